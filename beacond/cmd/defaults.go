@@ -47,16 +47,27 @@ func DefaultComponents() []any {
 		components.ProvideBlockStore[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
 		],
+		components.ProvideBlockStoreLoader[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BlockStore,
+		],
 		components.ProvideBlockStoreService[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*BlockStore, *Logger,
 		],
 		components.ProvideBlsSigner,
+		components.ProvideBlobArchiver[
+			*AvailabilityStore, *BlockStore, *Logger,
+		],
+		components.ProvideBlobChecker[
+			*AvailabilityStore, *BlockStore, *Logger,
+		],
+		components.ProvideBlobIntegrityService[*BeaconBlock, *Logger],
 		components.ProvideBlobProcessor[
 			*AvailabilityStore, *BeaconBlockBody, *BeaconBlockHeader,
 			*BlobSidecar, *BlobSidecars, *Logger,
 		],
 		components.ProvideBlobProofVerifier,
+		components.ProvideBlobSampler,
 		components.ProvideBlobVerifier[
 			*BeaconBlockHeader, *BlobSidecar, *BlobSidecars,
 		],
@@ -85,9 +96,9 @@ func DefaultComponents() []any {
 			*Deposit, *DepositStore, *Logger,
 		],
 		components.ProvideDepositService[
-			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Deposit,
-			*DepositContract, *DepositStore, *ExecutionPayload,
-			*ExecutionPayloadHeader, *Logger,
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BeaconState,
+			*Deposit, *DepositContract, *DepositStore, *ExecutionPayload,
+			*ExecutionPayloadHeader, *Logger, *StorageBackend,
 		],
 		components.ProvideDepositStore[*Deposit],
 		components.ProvideDispatcher[
@@ -99,13 +110,34 @@ func DefaultComponents() []any {
 		components.ProvideExecutionEngine[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
+		components.ProvideInclusionLists[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
+		],
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
 		],
+		components.ProvidePayloadReplayer[
+			*BeaconBlock, *BeaconState, *BlobSidecars, *Deposit,
+			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
+			*StorageBackend,
+		],
+		components.ProvideProposalReplayer[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+			*BeaconState, *BeaconStateMarshallable, *BlobSidecars, *Deposit,
+			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
+			*StorageBackend,
+		],
+		components.ProvideProposerReadiness[*Logger],
+		components.ProvideProposerSettings[*Logger],
+		components.ProvideRejectedProposals,
+		components.ProvideRejectionRecorder[
+			*BeaconState, *Logger, *StorageBackend,
+		],
 		components.ProvideReportingService[*Logger],
-		components.ProvideCometBFTService[*Logger],
+		components.ProvideCometBFTService[*ExecutionPayload, *Logger],
 		components.ProvideServiceRegistry[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BlockStore, *BeaconState,
@@ -154,8 +186,9 @@ func DefaultComponents() []any {
 
 	c = append(c,
 		components.ProvideNodeAPIHandlers[
-			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-			*ExecutionPayloadHeader, *KVStore, NodeAPIContext,
+			*BeaconBlock, *BeaconBlockHeader, *BeaconState,
+			*BeaconStateMarshallable, *BlobSidecars, *ExecutionPayloadHeader,
+			*KVStore, NodeAPIContext,
 		],
 		components.ProvideNodeAPIBeaconHandler[
 			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
@@ -164,11 +197,19 @@ func DefaultComponents() []any {
 		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
 		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
 		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
-		components.ProvideNodeAPINodeHandler[NodeAPIContext],
+		components.ProvideNodeAPINodeHandler[
+			*ExecutionPayload, *ExecutionPayloadHeader, NodeAPIContext,
+		],
 		components.ProvideNodeAPIProofHandler[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
 		],
+		components.ProvideNodeAPIValidatorHandler[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BeaconState,
+			*BeaconStateMarshallable, *BlobSidecars, *Deposit, *DepositStore,
+			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore,
+			*CometBFTService, NodeAPIContext,
+		],
 	)
 
 	return c
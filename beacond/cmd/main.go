@@ -71,6 +71,10 @@ func run() error {
 		clibuilder.WithNodeBuilderFunc[
 			Node, *ExecutionPayload, *Logger,
 		](nb.Build),
+		// Set the ComponentInjector to the NodeBuilder Inject.
+		clibuilder.WithComponentInjector[
+			Node, *ExecutionPayload, *Logger,
+		](nb.Inject),
 	)
 
 	cmd, err := cb.Build()
@@ -127,6 +127,7 @@ type (
 	DepositService = deposit.Service[
 		*BeaconBlock,
 		*BeaconBlockBody,
+		*BeaconState,
 		*Deposit,
 		*ExecutionPayload,
 		WithdrawalCredentials,
@@ -220,6 +221,7 @@ type (
 		*AttestationData,
 		*BeaconBlock,
 		*BeaconBlockBody,
+		*BeaconBlockHeader,
 		*BeaconState,
 		*BlobSidecars,
 		*Deposit,
@@ -230,6 +232,7 @@ type (
 		*ForkData,
 		*SlashingInfo,
 		*SlotData,
+		*Withdrawal,
 	]
 )
 
@@ -22,9 +22,9 @@ package blockchain
 
 import (
 	"context"
-	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // sendPostBlockFCU sends a forkchoice update to the execution client.
@@ -122,17 +122,24 @@ func (s *Service[
 	}
 }
 
-// calculateNextTimestamp calculates the next timestamp for an execution
-// payload.
-//
-// TODO: This is hood and needs to be improved.
+// calculateNextTimestamp predicts the timestamp of the execution payload
+// following the given block. The consensus time of the next slot is only
+// known once it is proposed, so the prediction is derived from the chain
+// rather than the local clock, and the payload is rebuilt on retrieval if
+// it turns out to be further than the payload timestamp bound from it.
 func (s *Service[
 	_, BeaconBlockT, _, _, _, _, _, _, _, _,
 ]) calculateNextTimestamp(blk BeaconBlockT) uint64 {
-	//#nosec:G701 // not an issue in practice.
-	return max(
-		uint64(time.Now().Unix()+
-			int64(s.chainSpec.TargetSecondsPerEth1Block())),
-		uint64(blk.GetBody().GetExecutionPayload().GetTimestamp()+1),
+	return s.predictPayloadTimestamp(
+		blk.GetBody().GetExecutionPayload().GetTimestamp(),
 	)
 }
+
+// predictPayloadTimestamp predicts the timestamp of the execution payload
+// built on top of a payload with the given timestamp.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) predictPayloadTimestamp(parentTimestamp math.U64) uint64 {
+	return parentTimestamp.Unwrap() +
+		max(s.chainSpec.TargetSecondsPerEth1Block(), 1)
+}
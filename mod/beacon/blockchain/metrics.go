@@ -108,6 +108,16 @@ func (cm *chainMetrics) markOptimisticPayloadBuildFailure(
 	)
 }
 
+// markSpeculativePayloadBuildSkipped increments the counter for the number of
+// payload builds skipped since the node is not predicted to propose the slot.
+func (cm *chainMetrics) markSpeculativePayloadBuildSkipped(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.speculative_payload_build_skipped",
+		"slot",
+		slot.Base10(),
+	)
+}
+
 // measureStateRootVerificationTime measures the time taken to verify the state
 // root of a block.
 // It records the duration from the provided start time to the current time.
@@ -22,7 +22,6 @@ package blockchain
 
 import (
 	"context"
-	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -90,6 +89,20 @@ func (s *Service[
 		slot math.Slot
 	)
 
+	stateSlot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	// A later round of the slot of the rejected block is only worth
+	// building for if it is proposed by the node.
+	if !s.isUpcomingProposer(
+		stateSlot+1, s.roundAfterRejection(stateSlot+1),
+	) {
+		s.metrics.markSpeculativePayloadBuildSkipped(stateSlot)
+		return nil
+	}
+
 	s.logger.Info("Rebuilding payload for rejected block ⏳ ")
 
 	// In order to rebuild a payload for the current slot, we need to know the
@@ -101,11 +114,6 @@ func (s *Service[
 		return err
 	}
 
-	stateSlot, err := st.GetSlot()
-	if err != nil {
-		return err
-	}
-
 	// Set the previous state root on the header.
 	latestHeader.SetStateRoot(st.HashTreeRoot())
 
@@ -122,12 +130,7 @@ func (s *Service[
 		st,
 		// We are rebuilding for the current slot.
 		stateSlot,
-		// TODO: this is hood as fuck.
-		max(
-			//#nosec:G701
-			uint64(time.Now().Unix()+1),
-			uint64((lph.GetTimestamp()+1)),
-		),
+		s.predictPayloadTimestamp(lph.GetTimestamp()),
 		// We set the parent root to the previous block root.
 		latestHeader.HashTreeRoot(),
 		// We set the head of our chain to the previous finalized block.
@@ -174,6 +177,15 @@ func (s *Service[
 	// to the block we just processed.
 	slot := blk.GetSlot() + 1
 
+	if !s.isUpcomingProposer(slot, 0) {
+		s.logger.Debug(
+			"Skipping optimistic payload build, not proposing next slot",
+			"next_slot", slot.Base10(),
+		)
+		s.metrics.markSpeculativePayloadBuildSkipped(slot)
+		return nil
+	}
+
 	s.logger.Info(
 		"Optimistically triggering payload build for next slot 🛩️ ",
 		"next_slot", slot.Base10(),
@@ -191,12 +203,7 @@ func (s *Service[
 	if _, err := s.localBuilder.RequestPayloadAsync(
 		ctx, st,
 		slot,
-		// TODO: this is hood as fuck.
-		max(
-			//#nosec:G701
-			uint64(time.Now().Unix()+int64(s.chainSpec.TargetSecondsPerEth1Block())),
-			uint64((payload.GetTimestamp()+1)),
-		),
+		s.calculateNextTimestamp(blk),
 		// The previous block root is simply the root of the block we just
 		// processed.
 		blk.HashTreeRoot(),
@@ -214,3 +221,49 @@ func (s *Service[
 	s.metrics.markOptimisticPayloadBuildSuccess(slot)
 	return nil
 }
+
+// isUpcomingProposer returns true if the node is predicted to propose in one
+// of the speculative build rounds of the given slot, starting at the given
+// round. Without a prediction, payloads are built ahead of time for every
+// slot.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) isUpcomingProposer(slot math.Slot, from uint32) bool {
+	if s.proposerSchedule == nil || s.speculativeBuildRounds == 0 {
+		return true
+	}
+
+	rounds, err := s.proposerSchedule.UpcomingProposerRounds(
+		slot, from, s.speculativeBuildRounds,
+	)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to predict proposer turns, building payload anyway",
+			"slot", slot.Base10(),
+			"error", err,
+		)
+		return true
+	}
+	return len(rounds) > 0
+}
+
+// roundAfterRejection returns the round following the one of the block of
+// the given slot being rejected. If the round is unknown, it returns round
+// 1, since the block was proposed in round 0 at the earliest.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) roundAfterRejection(slot math.Slot) uint32 {
+	if s.proposerSchedule == nil {
+		return 1
+	}
+	round, err := s.proposerSchedule.CurrentRound(slot)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to get the current round",
+			"slot", slot.Base10(),
+			"error", err,
+		)
+		return 1
+	}
+	return round + 1
+}
@@ -84,6 +84,26 @@ func (s *Service[
 		return err
 	}
 
+	// Verify the payload of the incoming block includes the transactions
+	// of the inclusion lists of the commit carried by the block.
+	if err := s.inclusionLists.VerifyPayload(
+		ctx, blk.GetSlot(), blk.GetBody().GetExecutionPayload(),
+	); err != nil {
+		s.logger.Error(
+			"Rejecting incoming beacon block ❌ ",
+			"slot",
+			blk.GetSlot(),
+			"reason",
+			err,
+		)
+
+		if s.shouldBuildOptimisticPayloads() {
+			go s.handleRebuildPayloadForRejectedBlock(ctx, preState)
+		}
+
+		return err
+	}
+
 	s.logger.Info(
 		"State root verification succeeded - accepting incoming beacon block",
 		"state_root",
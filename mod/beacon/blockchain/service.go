@@ -77,6 +77,15 @@ type Service[
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
+	// proposerSchedule predicts the slots the node proposes in, so that
+	// payloads are only built ahead of time for them.
+	proposerSchedule ProposerSchedule
+	// speculativeBuildRounds is the number of rounds of a slot searched for
+	// proposer turns of the node, 0 builds payloads for every slot.
+	speculativeBuildRounds uint32
+	// inclusionLists checks the payloads of incoming blocks against the
+	// inclusion lists of the validators.
+	inclusionLists InclusionListVerifier[ExecutionPayloadT]
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
 
@@ -122,6 +131,9 @@ func NewService[
 	],
 	telemetrySink TelemetrySink,
 	optimisticPayloadBuilds bool,
+	proposerSchedule ProposerSchedule,
+	speculativeBuildRounds uint32,
+	inclusionLists InclusionListVerifier[ExecutionPayloadT],
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
@@ -141,6 +153,9 @@ func NewService[
 		stateProcessor:          stateProcessor,
 		metrics:                 newChainMetrics(telemetrySink),
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
+		proposerSchedule:        proposerSchedule,
+		speculativeBuildRounds:  speculativeBuildRounds,
+		inclusionLists:          inclusionLists,
 		forceStartupSyncOnce:    new(sync.Once),
 		subFinalBlkReceived:     make(chan async.Event[BeaconBlockT]),
 		subBlockReceived:        make(chan async.Event[BeaconBlockT]),
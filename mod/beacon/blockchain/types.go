@@ -115,6 +115,16 @@ type Genesis[DepositT any, ExecutionPayloadHeaderT any] interface {
 	GetExecutionPayloadHeader() ExecutionPayloadHeaderT
 }
 
+// InclusionListVerifier checks execution payloads against the inclusion
+// lists of the commits carried by the blocks.
+type InclusionListVerifier[ExecutionPayloadT any] interface {
+	// VerifyPayload returns an error if the payload of the given slot omits
+	// a transaction of the inclusion lists that could have been included.
+	VerifyPayload(
+		ctx context.Context, slot math.Slot, payload ExecutionPayloadT,
+	) error
+}
+
 // LocalBuilder is the interface for the builder service.
 type LocalBuilder[BeaconStateT any] interface {
 	// Enabled returns true if the local builder is enabled.
@@ -137,6 +147,19 @@ type LocalBuilder[BeaconStateT any] interface {
 	) error
 }
 
+// ProposerSchedule predicts the proposer turns of the node.
+type ProposerSchedule interface {
+	// UpcomingProposerRounds returns the rounds, among the given number of
+	// rounds of the given slot starting at the given round, in which the
+	// node is the proposer.
+	UpcomingProposerRounds(
+		slot math.Slot, from, rounds uint32,
+	) ([]uint32, error)
+	// CurrentRound returns the round the consensus engine is in at the
+	// given slot, or 0 if it is at another slot.
+	CurrentRound(slot math.Slot) (uint32, error)
+}
+
 type PayloadAttributes interface {
 	IsNil() bool
 	Version() uint32
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+
+package replay
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// PayloadReplayer replays proposals like a Replayer, verifying their
+// payloads with the execution client on top of the other checks.
+type PayloadReplayer[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconStateT BeaconState,
+	BlobSidecarsT BlobSidecars[BlobSidecarsT],
+	ProposalT Proposal,
+] struct {
+	// replayer replays the proposals through the execution client.
+	replayer *Replayer[BeaconBlockT, BeaconStateT, BlobSidecarsT, ProposalT]
+}
+
+// NewPayloadReplayer creates a new replayer verifying the payloads of the
+// proposals with the given execution client, which the execution engine of
+// the state processor must be connected to.
+func NewPayloadReplayer[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconStateT BeaconState,
+	BlobSidecarsT BlobSidecars[BlobSidecarsT],
+	ProposalT Proposal,
+](
+	chainSpec common.ChainSpec,
+	proposals Proposals[ProposalT],
+	stateProcessor StateProcessor[BeaconBlockT, BeaconStateT],
+	blobVerifier BlobVerifier[BlobSidecarsT],
+	stateContexts StateContexts,
+	storageBackend StorageBackend[BeaconStateT],
+	executionClient ExecutionClient,
+) *PayloadReplayer[BeaconBlockT, BeaconStateT, BlobSidecarsT, ProposalT] {
+	replayer := NewReplayer[
+		BeaconBlockT, BeaconStateT, BlobSidecarsT, ProposalT,
+	](
+		chainSpec,
+		proposals,
+		stateProcessor,
+		blobVerifier,
+		stateContexts,
+		storageBackend,
+	)
+	replayer.executionClient = executionClient
+	return &PayloadReplayer[
+		BeaconBlockT, BeaconStateT, BlobSidecarsT, ProposalT,
+	]{
+		replayer: replayer,
+	}
+}
+
+// ReplayProposalWithPayload replays the latest proposal of the given
+// height, or the latest proposal of any height if height is 0, verifying
+// its payload with the execution client. The execution client is started,
+// so proposals must only be replayed by nodes that are not running.
+func (r *PayloadReplayer[_, _, _, ProposalT]) ReplayProposalWithPayload(
+	ctx context.Context,
+	height int64,
+) (ProposalT, *Report, error) {
+	return r.replayer.ReplayProposal(ctx, height)
+}
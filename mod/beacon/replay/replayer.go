@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay
+
+import (
+	"context"
+	"sync"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// Names of the checks run on a replayed proposal.
+const (
+	CheckDecodeBlock     = "decode beacon block"
+	CheckLoadPreState    = "load pre-state"
+	CheckPreStateRoot    = "pre-state root"
+	CheckTransition      = "state transition"
+	CheckDecodeSidecars  = "decode blob sidecars"
+	CheckInclusionProofs = "blob inclusion proofs"
+	CheckKZGProofs       = "blob kzg proofs"
+	CheckBlockRoots      = "blob block roots"
+)
+
+// Replayer replays proposals against the local beacon state, running the
+// checks ProcessProposal runs on them one at a time.
+type Replayer[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconStateT BeaconState,
+	BlobSidecarsT BlobSidecars[BlobSidecarsT],
+	ProposalT Proposal,
+] struct {
+	// chainSpec is the chain specification.
+	chainSpec common.ChainSpec
+	// proposals provides the proposals to replay.
+	proposals Proposals[ProposalT]
+	// stateProcessor runs the state transition of the proposed block.
+	stateProcessor StateProcessor[BeaconBlockT, BeaconStateT]
+	// blobVerifier verifies the proposed blob sidecars.
+	blobVerifier BlobVerifier[BlobSidecarsT]
+	// stateContexts provides the contexts holding committed beacon states.
+	stateContexts StateContexts
+	// storageBackend provides the beacon state of a context.
+	storageBackend StorageBackend[BeaconStateT]
+	// executionClient verifies the payloads, if set, through the state
+	// processor.
+	executionClient ExecutionClient
+	// startOnce starts the execution client once.
+	startOnce sync.Once
+	// startErr is the error the execution client was started with.
+	startErr error
+}
+
+// NewReplayer creates a new replayer, which does not verify the payloads of
+// the proposals. The execution engine of the state processor is never
+// called.
+func NewReplayer[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconStateT BeaconState,
+	BlobSidecarsT BlobSidecars[BlobSidecarsT],
+	ProposalT Proposal,
+](
+	chainSpec common.ChainSpec,
+	proposals Proposals[ProposalT],
+	stateProcessor StateProcessor[BeaconBlockT, BeaconStateT],
+	blobVerifier BlobVerifier[BlobSidecarsT],
+	stateContexts StateContexts,
+	storageBackend StorageBackend[BeaconStateT],
+) *Replayer[BeaconBlockT, BeaconStateT, BlobSidecarsT, ProposalT] {
+	return &Replayer[BeaconBlockT, BeaconStateT, BlobSidecarsT, ProposalT]{
+		chainSpec:      chainSpec,
+		proposals:      proposals,
+		stateProcessor: stateProcessor,
+		blobVerifier:   blobVerifier,
+		stateContexts:  stateContexts,
+		storageBackend: storageBackend,
+	}
+}
+
+// ReplayProposal replays the latest proposal of the given height, or the
+// latest proposal of any height if height is 0, reporting the outcome of
+// every check. If the replayer verifies the payloads, the execution client
+// is started, so proposals must only be replayed by nodes that are not
+// running.
+func (r *Replayer[_, _, _, ProposalT]) ReplayProposal(
+	ctx context.Context,
+	height int64,
+) (ProposalT, *Report, error) {
+	p, err := r.proposals.Get(height)
+	if err != nil {
+		return p, nil, err
+	}
+	if r.executionClient != nil {
+		r.startOnce.Do(func() {
+			r.startErr = r.executionClient.Start(ctx)
+		})
+		if r.startErr != nil {
+			return p, nil, r.startErr
+		}
+	}
+	return p, r.Replay(ctx, p), nil
+}
+
+// Replay runs the proposal through the state transition and the blob
+// verifier against the beacon state committed at the preceding height,
+// reporting the outcome of every check. The payload is only sent to the
+// execution client if the replayer verifies the payloads. The beacon state
+// is never written to.
+func (r *Replayer[_, _, _, _]) Replay(
+	ctx context.Context,
+	p Proposal,
+) *Report {
+	report := &Report{Height: p.GetHeight()}
+	r.replayBlock(ctx, report, p)
+	r.replaySidecars(report, p)
+	return report
+}
+
+// replayBlock runs the checks of the beacon block of the proposal.
+func (r *Replayer[BeaconBlockT, _, _, _]) replayBlock(
+	ctx context.Context,
+	report *Report,
+	p Proposal,
+) {
+	verifyPayload := r.executionClient != nil
+
+	//#nosec:G701 // heights are never negative.
+	slot := math.Slot(p.GetHeight())
+	blk, err := (*new(BeaconBlockT)).NewFromSSZ(
+		p.GetBlock(), r.chainSpec.ActiveForkVersionForSlot(slot),
+	)
+	if report.add(CheckDecodeBlock, err) != nil {
+		report.skip(CheckLoadPreState, CheckPreStateRoot, CheckTransition)
+		return
+	}
+
+	stCtx, err := r.stateContexts.StateContextAt(p.GetHeight() - 1)
+	if err != nil {
+		err = errors.Wrapf(ErrNoPreState, "height %d: %v", p.GetHeight()-1, err)
+	}
+	if report.add(CheckLoadPreState, err) != nil {
+		report.skip(CheckPreStateRoot, CheckTransition)
+		return
+	}
+
+	st := r.storageBackend.StateFromContext(stCtx)
+	if root := st.HashTreeRoot(); p.GetPreStateRoot() != (common.Root{}) &&
+		root != p.GetPreStateRoot() {
+		// The transition is still run since the mismatch may come from
+		// writes to the state that are irrelevant to the block.
+		err = errors.Wrapf(
+			ErrPreStateRootMismatch, "recorded %s, local %s",
+			p.GetPreStateRoot(), root,
+		)
+	}
+	report.add(CheckPreStateRoot, err)
+
+	if _, err = r.stateProcessor.Transition(
+		&transition.Context{
+			Context:                 ctx,
+			OptimisticEngine:        !verifyPayload,
+			SkipPayloadVerification: !verifyPayload,
+			SkipValidateResult:      false,
+			SkipValidateRandao:      false,
+		},
+		st, blk,
+	); errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
+		// ProcessProposal accepts blocks whose payload is accepted.
+		err = nil
+	}
+	report.add(CheckTransition, err)
+}
+
+// replaySidecars runs the checks of the blob sidecars of the proposal.
+func (r *Replayer[_, _, BlobSidecarsT, _]) replaySidecars(
+	report *Report,
+	p Proposal,
+) {
+	sidecars := (*new(BlobSidecarsT)).Empty()
+	if report.add(
+		CheckDecodeSidecars, sidecars.UnmarshalSSZ(p.GetSidecars()),
+	) != nil {
+		report.skip(CheckInclusionProofs, CheckKZGProofs, CheckBlockRoots)
+		return
+	}
+	if sidecars.Len() == 0 {
+		return
+	}
+
+	report.add(
+		CheckInclusionProofs, r.blobVerifier.VerifyInclusionProofs(sidecars),
+	)
+	report.add(CheckKZGProofs, r.blobVerifier.VerifyKZGProofs(sidecars))
+	report.add(CheckBlockRoots, sidecars.ValidateBlockRoots())
+}
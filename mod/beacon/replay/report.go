@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay
+
+// Check is the outcome of a check run on a replayed proposal.
+type Check struct {
+	// Name is the name of the check.
+	Name string
+	// Err is the error the check failed with, nil if it passed.
+	Err error
+	// Skipped is true if the check could not be run because an earlier
+	// check it depends on failed.
+	Skipped bool
+}
+
+// Report is the outcome of the replay of a proposal.
+type Report struct {
+	// Height is the height of the replayed proposal.
+	Height int64
+	// Checks are the checks run on the proposal, in order.
+	Checks []Check
+}
+
+// FirstFailure returns the first failed check of the report, nil if every
+// check passed.
+func (r *Report) FirstFailure() *Check {
+	for i := range r.Checks {
+		if r.Checks[i].Err != nil {
+			return &r.Checks[i]
+		}
+	}
+	return nil
+}
+
+// add appends the outcome of a check to the report and returns its error.
+func (r *Report) add(name string, err error) error {
+	r.Checks = append(r.Checks, Check{Name: name, Err: err})
+	return err
+}
+
+// skip appends the given checks to the report as skipped.
+func (r *Report) skip(names ...string) {
+	for _, name := range names {
+		r.Checks = append(r.Checks, Check{Name: name, Skipped: true})
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package replay
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// BeaconBlock represents a beacon block interface.
+type BeaconBlock[BeaconBlockT any] interface {
+	constraints.Nillable
+	// NewFromSSZ creates a new beacon block from the given SSZ bytes.
+	NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
+	// GetSlot returns the slot of the beacon block.
+	GetSlot() math.Slot
+}
+
+// BeaconState represents a beacon state interface.
+type BeaconState interface {
+	// HashTreeRoot returns the hash tree root of the beacon state.
+	HashTreeRoot() common.Root
+}
+
+// BlobSidecars is the interface for blob sidecars.
+type BlobSidecars[BlobSidecarsT any] interface {
+	constraints.SSZUnmarshaler
+	// Empty returns a new empty blob sidecars.
+	Empty() BlobSidecarsT
+	// Len returns the number of sidecars.
+	Len() int
+	// ValidateBlockRoots checks that all the sidecars are from the same
+	// block.
+	ValidateBlockRoots() error
+}
+
+// BlobVerifier is the interface for the verifier of the blob sidecars.
+type BlobVerifier[BlobSidecarsT any] interface {
+	// VerifyInclusionProofs verifies the inclusion proofs of the sidecars,
+	// laid out as the block body of the fork active at their slot.
+	VerifyInclusionProofs(scs BlobSidecarsT) error
+	// VerifyKZGProofs verifies the KZG proofs of the sidecars.
+	VerifyKZGProofs(scs BlobSidecarsT) error
+}
+
+// ExecutionClient is the client of the execution layer the payloads are
+// verified with.
+type ExecutionClient interface {
+	// Start connects the client to the execution layer.
+	Start(context.Context) error
+}
+
+// Proposal is a proposal to replay.
+type Proposal interface {
+	// GetHeight returns the height of the proposal.
+	GetHeight() int64
+	// GetBlock returns the SSZ encoded beacon block of the proposal.
+	GetBlock() []byte
+	// GetSidecars returns the SSZ encoded blob sidecars of the proposal.
+	GetSidecars() []byte
+	// GetPreStateRoot returns the root of the beacon state the proposal was
+	// processed on top of.
+	GetPreStateRoot() common.Root
+}
+
+// Proposals provides the proposals to replay.
+type Proposals[ProposalT any] interface {
+	// Get returns the latest proposal of the given height, or the latest
+	// proposal of any height if height is 0.
+	Get(height int64) (ProposalT, error)
+}
+
+// StateProcessor is the interface for the state processor.
+type StateProcessor[BeaconBlockT, BeaconStateT any] interface {
+	// Transition processes the state transition for the given block.
+	Transition(
+		ctx *transition.Context,
+		st BeaconStateT,
+		blk BeaconBlockT,
+	) (transition.ValidatorUpdates, error)
+}
+
+// StateContexts provides the contexts holding committed beacon states.
+type StateContexts interface {
+	// StateContextAt returns a context holding the beacon state committed
+	// at the given height. Writes to the beacon state of the context are
+	// never committed.
+	StateContextAt(height int64) (context.Context, error)
+}
+
+// StorageBackend provides the beacon state of a context.
+type StorageBackend[BeaconStateT any] interface {
+	// StateFromContext returns the beacon state of the context.
+	StateFromContext(context.Context) BeaconStateT
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// maxBlobsPerBlock returns the maximum number of blobs the proposals of the
+// node carry, which is bounded by the maximum of the chain.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) maxBlobsPerBlock() uint64 {
+	if s.cfg.MaxBlobsPerBlock == 0 {
+		return s.chainSpec.MaxBlobsPerBlock()
+	}
+	return min(s.cfg.MaxBlobsPerBlock, s.chainSpec.MaxBlobsPerBlock())
+}
+
+// checkBlobPolicy returns an error if the blobs of the payload violate the
+// blob policy of the proposer.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, ExecutionPayloadT, _, _, _, _, _,
+]) checkBlobPolicy(
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) error {
+	return blobPolicy{
+		maxBlobs:           s.maxBlobsPerBlock(),
+		minBlobGasPrice:    new(big.Int).SetUint64(s.cfg.MinBlobGasPrice),
+		prioritySubmitters: s.cfg.PrioritySubmitters,
+		signer: gethprimitives.LatestSignerForChainID(
+			new(big.Int).SetUint64(s.chainSpec.DepositEth1ChainID()),
+		),
+	}.check(envelope.GetExecutionPayload().GetTransactions())
+}
+
+// blobPolicy is the policy the blob transactions of the payloads proposed by
+// the node follow.
+type blobPolicy struct {
+	// maxBlobs is the maximum number of blobs per block.
+	maxBlobs uint64
+	// minBlobGasPrice is the minimum blob gas price of the blob
+	// transactions of submitters other than the priority submitters.
+	minBlobGasPrice *big.Int
+	// prioritySubmitters are the senders exempt from the policy.
+	prioritySubmitters []common.ExecutionAddress
+	// signer recovers the senders of the transactions.
+	signer gethprimitives.Signer
+}
+
+// check returns an error if the blob transactions of the given transactions
+// violate the policy. Blobs of priority submitters are exempt from the
+// minimum blob gas price and always fit in the block, while the blobs of
+// other submitters only fit in the room they leave under the maximum number
+// of blobs per block.
+func (p blobPolicy) check(txs engineprimitives.Transactions) error {
+	var priorityBlobs, otherBlobs uint64
+	for _, raw := range txs {
+		tx := new(gethprimitives.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil ||
+			tx.Type() != gethprimitives.BlobTxType {
+			continue
+		}
+
+		numBlobs := uint64(len(tx.BlobHashes()))
+		if p.isPrioritySubmitter(tx) {
+			priorityBlobs += numBlobs
+			continue
+		}
+		if tx.BlobGasFeeCap().Cmp(p.minBlobGasPrice) < 0 {
+			return errors.Wrapf(
+				ErrBlobPolicyViolated,
+				"blob transaction %s pays a blob gas price of %s below %s",
+				tx.Hash(), tx.BlobGasFeeCap(), p.minBlobGasPrice,
+			)
+		}
+		otherBlobs += numBlobs
+	}
+
+	if otherBlobs > 0 && priorityBlobs+otherBlobs > p.maxBlobs {
+		return errors.Wrapf(
+			ErrBlobPolicyViolated,
+			"payload carries %d blobs, %d of priority submitters, "+
+				"more than the maximum of %d",
+			priorityBlobs+otherBlobs, priorityBlobs, p.maxBlobs,
+		)
+	}
+	return nil
+}
+
+// isPrioritySubmitter returns true if the transaction is sent by one of the
+// priority submitters of the policy.
+func (p blobPolicy) isPrioritySubmitter(tx *gethprimitives.Transaction) bool {
+	if len(p.prioritySubmitters) == 0 {
+		return false
+	}
+	sender, err := gethprimitives.Sender(p.signer, tx)
+	if err != nil {
+		return false
+	}
+	for _, submitter := range p.prioritySubmitters {
+		if submitter == common.ExecutionAddress(sender) {
+			return true
+		}
+	}
+	return false
+}
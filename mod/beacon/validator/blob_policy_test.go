@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+const blobPolicyChainID = 80087
+
+// newBlobTx returns a transaction of the given sender carrying the given
+// number of blobs at the given blob gas price.
+func newBlobTx(
+	t *testing.T, key *ecdsa.PrivateKey, blobs int, blobGasPrice uint64,
+) []byte {
+	t.Helper()
+	hashes := make([]gethcommon.Hash, blobs)
+	for i := range hashes {
+		hashes[i] = gethcommon.Hash{0x01, byte(i)}
+	}
+	tx, err := types.SignNewTx(
+		key,
+		types.LatestSignerForChainID(big.NewInt(blobPolicyChainID)),
+		&types.BlobTx{
+			ChainID:    uint256.NewInt(blobPolicyChainID),
+			GasTipCap:  uint256.NewInt(1),
+			GasFeeCap:  uint256.NewInt(1),
+			Gas:        21_000,
+			BlobFeeCap: uint256.NewInt(blobGasPrice),
+			BlobHashes: hashes,
+		},
+	)
+	require.NoError(t, err)
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return bz
+}
+
+func newLegacyTx(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+	tx, err := types.SignNewTx(
+		key,
+		types.LatestSignerForChainID(big.NewInt(blobPolicyChainID)),
+		&types.LegacyTx{Gas: 21_000, GasPrice: big.NewInt(1)},
+	)
+	require.NoError(t, err)
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestBlobPolicy(t *testing.T) {
+	priority, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	policy := blobPolicy{
+		maxBlobs:        4,
+		minBlobGasPrice: big.NewInt(10),
+		prioritySubmitters: []common.ExecutionAddress{
+			common.ExecutionAddress(crypto.PubkeyToAddress(priority.PublicKey)),
+		},
+		signer: types.LatestSignerForChainID(big.NewInt(blobPolicyChainID)),
+	}
+
+	tests := []struct {
+		name    string
+		txs     engineprimitives.Transactions
+		wantErr bool
+	}{
+		{
+			name: "no blobs",
+			txs:  engineprimitives.Transactions{newLegacyTx(t, other)},
+		},
+		{
+			name: "blobs up to the maximum",
+			txs: engineprimitives.Transactions{
+				newBlobTx(t, other, 3, 10), newBlobTx(t, other, 1, 20),
+			},
+		},
+		{
+			name: "blobs over the maximum",
+			txs: engineprimitives.Transactions{
+				newBlobTx(t, other, 3, 10), newBlobTx(t, other, 2, 20),
+			},
+			wantErr: true,
+		},
+		{
+			name: "blob gas price below the minimum",
+			txs: engineprimitives.Transactions{
+				newBlobTx(t, other, 1, 9),
+			},
+			wantErr: true,
+		},
+		{
+			name: "priority submitter below the minimum blob gas price",
+			txs: engineprimitives.Transactions{
+				newBlobTx(t, priority, 1, 1),
+			},
+		},
+		{
+			name: "priority submitter over the maximum",
+			txs: engineprimitives.Transactions{
+				newBlobTx(t, priority, 6, 10),
+			},
+		},
+		{
+			name: "other submitter after priority submitter",
+			txs: engineprimitives.Transactions{
+				newBlobTx(t, priority, 3, 10), newBlobTx(t, other, 1, 10),
+			},
+		},
+		{
+			name: "other submitter over the room left by priority submitter",
+			txs: engineprimitives.Transactions{
+				newBlobTx(t, priority, 3, 10), newBlobTx(t, other, 2, 10),
+			},
+			wantErr: true,
+		},
+		{
+			name: "undecodable transaction",
+			txs:  engineprimitives.Transactions{{0x03, 0xff}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.check(tt.txs)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrBlobPolicyViolated)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Without priority submitters, every submitter follows the policy.
+	policy.prioritySubmitters = nil
+	require.ErrorIs(t, policy.check(engineprimitives.Transactions{
+		newBlobTx(t, priority, 1, 1),
+	}), ErrBlobPolicyViolated)
+}
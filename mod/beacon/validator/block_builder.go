@@ -26,6 +26,7 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -37,20 +38,16 @@ import (
 
 // buildBlockAndSidecars builds a new beacon block.
 func (s *Service[
-	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	_, BeaconBlockT, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT, _,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	slotData SlotDataT,
 ) (BeaconBlockT, BlobSidecarsT, error) {
 	var (
-		blk       BeaconBlockT
-		sidecars  BlobSidecarsT
-		startTime = time.Now()
-		g, _      = errgroup.WithContext(ctx)
+		blk      BeaconBlockT
+		sidecars BlobSidecarsT
 	)
 
-	defer s.metrics.measureRequestBlockForProposalTime(startTime)
-
 	// The goal here is to acquire a payload whose parent is the previously
 	// finalized block, such that, if this payload is accepted, it will be
 	// the next finalized block in the chain. A byproduct of this design
@@ -74,16 +71,66 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
-	// Create a new empty block from the current state.
-	blk, err = s.getEmptyBeaconBlockForSlot(
-		st, slotData.GetSlot(),
+	return s.assembleBlockAndSidecars(
+		ctx, st, slotData.GetSlot(), slotData.GetTime(), reveal,
+		slotData.GetAttestationData(), slotData.GetSlashingInfo(),
+		slotData.GetEmptyPayload(),
 	)
+}
+
+// assembleBlockAndSidecars builds the beacon block and sidecars of the given
+// slot with the given randao reveal on top of the state, which must already
+// be processed up to the slot. The execution payload of the block follows
+// the given consensus time of the slot, and is a payload without
+// transactions if requested.
+func (s *Service[
+	AttestationDataT, BeaconBlockT, _, _, BeaconStateT, BlobSidecarsT,
+	_, _, _, ExecutionPayloadT, ExecutionPayloadHeaderT, _, SlashingInfoT,
+	_, _,
+]) assembleBlockAndSidecars(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	consensusTime math.U64,
+	reveal crypto.BLSSignature,
+	attestationData []AttestationDataT,
+	slashingInfo []SlashingInfoT,
+	emptyPayload bool,
+) (BeaconBlockT, BlobSidecarsT, error) {
+	var (
+		blk       BeaconBlockT
+		sidecars  BlobSidecarsT
+		err       error
+		startTime = time.Now()
+		g, _      = errgroup.WithContext(ctx)
+	)
+
+	defer s.metrics.measureRequestBlockForProposalTime(startTime)
+
+	// Create a new empty block from the current state.
+	blk, err = s.getEmptyBeaconBlockForSlot(st, slot)
 	if err != nil {
 		return blk, sidecars, err
 	}
 
-	// Get the payload for the block.
-	envelope, err := s.retrieveExecutionPayload(ctx, st, blk)
+	// Get the payload for the block, falling back to an empty payload so
+	// that the slot is not missed if it cannot be retrieved in time.
+	var (
+		envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
+		bid      engineprimitives.BuilderBid[ExecutionPayloadHeaderT]
+	)
+	if emptyPayload {
+		err = ErrEmptyPayloadRequested
+	} else {
+		envelope, bid, err = s.retrieveExecutionPayloadWithTimeout(
+			ctx, st, blk, consensusTime,
+		)
+	}
+	if err != nil || envelope == nil {
+		envelope, err = s.retrieveEmptyPayload(
+			ctx, st, blk, consensusTime, err,
+		)
+	}
 	if err != nil {
 		return blk, sidecars, err
 	} else if envelope == nil {
@@ -93,11 +140,20 @@ func (s *Service[
 	// We have to assemble the block body prior to producing the sidecars
 	// since we need to generate the inclusion proofs.
 	if err = s.buildBlockBody(
-		ctx, st, blk, reveal, envelope, slotData,
+		ctx, st, blk, reveal, envelope, attestationData, slashingInfo,
 	); err != nil {
 		return blk, sidecars, err
 	}
 
+	// The payload of a winning bid is revealed in exchange for the signed
+	// blinded block, once signed, falling back to another payload would
+	// sign a second block for the slot.
+	if bid != nil {
+		if envelope, err = s.unblindBid(ctx, st, blk, bid); err != nil {
+			return blk, sidecars, errors.Join(ErrUnblindFailed, err)
+		}
+	}
+
 	// Produce blob sidecars, we produce them in parallel to computing the state
 	// root as an optimization.
 	//
@@ -123,7 +179,7 @@ func (s *Service[
 
 	s.logger.Info(
 		"Beacon block successfully built",
-		"slot", slotData.GetSlot().Base10(),
+		"slot", slot.Base10(),
 		"state_root", blk.GetStateRoot(),
 		"duration", time.Since(startTime).String(),
 	)
@@ -133,7 +189,7 @@ func (s *Service[
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
 	st BeaconStateT, requestedSlot math.Slot,
 ) (BeaconBlockT, error) {
@@ -165,7 +221,7 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
@@ -191,21 +247,71 @@ func (s *Service[
 	return s.signer.Sign(signingRoot[:])
 }
 
-// retrieveExecutionPayload retrieves the execution payload for the block.
+// retrieveLocalPayload retrieves the execution payload for the block from
+// the local payload builder, following the consensus time of the slot. The
+// execution client decides which blobs the payload carries, and dropping any
+// of them would invalidate the payload, so payloads violating the blob
+// policy of the proposer are replaced with a payload without transactions.
+// So are payloads the peers would reject for omitting transactions of the
+// inclusion lists, which payloads without transactions are exempt from.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
-]) retrieveExecutionPayload(
-	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, _, _, _, _, _,
+]) retrieveLocalPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	consensusTime math.U64,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
+	envelope, err := s.requestLocalPayload(ctx, st, blk, consensusTime)
+	if err != nil || envelope == nil {
+		return envelope, err
+	}
+
+	if err = s.checkBlobPolicy(envelope); err != nil {
+		s.metrics.markBlobPolicyViolation(blk.GetSlot())
+		return s.retrieveEmptyPayload(ctx, st, blk, consensusTime, err)
+	}
+	if err = s.inclusionLists.VerifyPayload(
+		ctx, blk.GetSlot(), envelope.GetExecutionPayload(),
+	); err != nil {
+		return s.retrieveEmptyPayload(ctx, st, blk, consensusTime, err)
+	}
+	return envelope, nil
+}
+
+// requestLocalPayload requests the execution payload for the block from the
+// local payload builder, following the consensus time of the slot.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _, _,
+]) requestLocalPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	consensusTime math.U64,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	// Payloads are not built on top of a head the execution client has not
+	// validated, nor while it is syncing or unavailable.
+	if state := s.readiness.State(); !state.canBuildFull() {
+		s.metrics.markPayloadBuildSkipped(blk.GetSlot(), state)
+		return nil, notReadyError(state)
+	}
+
+	// The latest execution payload header will be from the previous block
+	// during the block building phase.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	timestamp := payloadTimestamp(consensusTime, lph.GetTimestamp())
+
 	// Get the payload for the block.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
 			ctx,
 			blk.GetSlot(),
+			timestamp,
 			blk.GetParentBlockRoot(),
 		)
 	if err != nil {
@@ -214,14 +320,6 @@ func (s *Service[
 			err,
 		)
 
-		// The latest execution payload header will be from the previous block
-		// during the block building phase.
-		var lph ExecutionPayloadHeaderT
-		lph, err = st.GetLatestExecutionPayloadHeader()
-		if err != nil {
-			return nil, err
-		}
-
 		// If we failed to retrieve the payload, request a synchrnous payload.
 		//
 		// NOTE: The state here is properly configured by the
@@ -234,12 +332,7 @@ func (s *Service[
 			ctx,
 			st,
 			blk.GetSlot(),
-			// TODO: this is hood.
-			max(
-				//#nosec:G701
-				uint64(time.Now().Unix()+1),
-				uint64((lph.GetTimestamp()+1)),
-			),
+			timestamp,
 			blk.GetParentBlockRoot(),
 			lph.GetBlockHash(),
 			lph.GetParentHash(),
@@ -248,17 +341,97 @@ func (s *Service[
 	return envelope, nil
 }
 
+// payloadTimestamp returns the timestamp of a payload following the given
+// consensus time, as long as it is later than the parent payload.
+func payloadTimestamp(consensusTime, parentTimestamp math.U64) uint64 {
+	return max(consensusTime, parentTimestamp+1).Unwrap()
+}
+
+// retrieveExecutionPayloadWithTimeout retrieves the execution payload for the
+// block, along with the winning bid of the relay if any, giving up once the
+// payload retrieval timeout expires.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _, _,
+]) retrieveExecutionPayloadWithTimeout(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	consensusTime math.U64,
+) (
+	engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	engineprimitives.BuilderBid[ExecutionPayloadHeaderT],
+	error,
+) {
+	if s.cfg.PayloadRetrievalTimeout == 0 {
+		return s.retrieveExecutionPayload(ctx, st, blk, consensusTime)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.PayloadRetrievalTimeout)
+	defer cancel()
+	return s.retrieveExecutionPayload(ctx, st, blk, consensusTime)
+}
+
+// retrieveEmptyPayload retrieves a payload without transactions for the
+// block from the local payload builder, after the retrieval of its payload
+// failed with the given error.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, _, _, _, _, _,
+]) retrieveEmptyPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	consensusTime math.U64,
+	cause error,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	if cause == nil {
+		cause = ErrNilPayload
+	}
+	// An execution client that is syncing or unavailable cannot build an
+	// empty payload either.
+	if !s.readiness.State().canBuild() {
+		return nil, cause
+	}
+	s.logger.Warn(
+		"Failed to retrieve payload, falling back to empty payload",
+		"slot", blk.GetSlot().Base10(),
+		"error", cause,
+	)
+	s.metrics.markEmptyPayloadFallback(blk.GetSlot())
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, errors.Join(cause, err)
+	}
+
+	envelope, err := s.localPayloadBuilder.RequestEmptyPayload(
+		ctx,
+		st,
+		blk.GetSlot(),
+		payloadTimestamp(consensusTime, lph.GetTimestamp()),
+		blk.GetParentBlockRoot(),
+		lph.GetBlockHash(),
+		lph.GetParentHash(),
+	)
+	if err != nil {
+		return nil, errors.Join(cause, err)
+	}
+	return envelope, nil
+}
+
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT, ExecutionPayloadT, _,
-	_, _, SlotDataT,
+	AttestationDataT, BeaconBlockT, _, _, BeaconStateT, _, _, _, Eth1DataT,
+	ExecutionPayloadT, _, _, SlashingInfoT, _, _,
 ]) buildBlockBody(
 	_ context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
 	reveal crypto.BLSSignature,
 	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
-	slotData SlotDataT,
+	attestationData []AttestationDataT,
+	slashingInfo []SlashingInfoT,
 ) error {
 	// Assemble a new block with the payload.
 	body := blk.GetBody()
@@ -304,7 +477,7 @@ func (s *Service[
 	))
 
 	// Set the graffiti on the block body.
-	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
+	sizedGraffiti := bytes.ExtendToSize([]byte(s.graffiti()), bytes.B32Size)
 	graffiti, err := bytes.ToBytes32(sizedGraffiti)
 	if err != nil {
 		return fmt.Errorf("failed processing graffiti: %w", err)
@@ -318,10 +491,10 @@ func (s *Service[
 	)
 	if activeForkVersion >= version.DenebPlus {
 		// Set the attestations on the block body.
-		body.SetAttestations(slotData.GetAttestationData())
+		body.SetAttestations(attestationData)
 
 		// Set the slashing info on the block body.
-		body.SetSlashingInfo(slotData.GetSlashingInfo())
+		body.SetSlashingInfo(slashingInfo)
 	}
 
 	body.SetExecutionPayload(envelope.GetExecutionPayload())
@@ -331,7 +504,7 @@ func (s *Service[
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
 ]) computeAndSetStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -352,7 +525,7 @@ func (s *Service[
 
 // computeStateRoot computes the state root of an outgoing block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
 ]) computeStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -20,10 +20,35 @@
 
 package validator
 
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
 
+	// defaultGraffitiClientVersion is the default for appending the client
+	// version to the graffiti.
+	defaultGraffitiClientVersion = false
+
+	// defaultBuilderBoostFactor is the default percentage the value of
+	// relay bids is multiplied by when compared to local payloads.
+	defaultBuilderBoostFactor = 100
+
+	// defaultExternalSignerTimeout is the default time to wait for the
+	// external signer to publish the signed block of a proposal. It must
+	// leave room for the block to be returned before the proposal times out.
+	defaultExternalSignerTimeout = 1500 * time.Millisecond
+
+	// defaultPayloadRetrievalTimeout is the default time the retrieval of the
+	// payload of a proposal may take before falling back to an empty payload.
+	// It must leave room for the block to be built before the proposal times
+	// out.
+	defaultPayloadRetrievalTimeout = 1500 * time.Millisecond
+
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
@@ -37,14 +62,70 @@ type Config struct {
 	// graffiti field of the beacon block.
 	Graffiti string `mapstructure:"graffiti"`
 
+	// GraffitiClientVersion appends the client codes and commits of the
+	// execution and consensus clients to the graffiti when they fit.
+	GraffitiClientVersion bool `mapstructure:"graffiti-client-version"`
+
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// ProposerConfigPath is the path of the proposer configuration file,
+	// which holds the fee recipient, graffiti and relay settings by
+	// validator public key.
+	ProposerConfigPath string `mapstructure:"proposer-config-path"`
+
+	// BuilderBoostFactor is the percentage the value of relay bids is
+	// multiplied by before comparing it with the value of the local payload.
+	// 0 always selects the local payload, 100 selects the more valuable one.
+	BuilderBoostFactor uint64 `mapstructure:"builder-boost-factor"`
+
+	// ExternalSigner moves the signing of blocks to an external validator
+	// process, which produces blocks through the validator API and publishes
+	// them once signed. Proposals then wait for the signed block instead of
+	// building one with the signer of the node, and blocks can only be
+	// produced while their slot is being proposed, again in every round.
+	ExternalSigner bool `mapstructure:"external-signer"`
+
+	// ExternalSignerTimeout is the time a proposal waits for the external
+	// signer to produce and publish the signed block.
+	ExternalSignerTimeout time.Duration `mapstructure:"external-signer-timeout"`
+
+	// PayloadRetrievalTimeout is the time the retrieval of the payload of a
+	// proposal may take. Once it fails or times out, the block is proposed
+	// with a payload without transactions instead. 0 waits for the retrieval
+	// without a timeout.
+	PayloadRetrievalTimeout time.Duration `mapstructure:"payload-retrieval-timeout"`
+
+	// MaxBlobsPerBlock is the maximum number of blobs of submitters other
+	// than the priority submitters the proposals of the node carry, below the
+	// maximum of the chain. 0 applies the maximum of the chain.
+	MaxBlobsPerBlock uint64 `mapstructure:"max-blobs-per-block"`
+
+	// MinBlobGasPrice is the minimum blob gas price, in wei, blob
+	// transactions of submitters other than the priority submitters must pay
+	// to be carried by the proposals of the node.
+	MinBlobGasPrice uint64 `mapstructure:"min-blob-gas-price"`
+
+	// PrioritySubmitters are the senders of blob transactions that are
+	// exempt from the blob policy of the proposals of the node. Local
+	// payloads violating the blob policy are replaced with payloads without
+	// transactions.
+	PrioritySubmitters []common.ExecutionAddress `mapstructure:"priority-submitters"`
 }
 
 // DefaultConfig returns the default fork configuration.
 func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
+		GraffitiClientVersion:         defaultGraffitiClientVersion,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		ProposerConfigPath:            "",
+		BuilderBoostFactor:            defaultBuilderBoostFactor,
+		ExternalSigner:                false,
+		ExternalSignerTimeout:         defaultExternalSignerTimeout,
+		PayloadRetrievalTimeout:       defaultPayloadRetrievalTimeout,
+		MaxBlobsPerBlock:              0,
+		MinBlobGasPrice:               0,
+		PrioritySubmitters:            nil,
 	}
 }
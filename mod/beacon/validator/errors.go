@@ -37,4 +37,51 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrBlobPolicyViolated is an error for when the blobs of a payload
+	// violate the blob policy of the proposer.
+	ErrBlobPolicyViolated = errors.New("blob policy violated")
+
+	// ErrUnblindFailed is an error for when the relay does not reveal the
+	// payload of a signed blinded block, which fails the slot.
+	ErrUnblindFailed = errors.New("failed to unblind payload from relay")
+
+	// ErrExternalSignerDisabled is an error for when a block is produced
+	// for or published by an external signer while it is not enabled.
+	ErrExternalSignerDisabled = errors.New("external signer is disabled")
+
+	// ErrSlotNotProposed is an error for when a block is produced for the
+	// external signer for a slot that is not being proposed.
+	ErrSlotNotProposed = errors.New("slot is not being proposed")
+
+	// ErrInvalidRandaoReveal is an error for when the randao reveal supplied
+	// by the external signer is not signed by the proposer.
+	ErrInvalidRandaoReveal = errors.New("invalid randao reveal")
+
+	// ErrUnknownProposal is an error for when the external signer publishes
+	// a block that was not produced by this node.
+	ErrUnknownProposal = errors.New("unknown block proposal")
+
+	// ErrInvalidBlockSignature is an error for when the signature published
+	// by the external signer is not a valid signature of the block.
+	ErrInvalidBlockSignature = errors.New("invalid block signature")
+
+	// ErrSignedBlockTimeout is an error for when the external signer does
+	// not publish the signed block of a proposal in time.
+	ErrSignedBlockTimeout = errors.New(
+		"timed out waiting for the signed block",
+	)
+
+	// ErrEmptyPayloadRequested is an error for when the block of a slot is
+	// built with a payload without transactions, as building it failed
+	// before.
+	ErrEmptyPayloadRequested = errors.New(
+		"empty payload requested after failed proposal",
+	)
+
+	// ErrExecutionClientNotReady is an error for when the execution client
+	// is not ready to build the payload of a proposal.
+	ErrExecutionClientNotReady = errors.New(
+		"execution client not ready to build payloads",
+	)
 )
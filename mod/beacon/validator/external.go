@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// proposal is a block produced for the external signer along with its
+// sidecars.
+type proposal[BeaconBlockT, BlobSidecarsT any] struct {
+	blk      BeaconBlockT
+	sidecars BlobSidecarsT
+	// round is the round of the proposal the block was produced for.
+	round uint64
+	// bz is the SSZ encoding of the block handed to the external signer.
+	bz []byte
+	// signingRoot is the root the external signer signs the block over.
+	signingRoot common.Root
+}
+
+// pendingSlot is a slot being proposed that waits for the external signer
+// to produce and publish its block.
+type pendingSlot[SlotDataT any] struct {
+	// round numbers the proposals, telling apart the rounds a slot is
+	// proposed in.
+	round uint64
+	// ctx is the context of the proposal, holding the state the block of
+	// the slot is built on.
+	ctx context.Context
+	// slot is the slot being proposed.
+	slot math.Slot
+	// slotData is the slot data of the proposal.
+	slotData SlotDataT
+}
+
+// proposals tracks the blocks produced for the external signer until they
+// are published back signed and consumed by a proposal.
+type proposals[BeaconBlockT, BlobSidecarsT, SlotDataT any] struct {
+	mu sync.Mutex
+	// pending is the slot being proposed, if any.
+	pending *pendingSlot[SlotDataT]
+	// produced holds the latest block produced for each pending slot.
+	produced map[math.Slot]*proposal[BeaconBlockT, BlobSidecarsT]
+	// signed is the latest block published signed by the external signer.
+	signed *proposal[BeaconBlockT, BlobSidecarsT]
+	// rounds is the number of proposals so far.
+	rounds uint64
+	// notify is signalled when a signed block is published.
+	notify chan struct{}
+	// buildMu serializes the blocks produced for the external signer.
+	buildMu sync.Mutex
+}
+
+// newProposals creates a new tracker of external proposals.
+func newProposals[BeaconBlockT, BlobSidecarsT, SlotDataT any]() *proposals[
+	BeaconBlockT, BlobSidecarsT, SlotDataT,
+] {
+	return &proposals[BeaconBlockT, BlobSidecarsT, SlotDataT]{
+		produced: make(map[math.Slot]*proposal[BeaconBlockT, BlobSidecarsT]),
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// setPending marks the given slot as being proposed in a new round, in the
+// given context with the given slot data, until the returned function is
+// called.
+func (p *proposals[_, _, SlotDataT]) setPending(
+	ctx context.Context, slot math.Slot, slotData SlotDataT,
+) (*pendingSlot[SlotDataT], func()) {
+	p.mu.Lock()
+	p.rounds++
+	pending := &pendingSlot[SlotDataT]{
+		round: p.rounds, ctx: ctx, slot: slot, slotData: slotData,
+	}
+	p.pending = pending
+	p.mu.Unlock()
+	return pending, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.pending == pending {
+			p.pending = nil
+		}
+	}
+}
+
+// pendingFor returns the slot being proposed, if it is the given slot.
+func (p *proposals[_, _, SlotDataT]) pendingFor(
+	slot math.Slot,
+) *pendingSlot[SlotDataT] {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pending == nil || p.pending.slot != slot {
+		return nil
+	}
+	return p.pending
+}
+
+// add records the block produced for the given slot, replacing the blocks
+// produced for it or any earlier slot before.
+func (p *proposals[BeaconBlockT, BlobSidecarsT, _]) add(
+	slot math.Slot, prop *proposal[BeaconBlockT, BlobSidecarsT],
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for s := range p.produced {
+		if s <= slot {
+			delete(p.produced, s)
+		}
+	}
+	p.produced[slot] = prop
+}
+
+// sign marks the produced block with the given SSZ encoding as signed, after
+// verifying the signature with the given function.
+func (p *proposals[BeaconBlockT, BlobSidecarsT, _]) sign(
+	bz []byte, verify func(signingRoot common.Root) error,
+) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, prop := range p.produced {
+		if !bytes.Equal(prop.bz, bz) {
+			continue
+		}
+		if err := verify(prop.signingRoot); err != nil {
+			return errors.Join(ErrInvalidBlockSignature, err)
+		}
+		p.signed = prop
+		select {
+		case p.notify <- struct{}{}:
+		default:
+		}
+		return nil
+	}
+	return ErrUnknownProposal
+}
+
+// signedFor returns the signed block of the given round, if published.
+// The blocks signed in an earlier round of the slot carry the consensus time
+// of that round, so every round waits for a block produced for it.
+func (p *proposals[BeaconBlockT, BlobSidecarsT, SlotDataT]) signedFor(
+	pending *pendingSlot[SlotDataT],
+) *proposal[BeaconBlockT, BlobSidecarsT] {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.signed == nil || p.signed.round != pending.round {
+		return nil
+	}
+	return p.signed
+}
+
+// ProduceBlock builds the block of the given slot for the external signer,
+// with the randao reveal signed by it. The slot must be being proposed, its
+// block is built on a copy of the state of the proposal with the consensus
+// time, attestation data and slashing info of the slot.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, BlobSidecarsT,
+	_, _, _, _, _, ForkDataT, _, _, _,
+]) ProduceBlock(
+	slot math.Slot,
+	reveal crypto.BLSSignature,
+) (BeaconBlockT, BlobSidecarsT, error) {
+	var (
+		blk      BeaconBlockT
+		sidecars BlobSidecarsT
+		forkData ForkDataT
+		epoch    = s.chainSpec.SlotToEpoch(slot)
+	)
+	if !s.cfg.ExternalSigner {
+		return blk, sidecars, ErrExternalSignerDisabled
+	}
+
+	pending := s.proposals.pendingFor(slot)
+	if pending == nil {
+		return blk, sidecars, ErrSlotNotProposed
+	}
+	s.proposals.buildMu.Lock()
+	defer s.proposals.buildMu.Unlock()
+
+	// Building the block runs the state transition, so the state of the
+	// proposal is copied to allow the slot to be produced more than once.
+	st := s.sb.StateFromContext(pending.ctx).Copy()
+	if _, err := s.stateProcessor.ProcessSlots(st, slot); err != nil {
+		return blk, sidecars, err
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return blk, sidecars, err
+	}
+	forkData = forkData.New(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	)
+
+	// Reject reveals the proposal would be rejected for by the network.
+	randaoRoot := forkData.ComputeRandaoSigningRoot(
+		s.chainSpec.DomainTypeRandao(), epoch,
+	)
+	if err = s.signer.VerifySignature(
+		s.signer.PublicKey(), randaoRoot[:], reveal,
+	); err != nil {
+		return blk, sidecars, errors.Join(ErrInvalidRandaoReveal, err)
+	}
+
+	blk, sidecars, err = s.assembleBlockAndSidecars(
+		pending.ctx, st, slot, pending.slotData.GetTime(), reveal,
+		pending.slotData.GetAttestationData(),
+		pending.slotData.GetSlashingInfo(),
+		pending.slotData.GetEmptyPayload(),
+	)
+	if err != nil {
+		return blk, sidecars, err
+	}
+
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return blk, sidecars, err
+	}
+	s.proposals.add(slot, &proposal[BeaconBlockT, BlobSidecarsT]{
+		blk:      blk,
+		sidecars: sidecars,
+		round:    pending.round,
+		bz:       bz,
+		signingRoot: computeSigningRoot(
+			blk.HashTreeRoot(),
+			forkData.ComputeDomain(s.chainSpec.DomainTypeProposer()),
+		),
+	})
+	return blk, sidecars, nil
+}
+
+// PublishBlock accepts the SSZ encoded block produced for the external
+// signer back with its signature, making it available to the proposal of
+// its round. Beacon blocks carry no signature, as proposals are signed by
+// CometBFT with the consensus key of the node, so the signature is not
+// proposed. It is verified against the validator key instead, so that only
+// the blocks the external signer approved, after its slashing protection,
+// are proposed, unchanged from the ones produced.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) PublishBlock(
+	bz []byte,
+	signature crypto.BLSSignature,
+) error {
+	if !s.cfg.ExternalSigner {
+		return ErrExternalSignerDisabled
+	}
+
+	return s.proposals.sign(bz, func(signingRoot common.Root) error {
+		return s.signer.VerifySignature(
+			s.signer.PublicKey(), signingRoot[:], signature,
+		)
+	})
+}
+
+// awaitSignedBlock marks the slot of the given slot data as being proposed
+// in a new round and waits for the external signer to produce and publish
+// the signed block of that round.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT, _,
+]) awaitSignedBlock(
+	ctx context.Context,
+	slotData SlotDataT,
+) (BeaconBlockT, BlobSidecarsT, error) {
+	pending, done := s.proposals.setPending(ctx, slotData.GetSlot(), slotData)
+	defer done()
+
+	timer := time.NewTimer(s.cfg.ExternalSignerTimeout)
+	defer timer.Stop()
+	for {
+		if prop := s.proposals.signedFor(pending); prop != nil {
+			return prop.blk, prop.sidecars, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return *new(BeaconBlockT), *new(BlobSidecarsT), ctx.Err()
+		case <-timer.C:
+			return *new(BeaconBlockT), *new(BlobSidecarsT),
+				ErrSignedBlockTimeout
+		case <-s.proposals.notify:
+		}
+	}
+}
+
+// computeSigningRoot computes the hash tree root of the SigningData container
+// of the given object root and domain.
+func computeSigningRoot(root common.Root, domain common.Domain) common.Root {
+	return sha256.Sum256(append(root[:], domain[:]...))
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+var errBadSignature = errors.New("bad signature")
+
+func acceptSignature(common.Root) error { return nil }
+
+func rejectSignature(common.Root) error { return errBadSignature }
+
+func TestProposalsRequireABlockSignedForTheRound(t *testing.T) {
+	p := newProposals[string, string, string]()
+	ctx := context.Background()
+
+	first, done := p.setPending(ctx, 5, "round 0")
+	require.Equal(t, first, p.pendingFor(5))
+	require.Nil(t, p.pendingFor(6))
+	p.add(5, &proposal[string, string]{
+		blk: "block 0", round: first.round, bz: []byte("block 0"),
+	})
+	require.Nil(t, p.signedFor(first))
+	require.NoError(t, p.sign([]byte("block 0"), acceptSignature))
+	require.Equal(t, "block 0", p.signedFor(first).blk)
+	done()
+	require.Nil(t, p.pendingFor(5))
+
+	// The block signed in the first round carries its consensus time, so
+	// the slot proposed again waits for a block produced for the new round.
+	second, done := p.setPending(ctx, 5, "round 1")
+	defer done()
+	require.Nil(t, p.signedFor(second))
+	p.add(5, &proposal[string, string]{
+		blk: "block 1", round: second.round, bz: []byte("block 1"),
+	})
+	require.ErrorIs(t,
+		p.sign([]byte("block 0"), acceptSignature), ErrUnknownProposal,
+	)
+	require.NoError(t, p.sign([]byte("block 1"), acceptSignature))
+	require.Equal(t, "block 1", p.signedFor(second).blk)
+}
+
+func TestProposalsRejectInvalidSignatures(t *testing.T) {
+	p := newProposals[string, string, string]()
+	pending, done := p.setPending(context.Background(), 5, "round 0")
+	defer done()
+	p.add(5, &proposal[string, string]{
+		blk: "block", round: pending.round, bz: []byte("block"),
+	})
+
+	err := p.sign([]byte("block"), rejectSignature)
+	require.ErrorIs(t, err, ErrInvalidBlockSignature)
+	require.ErrorIs(t, err, errBadSignature)
+	require.Nil(t, p.signedFor(pending))
+
+	require.ErrorIs(t,
+		p.sign([]byte("other"), acceptSignature), ErrUnknownProposal,
+	)
+	require.Nil(t, p.signedFor(pending))
+}
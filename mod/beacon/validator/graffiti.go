@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+)
+
+const (
+	// consensusClientCode is the two letter code identifying beacon-kit.
+	consensusClientCode = "BK"
+	// commitPrefixLength is the number of hex characters of the execution
+	// client commit included in the graffiti.
+	commitPrefixLength = 4
+)
+
+// graffiti returns the graffiti of the blocks built by the node, as set in
+// the proposer settings. If enabled, the graffiti is suffixed with the client
+// version, e.g. "GEabcdBK" for geth at commit abcd..., if it fits into the
+// graffiti field.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) graffiti() string {
+	graffiti := s.proposerSettings.Graffiti(s.signer.PublicKey())
+	if !s.cfg.GraffitiClientVersion {
+		return graffiti
+	}
+
+	v := s.executionClient.ClientVersion()
+	if v == nil {
+		return graffiti
+	}
+
+	commit := strings.TrimPrefix(v.Commit, "0x")
+	if len(commit) > commitPrefixLength {
+		commit = commit[:commitPrefixLength]
+	}
+	version := v.Code + commit + consensusClientCode
+
+	switch {
+	case graffiti == "":
+		return version
+	case len(graffiti)+1+len(version) <= bytes.B32Size:
+		return graffiti + " " + version
+	default:
+		return graffiti
+	}
+}
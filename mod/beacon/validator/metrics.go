@@ -26,6 +26,13 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+const (
+	// payloadSourceLocal labels payloads built by the local builder.
+	payloadSourceLocal = "local"
+	// payloadSourceRelay labels payloads built by the relay's builders.
+	payloadSourceRelay = "relay"
+)
+
 // validatorMetrics is a struct that contains metrics for the chain.
 type validatorMetrics struct {
 	// sink is the sink for the metrics.
@@ -74,3 +81,69 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// markPayloadSource increments the counter for the number of blocks built
+// with a payload from the given source.
+func (cm *validatorMetrics) markPayloadSource(source string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.payload_source", "source", source,
+	)
+}
+
+// markEmptyPayloadFallback increments the counter for the number of blocks
+// proposed with an empty payload since the payload could not be retrieved.
+func (cm *validatorMetrics) markEmptyPayloadFallback(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.empty_payload_fallback",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// markBlobPolicyViolation increments the counter for the number of local
+// payloads replaced for violating the blob policy.
+func (cm *validatorMetrics) markBlobPolicyViolation(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.blob_policy_violation",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// setReadiness sets the gauge of the readiness of the execution client to
+// build payloads, whose value is the readiness state.
+func (cm *validatorMetrics) setReadiness(state ReadinessState) {
+	cm.sink.SetGauge(
+		"beacon_kit.validator.proposer_readiness",
+		int64(state),
+	)
+}
+
+// markReadinessTransition increments the counter for the number of
+// transitions between readiness states.
+func (cm *validatorMetrics) markReadinessTransition(
+	from, to ReadinessState,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.proposer_readiness_transition",
+		"from",
+		from.String(),
+		"to",
+		to.String(),
+	)
+}
+
+// markPayloadBuildSkipped increments the counter for the number of
+// proposals whose payload was not built locally since the execution client
+// was not ready.
+func (cm *validatorMetrics) markPayloadBuildSkipped(
+	slot math.Slot, state ReadinessState,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.payload_build_skipped",
+		"slot",
+		slot.Base10(),
+		"readiness",
+		state.String(),
+	)
+}
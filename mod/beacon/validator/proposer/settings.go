@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// Settings are the settings used for the proposals of a validator.
+type Settings struct {
+	// FeeRecipient is the address receiving the fees of the proposals.
+	FeeRecipient common.ExecutionAddress
+	// Graffiti is the graffiti included in the proposed blocks.
+	Graffiti string
+	// GasLimit is the gas limit target registered with the relay.
+	GasLimit uint64
+	// BuilderEnabled determines if payloads are requested from the relay.
+	BuilderEnabled bool
+}
+
+// File is the proposer configuration file. It holds the settings of
+// validators keyed by their public key, and the settings of validators
+// without an entry of their own.
+type File struct {
+	// ProposerConfig holds the settings of the validators by public key.
+	ProposerConfig map[crypto.BLSPubkey]*Entry `json:"proposer_config"`
+	// DefaultConfig holds the settings of validators without an entry.
+	DefaultConfig *Entry `json:"default_config"`
+}
+
+// Entry is an entry of the proposer configuration file. Settings left out
+// of an entry fall back to the default entry, and then to the node
+// configuration.
+type Entry struct {
+	// FeeRecipient is the address receiving the fees of the proposals.
+	FeeRecipient *common.ExecutionAddress `json:"fee_recipient,omitempty"`
+	// Graffiti is the graffiti included in the proposed blocks.
+	Graffiti *string `json:"graffiti,omitempty"`
+	// Builder holds the settings for payloads requested from the relay.
+	Builder *BuilderEntry `json:"builder,omitempty"`
+}
+
+// BuilderEntry holds the settings for payloads requested from the relay.
+type BuilderEntry struct {
+	// Enabled determines if payloads are requested from the relay.
+	Enabled *bool `json:"enabled,omitempty"`
+	// GasLimit is the gas limit target registered with the relay.
+	GasLimit *uint64 `json:"gas_limit,string,omitempty"`
+}
+
+// apply overrides the settings with the fields set in the entry.
+func (e *Entry) apply(s *Settings) {
+	if e == nil {
+		return
+	}
+	if e.FeeRecipient != nil {
+		s.FeeRecipient = *e.FeeRecipient
+	}
+	if e.Graffiti != nil {
+		s.Graffiti = *e.Graffiti
+	}
+	if e.Builder == nil {
+		return
+	}
+	if e.Builder.Enabled != nil {
+		s.BuilderEnabled = *e.Builder.Enabled
+	}
+	if e.Builder.GasLimit != nil {
+		s.GasLimit = *e.Builder.GasLimit
+	}
+}
+
+// validate returns an error if the graffiti of an entry of the file does
+// not fit the graffiti field of a block.
+func (f *File) validate() error {
+	if err := f.DefaultConfig.validate(); err != nil {
+		return errors.Wrap(err, "default_config")
+	}
+	for pubkey, entry := range f.ProposerConfig {
+		if err := entry.validate(); err != nil {
+			return errors.Wrap(err, pubkey.String())
+		}
+	}
+	return nil
+}
+
+// validate returns an error if the graffiti of the entry does not fit the
+// graffiti field of a block.
+func (e *Entry) validate() error {
+	if e == nil || e.Graffiti == nil {
+		return nil
+	}
+	return validateGraffiti(*e.Graffiti)
+}
+
+// validateGraffiti returns an error if the graffiti does not fit the
+// graffiti field of a block.
+func validateGraffiti(graffiti string) error {
+	if len(graffiti) > bytes.B32Size {
+		return errors.Wrapf(
+			ErrGraffitiTooLong, "%d bytes, at most %d allowed",
+			len(graffiti), bytes.B32Size,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/filewatch"
+)
+
+// Store holds the proposer settings of the validators of the node. It
+// resolves the settings of a validator from the fee recipients set at
+// runtime, the proposer configuration file and the node configuration, in
+// that order, and reloads the file whenever it changes.
+type Store struct {
+	// path is the path of the proposer configuration file, if any.
+	path string
+	// logger is the logger for the store.
+	logger log.Logger
+	// fallback holds the settings of the node configuration.
+	fallback Settings
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// file is the last valid proposer configuration file read.
+	file *File
+	// feeRecipients holds the fee recipients set at runtime, which take
+	// precedence over the file until the node restarts.
+	feeRecipients map[crypto.BLSPubkey]common.ExecutionAddress
+}
+
+// New creates a new proposer settings store, falling back to the given
+// settings. If a path is given, the proposer configuration file is read
+// from it.
+func New(path string, fallback Settings, logger log.Logger) (*Store, error) {
+	if err := validateGraffiti(fallback.Graffiti); err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		path:          path,
+		logger:        logger,
+		fallback:      fallback,
+		file:          &File{},
+		feeRecipients: make(map[crypto.BLSPubkey]common.ExecutionAddress),
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	file, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s.file = file
+	return s, nil
+}
+
+// Name returns the name of the store.
+func (s *Store) Name() string {
+	return "proposer-settings"
+}
+
+// Start watches the proposer configuration file for changes, if any.
+func (s *Store) Start(ctx context.Context) error {
+	if s.path == "" {
+		return nil
+	}
+
+	go func() {
+		if err := filewatch.Watch(
+			ctx, s.path, readFile, s.apply, func(err error) {
+				s.logger.Warn(
+					"Ignoring proposer config change",
+					"path", s.path, "err", err,
+				)
+			},
+		); err != nil {
+			s.logger.Error(
+				"Failed to watch proposer config", "path", s.path, "err", err,
+			)
+		}
+	}()
+	return nil
+}
+
+// Settings returns the settings of the validator with the given public key.
+func (s *Store) Settings(pubkey crypto.BLSPubkey) Settings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	settings := s.fallback
+	s.file.DefaultConfig.apply(&settings)
+	s.file.ProposerConfig[pubkey].apply(&settings)
+	if feeRecipient, ok := s.feeRecipients[pubkey]; ok {
+		settings.FeeRecipient = feeRecipient
+	}
+	return settings
+}
+
+// FeeRecipient returns the fee recipient of the validator with the given
+// public key.
+func (s *Store) FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress {
+	return s.Settings(pubkey).FeeRecipient
+}
+
+// Graffiti returns the graffiti of the validator with the given public key.
+func (s *Store) Graffiti(pubkey crypto.BLSPubkey) string {
+	return s.Settings(pubkey).Graffiti
+}
+
+// GasLimit returns the gas limit target of the validator with the given
+// public key.
+func (s *Store) GasLimit(pubkey crypto.BLSPubkey) uint64 {
+	return s.Settings(pubkey).GasLimit
+}
+
+// BuilderEnabled returns true if the validator with the given public key
+// requests payloads from the relay.
+func (s *Store) BuilderEnabled(pubkey crypto.BLSPubkey) bool {
+	return s.Settings(pubkey).BuilderEnabled
+}
+
+// SetFeeRecipient sets the fee recipient of the validator with the given
+// public key until the node restarts.
+func (s *Store) SetFeeRecipient(
+	pubkey crypto.BLSPubkey,
+	feeRecipient common.ExecutionAddress,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.feeRecipients[pubkey] = feeRecipient
+}
+
+// apply replaces the proposer configuration file with the given file.
+func (s *Store) apply(file *File) {
+	s.mu.Lock()
+	s.file = file
+	s.mu.Unlock()
+	s.logger.Info(
+		"Reloaded proposer config",
+		"path", s.path,
+		"validators", len(file.ProposerConfig),
+	)
+}
+
+// readFile reads and validates the proposer configuration file at the
+// given path.
+func readFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := new(File)
+	if err = json.Unmarshal(data, file); err != nil {
+		return nil, err
+	}
+	if err = file.validate(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
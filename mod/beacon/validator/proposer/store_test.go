@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator/proposer"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testFile = `{
+  "proposer_config": {
+    "%s": {
+      "fee_recipient": "0x0000000000000000000000000000000000000001",
+      "builder": {"enabled": true, "gas_limit": "36000000"}
+    }
+  },
+  "default_config": {
+    "fee_recipient": "0x0000000000000000000000000000000000000002",
+    "graffiti": "default"
+  }
+}`
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposer.json")
+	require.NoError(t, os.WriteFile(
+		path, []byte(fmt.Sprintf(testFile, crypto.BLSPubkey{1})), 0o600,
+	))
+
+	fallback := proposer.Settings{
+		FeeRecipient: common.ExecutionAddress{3},
+		Graffiti:     "node",
+		GasLimit:     30_000_000,
+	}
+	store, err := proposer.New(path, fallback, noop.NewLogger[any]())
+	require.NoError(t, err)
+
+	// Settings of the entry override the default entry.
+	require.Equal(t, proposer.Settings{
+		FeeRecipient:   common.ExecutionAddress{19: 1},
+		Graffiti:       "default",
+		GasLimit:       36_000_000,
+		BuilderEnabled: true,
+	}, store.Settings(crypto.BLSPubkey{1}))
+
+	// Validators without an entry use the default entry.
+	require.Equal(t, proposer.Settings{
+		FeeRecipient: common.ExecutionAddress{19: 2},
+		Graffiti:     "default",
+		GasLimit:     30_000_000,
+	}, store.Settings(crypto.BLSPubkey{2}))
+
+	// Fee recipients set at runtime take precedence over the file.
+	store.SetFeeRecipient(crypto.BLSPubkey{1}, common.ExecutionAddress{4})
+	require.Equal(t,
+		common.ExecutionAddress{4}, store.FeeRecipient(crypto.BLSPubkey{1}),
+	)
+
+	// Graffiti not fitting the graffiti field of a block is rejected.
+	require.NoError(t, os.WriteFile(
+		path, []byte(`{"default_config": {"graffiti": "`+
+			strings.Repeat("g", 33)+`"}}`), 0o600,
+	))
+	_, err = proposer.New(path, fallback, noop.NewLogger[any]())
+	require.ErrorIs(t, err, proposer.ErrGraffitiTooLong)
+
+	// Without a file the node configuration is used.
+	store, err = proposer.New("", fallback, noop.NewLogger[any]())
+	require.NoError(t, err)
+	require.Equal(t, fallback, store.Settings(crypto.BLSPubkey{1}))
+}
@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"sync"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// ReadinessState is the readiness of the execution client to build the
+// payloads of the proposals of this node.
+type ReadinessState uint8
+
+const (
+	// ReadinessUnknown is the state until the execution client first
+	// responds, payloads are built as usual.
+	ReadinessUnknown ReadinessState = iota
+	// ReadinessReady is the state once the execution client validated the
+	// head, payloads are built as usual.
+	ReadinessReady
+	// ReadinessOptimistic is the state once a payload was imported without
+	// being validated by the execution client, proposals fall back to empty
+	// payloads.
+	ReadinessOptimistic
+	// ReadinessSyncing is the state once the execution client reported it
+	// is syncing to the head, payloads are not built locally.
+	ReadinessSyncing
+	// ReadinessUnavailable is the state once the execution client failed to
+	// respond, payloads are not built locally.
+	ReadinessUnavailable
+)
+
+// String returns the name of the readiness state.
+func (s ReadinessState) String() string {
+	switch s {
+	case ReadinessUnknown:
+		return "unknown"
+	case ReadinessReady:
+		return "ready"
+	case ReadinessOptimistic:
+		return "optimistic"
+	case ReadinessSyncing:
+		return "syncing"
+	case ReadinessUnavailable:
+		return "unavailable"
+	default:
+		return "invalid"
+	}
+}
+
+// canBuild returns true if payloads can be built locally in the state, be
+// it only empty ones.
+func (s ReadinessState) canBuild() bool {
+	return s != ReadinessSyncing && s != ReadinessUnavailable
+}
+
+// canBuildFull returns true if payloads with transactions can be built
+// locally in the state.
+func (s ReadinessState) canBuildFull() bool {
+	return s == ReadinessUnknown || s == ReadinessReady
+}
+
+// notReadyError returns the error of payloads not built in the state.
+func notReadyError(state ReadinessState) error {
+	return errors.Wrapf(
+		ErrExecutionClientNotReady, "proposer readiness %s", state,
+	)
+}
+
+// Readiness tracks the readiness of the execution client to build the
+// payloads of the proposals of this node, driven by the sync status the
+// execution client reports on forkchoice updates and new payloads.
+type Readiness struct {
+	// logger is the logger of the readiness.
+	logger log.Logger
+	// metrics reports the readiness.
+	metrics *validatorMetrics
+	// mu protects the state.
+	mu sync.RWMutex
+	// state is the current readiness state.
+	state ReadinessState
+}
+
+// NewReadiness creates a new readiness in the unknown state.
+func NewReadiness(logger log.Logger, ts TelemetrySink) *Readiness {
+	r := &Readiness{
+		logger:  logger,
+		metrics: newValidatorMetrics(ts),
+	}
+	r.metrics.setReadiness(r.state)
+	return r
+}
+
+// State returns the current readiness state.
+func (r *Readiness) State() ReadinessState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.state
+}
+
+// Health returns an error if the execution client is not ready to build
+// payloads.
+func (r *Readiness) Health() error {
+	if state := r.State(); !state.canBuildFull() {
+		return notReadyError(state)
+	}
+	return nil
+}
+
+// ObserveForkchoiceUpdate updates the readiness with the outcome of a
+// forkchoice update. The execution client caught up once it validates the
+// head again.
+func (r *Readiness) ObserveForkchoiceUpdate(err error) {
+	switch {
+	case err == nil:
+		r.transition(ReadinessReady)
+	case errors.IsAny(
+		err,
+		engineerrors.ErrSyncingPayloadStatus,
+		engineerrors.ErrAcceptedPayloadStatus,
+	):
+		r.transition(ReadinessSyncing)
+	case !isResponseError(err):
+		r.transition(ReadinessUnavailable)
+	}
+}
+
+// ObserveNewPayload updates the readiness with the outcome of a new
+// payload. A validated payload does not end syncing, which only a
+// forkchoice update of the head does.
+func (r *Readiness) ObserveNewPayload(err error) {
+	r.update(func(from ReadinessState) ReadinessState {
+		switch {
+		case err == nil:
+			if from != ReadinessSyncing {
+				return ReadinessReady
+			}
+		case errors.IsAny(
+			err,
+			engineerrors.ErrSyncingPayloadStatus,
+			engineerrors.ErrAcceptedPayloadStatus,
+		):
+			if from != ReadinessSyncing {
+				return ReadinessOptimistic
+			}
+		case !isResponseError(err):
+			return ReadinessUnavailable
+		}
+		return from
+	})
+}
+
+// transition moves the readiness to the given state.
+func (r *Readiness) transition(state ReadinessState) {
+	r.update(func(ReadinessState) ReadinessState { return state })
+}
+
+// update moves the readiness to the state next returns for the current
+// state. The current state is read and updated under a single lock, so that
+// concurrent observations do not overwrite each other.
+func (r *Readiness) update(next func(from ReadinessState) ReadinessState) {
+	r.mu.Lock()
+	from := r.state
+	state := next(from)
+	r.state = state
+	r.mu.Unlock()
+	if from == state {
+		return
+	}
+
+	r.metrics.setReadiness(state)
+	r.metrics.markReadinessTransition(from, state)
+	switch {
+	case state.canBuildFull():
+		r.logger.Info(
+			"Execution client is ready, building payloads",
+			"from", from, "to", state,
+		)
+	case state.canBuild():
+		r.logger.Warn(
+			"Execution client is not ready, building empty payloads",
+			"from", from, "to", state,
+		)
+	default:
+		r.logger.Warn(
+			"Execution client is not ready, skipping local payload builds",
+			"from", from, "to", state,
+		)
+	}
+}
+
+// isResponseError returns true if the error tells nothing about the sync
+// status of the execution client, either because it rejected a payload,
+// which it only does once it is synced enough to validate it, or because
+// the request was cancelled by this node.
+func isResponseError(err error) bool {
+	return errors.IsAny(
+		err,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+		context.Canceled,
+	)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/stretchr/testify/require"
+)
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string)        {}
+func (noopSink) SetGauge(string, int64, ...string)         {}
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+func TestReadiness(t *testing.T) {
+	r := validator.NewReadiness(noop.NewLogger[any](), noopSink{})
+	require.Equal(t, validator.ReadinessUnknown, r.State())
+	require.NoError(t, r.Health())
+
+	// An optimistic import only allows empty payloads.
+	r.ObserveNewPayload(engineerrors.ErrAcceptedPayloadStatus)
+	require.Equal(t, validator.ReadinessOptimistic, r.State())
+	require.ErrorIs(t, r.Health(), validator.ErrExecutionClientNotReady)
+
+	// A syncing head is only left on a validated forkchoice update.
+	r.ObserveForkchoiceUpdate(engineerrors.ErrSyncingPayloadStatus)
+	require.Equal(t, validator.ReadinessSyncing, r.State())
+	r.ObserveNewPayload(nil)
+	require.Equal(t, validator.ReadinessSyncing, r.State())
+	r.ObserveForkchoiceUpdate(nil)
+	require.Equal(t, validator.ReadinessReady, r.State())
+
+	// An invalid payload tells nothing about the sync status.
+	r.ObserveNewPayload(engineerrors.ErrInvalidPayloadStatus)
+	require.Equal(t, validator.ReadinessReady, r.State())
+
+	// An unreachable client recovers on its next response.
+	r.ObserveForkchoiceUpdate(errors.New("connection refused"))
+	require.Equal(t, validator.ReadinessUnavailable, r.State())
+	require.Error(t, r.Health())
+	r.ObserveNewPayload(nil)
+	require.Equal(t, validator.ReadinessReady, r.State())
+	require.NoError(t, r.Health())
+}
@@ -0,0 +1,389 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// registrationInterval is the interval at which the validator renews
+	// its registration with the relay.
+	registrationInterval = 5 * time.Minute
+	// boostFactorDenominator is the denominator of the builder boost
+	// factor, which is given in percent.
+	boostFactorDenominator = 100
+	// gasLimitBoundDivisor bounds the change of the gas limit between
+	// consecutive payloads.
+	gasLimitBoundDivisor = 1024
+	// minGasLimit is the minimum gas limit of a payload.
+	minGasLimit = 5000
+)
+
+// retrieveExecutionPayload retrieves the execution payload for the block
+// following the given consensus time of its slot.
+// If a relay is configured and enabled for the proposer, its bid is requested
+// while the local payload is retrieved and the bid is returned along with
+// the local payload if it is worth more than the local payload. The payload
+// of the bid is revealed only once the block is assembled, the local payload
+// stands in for it until then.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _, _,
+]) retrieveExecutionPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	consensusTime math.U64,
+) (
+	engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	engineprimitives.BuilderBid[ExecutionPayloadHeaderT],
+	error,
+) {
+	if s.relay == nil ||
+		!s.proposerSettings.BuilderEnabled(s.signer.PublicKey()) {
+		local, err := s.retrieveLocalPayload(ctx, st, blk, consensusTime)
+		return local, nil, err
+	}
+
+	// The bid is requested without touching the state, which is in use by
+	// the local payload builder in the meantime.
+	expected, err := s.expectedBid(st, blk.GetSlot(), consensusTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	bids := make(
+		chan engineprimitives.BuilderBid[ExecutionPayloadHeaderT], 1,
+	)
+	go func() {
+		bids <- s.requestBid(ctx, blk.GetSlot(), expected)
+	}()
+
+	// The state root of the blinded block is computed with the local
+	// payload standing in for the payload of the bid, so the bid cannot be
+	// used without it.
+	local, localErr := s.retrieveLocalPayload(ctx, st, blk, consensusTime)
+	bid := <-bids
+	if bid == nil || localErr != nil || !s.bidBeatsLocal(bid, local) {
+		s.metrics.markPayloadSource(payloadSourceLocal)
+		return local, nil, localErr
+	}
+	return local, bid, nil
+}
+
+// bidAttributes holds the fields of a bid's payload header that are
+// determined by the chain and the proposer rather than the builder.
+type bidAttributes struct {
+	// parentHash is the block hash of the parent payload.
+	parentHash common.ExecutionHash
+	// timestamp is the timestamp of the payload.
+	timestamp math.U64
+	// prevRandao is the randao mix of the payload.
+	prevRandao common.Bytes32
+	// withdrawalsRoot is the root of the withdrawals of the payload.
+	withdrawalsRoot common.Root
+	// gasLimit is the gas limit of the payload.
+	gasLimit math.U64
+}
+
+// expectedBid returns the attributes a bid for the payload of the slot must
+// match, which are the attributes the local payload is built with.
+func (s *Service[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
+]) expectedBid(
+	st BeaconStateT,
+	slot math.Slot,
+	consensusTime math.U64,
+) (*bidAttributes, error) {
+	// The latest execution payload header will be from the previous block
+	// during the block building phase.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	prevRandao, err := st.GetRandaoMixAtIndex(
+		s.chainSpec.SlotToEpoch(slot).Unwrap() %
+			s.chainSpec.EpochsPerHistoricalVector(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedWithdrawals, err := st.ExpectedWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	withdrawals := make(
+		engineprimitives.Withdrawals, 0, len(expectedWithdrawals),
+	)
+	for _, w := range expectedWithdrawals {
+		withdrawals = append(withdrawals, &engineprimitives.Withdrawal{
+			Index:     w.GetIndex(),
+			Validator: w.GetValidatorIndex(),
+			Address:   w.GetAddress(),
+			Amount:    w.GetAmount(),
+		})
+	}
+
+	return &bidAttributes{
+		parentHash: lph.GetBlockHash(),
+		timestamp: math.U64(
+			payloadTimestamp(consensusTime, lph.GetTimestamp()),
+		),
+		prevRandao:      prevRandao,
+		withdrawalsRoot: withdrawals.HashTreeRoot(),
+		gasLimit: math.U64(expectedGasLimit(
+			lph.GetGasLimit().Unwrap(),
+			s.proposerSettings.GasLimit(s.signer.PublicKey()),
+		)),
+	}, nil
+}
+
+// requestBid requests a bid from the relay for the payload of the slot
+// with the given attributes. It returns nil if the relay does not respond
+// in time or its bid is not valid.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, ExecutionPayloadHeaderT, _, _, _, _,
+]) requestBid(
+	ctx context.Context,
+	slot math.Slot,
+	expected *bidAttributes,
+) engineprimitives.BuilderBid[ExecutionPayloadHeaderT] {
+	bid, err := s.relay.GetHeader(
+		ctx, s.builderDomain(), slot, expected.parentHash,
+	)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to get bid from relay",
+			"slot", slot.Base10(),
+			"error", err,
+		)
+		return nil
+	}
+
+	header := bid.GetHeader()
+	if bid.GetValue() == nil ||
+		header.GetParentHash() != expected.parentHash ||
+		header.GetTimestamp() != expected.timestamp ||
+		header.GetPrevRandao() != expected.prevRandao ||
+		header.GetWithdrawalsRoot() != expected.withdrawalsRoot ||
+		header.GetGasLimit() != expected.gasLimit ||
+		uint64(len(bid.GetBlobKZGCommitments())) > s.maxBlobsPerBlock() {
+		s.logger.Warn(
+			"Ignoring invalid bid from relay",
+			"slot", slot.Base10(),
+			"block_hash", header.GetBlockHash(),
+		)
+		return nil
+	}
+	return bid
+}
+
+// expectedGasLimit returns the gas limit of a payload built on top of a
+// payload with the given gas limit by an execution client targeting the
+// given gas limit. The execution client moves the gas limit towards the
+// target by less than 1/1024 of the parent gas limit per block.
+func expectedGasLimit(parent, target uint64) uint64 {
+	target = max(target, minGasLimit)
+	delta := max(parent/gasLimitBoundDivisor, 1) - 1
+	switch {
+	case target > parent:
+		return min(parent+delta, target)
+	case target < parent:
+		return max(parent-delta, target)
+	default:
+		return parent
+	}
+}
+
+// bidBeatsLocal returns true if the value of the bid, multiplied by the
+// builder boost factor, exceeds the value of the local payload.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _, _,
+]) bidBeatsLocal(
+	bid engineprimitives.BuilderBid[ExecutionPayloadHeaderT],
+	local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) bool {
+	// The execution client asks for its payload to be used, e.g. when it
+	// detects censorship by the builders.
+	if local.ShouldOverrideBuilder() {
+		return false
+	}
+
+	localValue := local.GetValue()
+	if localValue == nil {
+		localValue = math.NewU256(0)
+	}
+	boosted := new(math.U256).Mul(
+		bid.GetValue(), math.NewU256(s.cfg.BuilderBoostFactor),
+	)
+	boosted.Div(boosted, math.NewU256(boostFactorDenominator))
+	return boosted.Gt(localValue)
+}
+
+// unblindBid signs the blinded form of the block, which commits to the
+// payload of the bid, and retrieves the execution payload and blobs of the
+// bid from the relay. The body of the block must be assembled with the local
+// payload standing in for the payload of the bid, which it is replaced with
+// once revealed.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, _, _, _,
+]) unblindBid(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	bid engineprimitives.BuilderBid[ExecutionPayloadHeaderT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	body := blk.GetBody()
+	body.SetBlobKzgCommitments(bid.GetBlobKZGCommitments())
+
+	stateRoot, err := s.computeBlindedStateRoot(ctx, st, blk, bid.GetHeader())
+	if err != nil {
+		return nil, err
+	}
+	blk.SetStateRoot(stateRoot)
+
+	var forkData ForkDataT
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	domain := forkData.New(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+		), genesisValidatorsRoot,
+	).ComputeDomain(s.chainSpec.DomainTypeProposer())
+
+	remote, err := s.relay.SubmitBlindedBlock(ctx, domain, blk, bid)
+	if err != nil {
+		return nil, err
+	}
+	body.SetExecutionPayload(remote.GetExecutionPayload())
+
+	s.logger.Info(
+		"Using payload from relay",
+		"slot", blk.GetSlot().Base10(),
+		"block_hash", bid.GetHeader().GetBlockHash(),
+		"value", bid.GetValue().Dec(),
+	)
+	s.metrics.markPayloadSource(payloadSourceRelay)
+	return remote, nil
+}
+
+// computeBlindedStateRoot computes the state root of the blinded form of the
+// block, which commits to its execution payload by the given header. The
+// body of the block carries a stand-in payload with the withdrawals expected
+// by the state, so the transition of the block differs from the one of its
+// blinded form only in the latest execution payload header and the body
+// root of the latest block header, which are replaced accordingly.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
+	ExecutionPayloadHeaderT, _, _, _, _,
+]) computeBlindedStateRoot(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	header ExecutionPayloadHeaderT,
+) (common.Root, error) {
+	blinded := st.Copy()
+	if _, err := s.computeStateRoot(ctx, blinded, blk); err != nil {
+		return common.Root{}, err
+	}
+
+	if err := blinded.SetLatestExecutionPayloadHeader(header); err != nil {
+		return common.Root{}, err
+	}
+	latestBlockHeader, err := blinded.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	latestBlockHeader.SetBodyRoot(
+		blk.GetBody().HashTreeRootBlinded(header.HashTreeRoot()),
+	)
+	if err = blinded.SetLatestBlockHeader(latestBlockHeader); err != nil {
+		return common.Root{}, err
+	}
+	return blinded.HashTreeRoot(), nil
+}
+
+// registrationLoop registers the validator with the relay on start and
+// renews the registration periodically, picking up changes to the proposer
+// settings.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) registrationLoop(ctx context.Context) {
+	ticker := time.NewTicker(registrationInterval)
+	defer ticker.Stop()
+	for {
+		s.registerValidator(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// registerValidator registers the validator with the relay, if enabled for
+// the validator in the proposer settings.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) registerValidator(ctx context.Context) {
+	pubkey := s.signer.PublicKey()
+	if !s.proposerSettings.BuilderEnabled(pubkey) {
+		return
+	}
+
+	if err := s.relay.RegisterValidator(
+		ctx,
+		s.builderDomain(),
+		s.proposerSettings.FeeRecipient(pubkey),
+		s.proposerSettings.GasLimit(pubkey),
+	); err != nil {
+		s.logger.Error(
+			"Failed to register validator with relay", "error", err,
+		)
+	}
+}
+
+// builderDomain returns the domain of builder API messages, which is
+// independent of the chain's forks and genesis.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, ForkDataT, _, _, _,
+]) builderDomain() common.Domain {
+	var forkData ForkDataT
+	return forkData.New(
+		version.FromUint32[common.Version](
+			s.chainSpec.ActiveForkVersionForEpoch(0),
+		), common.Root{},
+	).ComputeDomain(s.chainSpec.DomainTypeApplicationMask())
+}
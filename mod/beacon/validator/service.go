@@ -24,6 +24,7 @@ import (
 	"context"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -38,16 +39,20 @@ type Service[
 	BeaconBlockBodyT BeaconBlockBody[
 		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, WithdrawalT,
+	],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	WithdrawalT Withdrawal,
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
@@ -57,6 +62,11 @@ type Service[
 	chainSpec common.ChainSpec
 	// signer is used to retrieve the public key of this node.
 	signer crypto.BLSSigner
+	// executionClient identifies the execution client for the graffiti.
+	executionClient ExecutionClient
+	// proposerSettings provides the graffiti and relay settings of the
+	// proposals of this node.
+	proposerSettings ProposerSettings
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT]
 	// sb is the beacon state backend.
@@ -78,6 +88,17 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// relay serves payloads built by external block builders, it is nil
+	// when payloads are only built locally.
+	relay Relay[BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT]
+	// inclusionLists checks the local payloads against the inclusion lists
+	// the peers check the proposals against.
+	inclusionLists InclusionListVerifier[ExecutionPayloadT]
+	// proposals tracks the blocks produced for the external signer.
+	proposals *proposals[BeaconBlockT, BlobSidecarsT, SlotDataT]
+	// readiness tracks the readiness of the execution client to build
+	// payloads.
+	readiness *Readiness
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 	// subNewSlot is a channel to hold NewSlot events.
@@ -91,16 +112,20 @@ func NewService[
 	BeaconBlockBodyT BeaconBlockBody[
 		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, WithdrawalT,
+	],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	WithdrawalT Withdrawal,
 ](
 	cfg *Config,
 	logger log.Logger,
@@ -113,40 +138,54 @@ func NewService[
 		ExecutionPayloadHeaderT,
 	],
 	signer crypto.BLSSigner,
+	executionClient ExecutionClient,
+	proposerSettings ProposerSettings,
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	relay Relay[BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT],
+	inclusionLists InclusionListVerifier[ExecutionPayloadT],
+	readiness *Readiness,
 	ts TelemetrySink,
 	dispatcher asynctypes.EventDispatcher,
 ) *Service[
-	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
-	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT,
+	SlotDataT, WithdrawalT,
 ] {
 	return &Service[
-		AttestationDataT, BeaconBlockT, BeaconBlockBodyT,
+		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT,
-		SlotDataT,
+		SlotDataT, WithdrawalT,
 	]{
 		cfg:                   cfg,
 		logger:                logger,
 		sb:                    sb,
 		chainSpec:             chainSpec,
 		signer:                signer,
+		executionClient:       executionClient,
+		proposerSettings:      proposerSettings,
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
-		metrics:               newValidatorMetrics(ts),
-		dispatcher:            dispatcher,
-		subNewSlot:            make(chan async.Event[SlotDataT]),
+		relay:                 relay,
+		inclusionLists:        inclusionLists,
+		proposals: newProposals[
+			BeaconBlockT, BlobSidecarsT, SlotDataT,
+		](),
+		readiness:  readiness,
+		metrics:    newValidatorMetrics(ts),
+		dispatcher: dispatcher,
+		subNewSlot: make(chan async.Event[SlotDataT]),
 	}
 }
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "validator"
 }
@@ -154,7 +193,7 @@ func (s *Service[
 // Start listens for NewSlot events and builds a block and sidecars for the
 // requested slot data.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
 	ctx context.Context,
 ) error {
@@ -163,13 +202,17 @@ func (s *Service[
 	if err != nil {
 		return err
 	}
+	// keep the validator registered with the relay, if any.
+	if s.relay != nil {
+		go s.registrationLoop(ctx)
+	}
 	// start the event loop to listen and handle events.
 	go s.eventLoop(ctx)
 	return nil
 }
 
 // eventLoop is the main event loop for the validator service.
-func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _]) eventLoop(
+func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _, _, _]) eventLoop(
 	ctx context.Context,
 ) {
 	for {
@@ -182,22 +225,35 @@ func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _]) eventLoop(
 	}
 }
 
-// handleNewSlot builds a block and sidecars for the requested slot data and
-// emits BuiltBeaconBlock and BuiltSidecars events containing the built block
-// and sidecars.
+// handleNewSlot builds a block and sidecars for the requested slot data, or
+// waits for the external signer to publish them if enabled, and emits
+// BuiltBeaconBlock and BuiltSidecars events containing the built block and
+// sidecars.
 func (s *Service[
-	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	_, BeaconBlockT, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT, _,
 ]) handleNewSlot(req async.Event[SlotDataT]) {
 	var (
 		blk      BeaconBlockT
 		sidecars BlobSidecarsT
 		err      error
 	)
-	// build the block and sidecars for the requested slot data
-	blk, sidecars, err = s.buildBlockAndSidecars(
-		req.Context(), req.Data(),
-	)
-	if err != nil {
+	if s.cfg.ExternalSigner {
+		// use the block signed by the external signer for the slot
+		blk, sidecars, err = s.awaitSignedBlock(req.Context(), req.Data())
+	} else {
+		// build the block and sidecars for the requested slot data
+		blk, sidecars, err = s.buildBlockAndSidecars(
+			req.Context(), req.Data(),
+		)
+	}
+	switch {
+	case errors.Is(err, ErrExecutionClientNotReady):
+		s.logger.Warn(
+			"Skipping block proposal, execution client not ready",
+			"slot", req.Data().GetSlot().Base10(),
+			"err", err,
+		)
+	case err != nil:
 		s.logger.Error("failed to build block", "err", err)
 	}
 
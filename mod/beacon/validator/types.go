@@ -38,7 +38,7 @@ type BeaconBlock[
 	T any,
 	BeaconBlockBodyT any,
 ] interface {
-	constraints.SSZMarshallable
+	constraints.SSZMarshallableRootable
 	// NewWithVersion creates a new beacon block with the given parameters.
 	NewWithVersion(
 		slot math.Slot,
@@ -48,6 +48,8 @@ type BeaconBlock[
 	) (T, error)
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the proposer of the beacon block.
+	GetProposerIndex() math.ValidatorIndex
 	// GetParentBlockRoot returns the parent block root of the beacon block.
 	GetParentBlockRoot() common.Root
 	// SetStateRoot sets the state root of the beacon block.
@@ -81,17 +83,43 @@ type BeaconBlockBody[
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// HashTreeRootBlinded returns the hash tree root of the beacon block
+	// body with its execution payload replaced by the header with the given
+	// hash tree root.
+	HashTreeRootBlinded(common.Root) common.Root
+}
+
+// BeaconBlockHeader represents a beacon block header interface.
+type BeaconBlockHeader interface {
+	// SetBodyRoot sets the body root of the beacon block header.
+	SetBodyRoot(common.Root)
 }
 
 // BeaconState represents a beacon state interface.
-type BeaconState[ExecutionPayloadHeaderT any] interface {
+type BeaconState[
+	BeaconStateT, BeaconBlockHeaderT, ExecutionPayloadHeaderT, WithdrawalT any,
+] interface {
+	// Copy returns a copy of the beacon state.
+	Copy() BeaconStateT
+	// ExpectedWithdrawals returns the withdrawals expected in the next
+	// execution payload.
+	ExpectedWithdrawals() ([]WithdrawalT, error)
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
+	// GetLatestBlockHeader returns the latest block header.
+	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
+	// SetLatestBlockHeader sets the latest block header.
+	SetLatestBlockHeader(BeaconBlockHeaderT) error
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
+	// SetLatestExecutionPayloadHeader sets the latest execution payload
+	// header.
+	SetLatestExecutionPayloadHeader(ExecutionPayloadHeaderT) error
+	// GetRandaoMixAtIndex returns the randao mix at the given index.
+	GetRandaoMixAtIndex(uint64) (common.Bytes32, error)
 	// GetSlot returns the current slot of the beacon state.
 	GetSlot() (math.Slot, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
@@ -136,6 +164,19 @@ type Eth1Data[T any] interface {
 	) T
 }
 
+// ExecutionClient identifies the execution client connected to the node.
+type ExecutionClient interface {
+	// ClientVersion returns the version of the execution client, or nil if
+	// it is not known.
+	ClientVersion() *engineprimitives.ClientVersionV1
+}
+
+// ExecutionPayload represents the execution payload interface.
+type ExecutionPayload interface {
+	// GetTransactions returns the transactions of the execution payload.
+	GetTransactions() engineprimitives.Transactions
+}
+
 // ExecutionPayloadHeader represents the execution payload header interface.
 type ExecutionPayloadHeader interface {
 	// GetTimestamp returns the timestamp of the execution payload header.
@@ -144,6 +185,16 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash of the execution payload header.
 	GetParentHash() common.ExecutionHash
+	// GetPrevRandao returns the prev randao of the execution payload header.
+	GetPrevRandao() common.Bytes32
+	// GetWithdrawalsRoot returns the withdrawals root of the execution
+	// payload header.
+	GetWithdrawalsRoot() common.Root
+	// GetGasLimit returns the gas limit of the execution payload header.
+	GetGasLimit() math.U64
+	// HashTreeRoot returns the hash tree root of the execution payload
+	// header.
+	HashTreeRoot() common.Root
 }
 
 // ForkData represents the fork data interface.
@@ -153,6 +204,8 @@ type ForkData[T any] interface {
 		common.Version,
 		common.Root,
 	) T
+	// ComputeDomain computes the signing domain for the given domain type.
+	ComputeDomain(common.DomainType) common.Domain
 	// ComputeRandaoSigningRoot computes the Randao signing root.
 	ComputeRandaoSigningRoot(
 		common.DomainType,
@@ -160,6 +213,16 @@ type ForkData[T any] interface {
 	) common.Root
 }
 
+// InclusionListVerifier checks execution payloads against the inclusion
+// lists of the commits carried by the blocks.
+type InclusionListVerifier[ExecutionPayloadT any] interface {
+	// VerifyPayload returns an error if the payload of the given slot omits
+	// a transaction of the inclusion lists that could have been included.
+	VerifyPayload(
+		ctx context.Context, slot math.Slot, payload ExecutionPayloadT,
+	) error
+}
+
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
 type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
@@ -167,6 +230,7 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 	RetrievePayload(
 		ctx context.Context,
 		slot math.Slot,
+		timestamp uint64,
 		parentBlockRoot common.Root,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 	// RequestPayloadSync requests a payload for the given slot and
@@ -180,6 +244,74 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+	// RequestEmptyPayload requests a payload without transactions for the
+	// given slot from a build distinct from the cached ones.
+	RequestEmptyPayload(
+		ctx context.Context,
+		st BeaconStateT,
+		slot math.Slot,
+		timestamp uint64,
+		parentBlockRoot common.Root,
+		headEth1BlockHash common.ExecutionHash,
+		finalEth1BlockHash common.ExecutionHash,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
+// ProposerSettings provides the settings of the proposers of the node.
+type ProposerSettings interface {
+	// FeeRecipient returns the fee recipient of the given proposer.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+	// Graffiti returns the graffiti of the given proposer.
+	Graffiti(pubkey crypto.BLSPubkey) string
+	// GasLimit returns the gas limit target of the given proposer.
+	GasLimit(pubkey crypto.BLSPubkey) uint64
+	// BuilderEnabled returns true if the given proposer requests payloads
+	// from the relay.
+	BuilderEnabled(pubkey crypto.BLSPubkey) bool
+}
+
+// Relay represents a relay serving execution payloads built by external
+// block builders through the builder API.
+type Relay[
+	BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT any,
+] interface {
+	// RegisterValidator registers the validator with the relay, with the
+	// fee recipient and gas limit target its blocks are built for.
+	RegisterValidator(
+		ctx context.Context,
+		domain common.Domain,
+		feeRecipient common.ExecutionAddress,
+		gasLimit uint64,
+	) error
+	// GetHeader requests the best bid of the relay for the payload built on
+	// top of the given parent hash in the given slot.
+	GetHeader(
+		ctx context.Context,
+		domain common.Domain,
+		slot math.Slot,
+		parentHash common.ExecutionHash,
+	) (engineprimitives.BuilderBid[ExecutionPayloadHeaderT], error)
+	// SubmitBlindedBlock signs the blinded form of the block, which commits
+	// to the payload of the bid, and returns the execution payload revealed
+	// by the relay in exchange.
+	SubmitBlindedBlock(
+		ctx context.Context,
+		domain common.Domain,
+		blk BeaconBlockT,
+		bid engineprimitives.BuilderBid[ExecutionPayloadHeaderT],
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
+// Withdrawal represents a withdrawal of the execution payload.
+type Withdrawal interface {
+	// GetIndex returns the index of the withdrawal.
+	GetIndex() math.U64
+	// GetValidatorIndex returns the index of the withdrawing validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// GetAddress returns the execution address of the withdrawal.
+	GetAddress() common.ExecutionAddress
+	// GetAmount returns the amount of the withdrawal.
+	GetAmount() math.Gwei
 }
 
 // SlotData represents the slot data interface.
@@ -190,6 +322,11 @@ type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	GetAttestationData() []AttestationDataT
 	// GetSlashingInfo returns the slashing info of the incoming slot.
 	GetSlashingInfo() []SlashingInfoT
+	// GetTime returns the consensus time of the incoming slot.
+	GetTime() math.U64
+	// GetEmptyPayload returns true if the block of the incoming slot is to
+	// be built with a payload without transactions.
+	GetEmptyPayload() bool
 }
 
 // StateProcessor defines the interface for processing the state.
@@ -227,6 +364,9 @@ type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
 	// keys.
 	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
@@ -57,6 +57,11 @@ type Spec[
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
 
+	// PayloadTimestampBound returns the maximum difference, in seconds,
+	// between the timestamp of the execution payload of a proposal and the
+	// consensus time of its slot.
+	PayloadTimestampBound() uint64
+
 	// Signature Domains
 
 	// DomainTypeProposer returns the domain for proposer signatures.
@@ -88,6 +93,10 @@ type Spec[
 	// DepositContractAddress returns the deposit contract address.
 	DepositContractAddress() ExecutionAddressT
 
+	// DepositContractDeployBlock returns the execution block the deposit
+	// contract was deployed at.
+	DepositContractDeployBlock() uint64
+
 	// MaxDepositsPerBlock returns the maximum number of deposit operations per
 	// block.
 	MaxDepositsPerBlock() uint64
@@ -102,12 +111,20 @@ type Spec[
 	// TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 	TargetSecondsPerEth1Block() uint64
 
+	// IncompatibleExecutionClients returns the execution clients which are
+	// known to be incompatible with the chain.
+	IncompatibleExecutionClients() []string
+
 	// Fork-related values.
 	// DenebPlusForkEpoch returns the epoch at which the Deneb+ fork takes
 	DenebPlusForkEpoch() EpochT
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
 	// effect.
 	ElectraForkEpoch() EpochT
+	// PayloadTimestampBoundForkEpoch returns the epoch from which the
+	// timestamp of the execution payload of a proposal must be within the
+	// payload timestamp bound of the consensus time of its slot.
+	PayloadTimestampBoundForkEpoch() EpochT
 
 	// State list lengths
 
@@ -183,6 +200,10 @@ type Spec[
 	// GetCometBFTConfigForSlot retrieves the CometBFT config for a specific
 	// slot.
 	GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT
+
+	// VoteExtensionsEnableHeight returns the height from which the
+	// precommits are extended, zero if they are disabled.
+	VoteExtensionsEnableHeight() uint64
 }
 
 // chainSpec is a concrete implementation of the ChainSpec interface, holding
@@ -267,6 +288,15 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// PayloadTimestampBound returns the maximum difference, in seconds, between
+// the timestamp of the execution payload of a proposal and the consensus
+// time of its slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) PayloadTimestampBound() uint64 {
+	return c.Data.PayloadTimestampBound
+}
+
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -331,6 +361,14 @@ func (c chainSpec[
 	return c.Data.DepositContractAddress
 }
 
+// DepositContractDeployBlock returns the execution block the deposit
+// contract was deployed at.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DepositContractDeployBlock() uint64 {
+	return c.Data.DepositContractDeployBlock
+}
+
 // MaxDepositsPerBlock returns the maximum number of deposits per block.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -360,6 +398,14 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
+// IncompatibleExecutionClients returns the execution clients which are known
+// to be incompatible with the chain.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) IncompatibleExecutionClients() []string {
+	return c.Data.IncompatibleExecutionClients
+}
+
 // DenebPlusForEpoch returns the epoch of the Deneb+ fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -374,6 +420,15 @@ func (c chainSpec[
 	return c.Data.ElectraForkEpoch
 }
 
+// PayloadTimestampBoundForkEpoch returns the epoch from which the timestamp
+// of the execution payload of a proposal must be within the payload
+// timestamp bound of the consensus time of its slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) PayloadTimestampBoundForkEpoch() EpochT {
+	return c.Data.PayloadTimestampBoundForkEpoch
+}
+
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -476,3 +531,11 @@ func (c chainSpec[
 ]) GetCometBFTConfigForSlot(_ SlotT) CometBFTConfigT {
 	return c.Data.CometValues
 }
+
+// VoteExtensionsEnableHeight returns the height from which the precommits
+// are extended, zero if they are disabled.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) VoteExtensionsEnableHeight() uint64 {
+	return c.Data.VoteExtensionsEnableHeight
+}
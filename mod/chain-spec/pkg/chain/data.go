@@ -52,6 +52,10 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// PayloadTimestampBound is the maximum difference, in seconds, between
+	// the timestamp of the execution payload of a proposal and the consensus
+	// time of its slot.
+	PayloadTimestampBound uint64 `mapstructure:"payload-timestamp-bound"`
 
 	// Signature domains.
 	//
@@ -78,6 +82,9 @@ type SpecData[
 	//
 	// DepositContractAddress is the address of the deposit contract.
 	DepositContractAddress ExecutionAddressT `mapstructure:"deposit-contract-address"`
+	// DepositContractDeployBlock is the execution block the deposit contract
+	// was deployed at, which the deposits are first synced from.
+	DepositContractDeployBlock uint64 `mapstructure:"deposit-contract-deploy-block"`
 	// MaxDepositsPerBlock specifies the maximum number of deposit operations
 	// allowed per block.
 	MaxDepositsPerBlock uint64 `mapstructure:"max-deposits-per-block"`
@@ -88,6 +95,11 @@ type SpecData[
 	Eth1FollowDistance uint64 `mapstructure:"eth1-follow-distance"`
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
+	// IncompatibleExecutionClients lists the execution clients which are
+	// known to be incompatible with the chain. Each entry is either a client
+	// code, e.g. "GE", matching every version of the client, or a client
+	// code and version separated by a slash, e.g. "GE/1.14.0".
+	IncompatibleExecutionClients []string `mapstructure:"incompatible-execution-clients"`
 
 	// Fork-related values.
 	//
@@ -95,6 +107,10 @@ type SpecData[
 	DenebPlusForkEpoch EpochT `mapstructure:"deneb-plus-fork-epoch"`
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// PayloadTimestampBoundForkEpoch is the epoch from which the timestamp
+	// of the execution payload of a proposal must be within the payload
+	// timestamp bound of the consensus time of its slot.
+	PayloadTimestampBoundForkEpoch EpochT `mapstructure:"payload-timestamp-bound-fork-epoch"`
 
 	// State list lengths
 	//
@@ -146,4 +162,9 @@ type SpecData[
 
 	// CometValues
 	CometValues CometBFTConfigT `mapstructure:"comet-bft-config"`
+	// VoteExtensionsEnableHeight is the height from which the precommits are
+	// extended, zero leaving them disabled. On a running chain it must be
+	// scheduled at a future height, as CometBFT rejects enabling them at a
+	// past or current height.
+	VoteExtensionsEnableHeight uint64 `mapstructure:"vote-extensions-enable-height"`
 }
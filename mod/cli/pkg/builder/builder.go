@@ -53,6 +53,10 @@ type CLIBuilder[
 	// eventually called by the cosmos-sdk.
 	// TODO: CLI should not know about the AppCreator
 	nodeBuilderFunc servertypes.AppCreator[T, LoggerT]
+	// componentInjector is a function that builds components of the Node
+	// without the Node, for the commands working on the data of a stopped
+	// node.
+	componentInjector servertypes.ComponentInjector[LoggerT]
 }
 
 // New returns a new CLIBuilder with the given options.
@@ -113,6 +117,7 @@ func (cb *CLIBuilder[
 		rootCmd,
 		&cometbft.Service[LoggerT]{},
 		cb.nodeBuilderFunc,
+		cb.componentInjector,
 		chainSpec,
 	)
 
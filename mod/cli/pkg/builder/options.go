@@ -79,3 +79,16 @@ func WithNodeBuilderFunc[
 		cb.nodeBuilderFunc = nodeBuilderFunc
 	}
 }
+
+// WithComponentInjector sets the component injector for the CLIBuilder.
+func WithComponentInjector[
+	T types.Node,
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	componentInjector servertypes.ComponentInjector[LoggerT],
+) Opt[T, ExecutionPayloadT, LoggerT] {
+	return func(cb *CLIBuilder[T, ExecutionPayloadT, LoggerT]) {
+		cb.componentInjector = componentInjector
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for moving the blob sidecars of the
+// availability store between nodes and checking them.
+func Commands[LoggerT log.AdvancedLogger[LoggerT]](
+	inject types.ComponentInjector[LoggerT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "blobs",
+		Short:                      "Blob sidecar subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewExportCmd(inject),
+		NewImportCmd(inject),
+		NewVerifyCmd(inject),
+	)
+
+	return cmd
+}
+
+// withComponents builds the given components of the node of the home
+// directory of the command and runs fn once they are built. No service of
+// the node is started.
+func withComponents[LoggerT log.AdvancedLogger[LoggerT]](
+	cmd *cobra.Command,
+	inject types.ComponentInjector[LoggerT],
+	fn func() error,
+	components ...any,
+) error {
+	v := clicontext.GetViperFromCmd(cmd)
+	logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
+	cfg := clicontext.GetConfigFromCmd(cmd)
+
+	db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err = inject(logger, db, cfg, v, components...); err != nil {
+		return err
+	}
+	return fn()
+}
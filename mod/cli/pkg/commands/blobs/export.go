@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagFromSlot is the flag of the first slot to export.
+	FlagFromSlot = "from-slot"
+	// FlagToSlot is the flag of the last slot to export.
+	FlagToSlot = "to-slot"
+)
+
+// NewExportCmd creates a command to export the blob sidecars of a range of
+// slots into an archive.
+func NewExportCmd[LoggerT log.AdvancedLogger[LoggerT]](
+	inject types.ComponentInjector[LoggerT],
+) *cobra.Command {
+	var (
+		from, to uint64
+		archiver BlobArchiver
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export [dir]",
+		Short: "export the blob sidecars of a range of slots",
+		Long: `Exports the blob sidecars of the availability store for the
+slots in [--from-slot, --to-slot] into an archive in the given directory: one
+SSZ encoded file of sidecars per slot holding any, described by a
+manifest.json written last. Slots without sidecars, or whose sidecars were
+pruned, are skipped. The node must be stopped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withComponents(cmd, inject, func() error {
+				m, err := archiver.ExportBlobs(
+					cmd.Context(), args[0], math.Slot(from), math.Slot(to),
+				)
+				if err != nil {
+					return err
+				}
+				cmd.Printf(
+					"Exported %d blob sidecars of %d slots to %s\n",
+					m.NumSidecars(), len(m.Entries), args[0],
+				)
+				return nil
+			}, &archiver)
+		},
+	}
+
+	cmd.Flags().Uint64Var(&from, FlagFromSlot, 0, "first slot to export")
+	cmd.Flags().Uint64Var(&to, FlagToSlot, 0, "last slot to export")
+	_ = cmd.MarkFlagRequired(FlagToSlot)
+	return cmd
+}
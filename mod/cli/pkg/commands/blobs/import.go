@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/spf13/cobra"
+)
+
+// NewImportCmd creates a command to import the blob sidecars of an archive.
+func NewImportCmd[LoggerT log.AdvancedLogger[LoggerT]](
+	inject types.ComponentInjector[LoggerT],
+) *cobra.Command {
+	var (
+		loader   BlockStoreLoader
+		archiver BlobArchiver
+	)
+
+	return &cobra.Command{
+		Use:   "import [dir]",
+		Short: "import the blob sidecars of an archive",
+		Long: `Imports the blob sidecars of the archive in the given directory,
+as written by the export command, into the availability store. The sidecars
+of every slot are checked against the checksums of the manifest, their block
+header must be the one of the block of the slot, and their inclusion and KZG
+proofs are verified before they are stored. The blocks are those of the block
+store, loaded from the last committed blocks up to its availability window,
+so older slots cannot be imported. The import stops at the first slot
+failing, and can be run again. The node must be stopped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withComponents(cmd, inject, func() error {
+				// the sidecars are checked against the blocks of the block
+				// store, which only holds the blocks finalized since the
+				// node started until the committed ones are loaded.
+				if err := loader.LoadBlocks(cmd.Context()); err != nil {
+					return err
+				}
+				m, err := archiver.ImportBlobs(cmd.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				cmd.Printf(
+					"Imported %d blob sidecars of %d slots from %s\n",
+					m.NumSidecars(), len(m.Entries), args[0],
+				)
+				return nil
+			}, &loader, &archiver)
+		},
+	}
+}
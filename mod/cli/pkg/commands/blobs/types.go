@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/integrity"
+	"github.com/berachain/beacon-kit/mod/da/pkg/transfer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BlockStoreLoader loads the blocks committed by CometBFT into the block
+// store, which otherwise only holds the blocks finalized since the node
+// started.
+type BlockStoreLoader interface {
+	// LoadBlocks loads the last blocks committed by CometBFT, as many as the
+	// block store holds. It must not be called on a running node.
+	LoadBlocks(ctx context.Context) error
+}
+
+// BlobArchiver exports and imports the blob sidecars of the availability
+// store.
+type BlobArchiver interface {
+	// ExportBlobs writes the blob sidecars of the slots in [from, to] into
+	// an archive in the given directory. It must not be called on a running
+	// node.
+	ExportBlobs(
+		ctx context.Context,
+		dir string,
+		from, to math.Slot,
+	) (*transfer.Manifest, error)
+	// ImportBlobs verifies and stores the blob sidecars of the archive in
+	// the given directory, which must belong to the blocks of the block
+	// store. It must not be called on a running node.
+	ImportBlobs(ctx context.Context, dir string) (*transfer.Manifest, error)
+}
+
+// BlobChecker checks the blob sidecars of the availability store against
+// the commitments of their slots.
+type BlobChecker interface {
+	// CheckBlobs checks the blob sidecars of the data availability window
+	// ending at the given slot, fetching the missing or corrupt ones again
+	// from the blob API of the node at the given URL if set. It must not be
+	// called on a running node.
+	CheckBlobs(
+		ctx context.Context,
+		head math.Slot,
+		peer string,
+	) (*integrity.Report, error)
+}
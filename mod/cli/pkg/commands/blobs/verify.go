@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/da/pkg/integrity"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstore "github.com/cometbft/cometbft/store"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagHeadSlot is the flag of the slot the checked window ends at.
+	FlagHeadSlot = "head-slot"
+	// FlagRefetchFrom is the flag of the URL of the node API of the peer the
+	// missing or corrupt blob sidecars are fetched again from.
+	FlagRefetchFrom = "refetch-from"
+	// FlagReport is the flag of the path the JSON report is written to.
+	FlagReport = "report"
+)
+
+// NewVerifyCmd creates a command to check the blob sidecars of the data
+// availability window against the commitments of their slots.
+func NewVerifyCmd[LoggerT log.AdvancedLogger[LoggerT]](
+	inject types.ComponentInjector[LoggerT],
+) *cobra.Command {
+	var (
+		head       uint64
+		peer, path string
+		loader     BlockStoreLoader
+		checker    BlobChecker
+	)
+
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "check the blob sidecars of the data availability window",
+		Long: `Checks the blob sidecars of the availability store for the
+slots of the data availability window ending at --head-slot, or at the last
+committed block if unset: every commitment of a slot must have a stored
+sidecar holding it, included by its proof in the block of the slot and
+matching its blob by its KZG proof. The missing or corrupt sidecars are
+reported, and fetched again from the blob API of the node at --refetch-from
+if set. The fetched sidecars must belong to the block of their slot in the
+block store, loaded from the last committed blocks up to its availability
+window, so the sidecars of older slots cannot be fetched again. The node must
+be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if head == 0 {
+				height, err := latestHeight(cmd)
+				if err != nil {
+					return err
+				}
+				head = height
+			}
+			return withComponents(cmd, inject, func() error {
+				// the fetched sidecars are checked against the blocks of the
+				// block store, which only holds the blocks finalized since
+				// the node started until the committed ones are loaded.
+				if peer != "" {
+					if err := loader.LoadBlocks(cmd.Context()); err != nil {
+						return err
+					}
+				}
+				report, err := checker.CheckBlobs(
+					cmd.Context(), math.Slot(head), peer,
+				)
+				if err != nil {
+					return err
+				}
+				if path != "" {
+					if err = report.WriteFile(path); err != nil {
+						return err
+					}
+				}
+				return printReport(cmd, report)
+			}, &loader, &checker)
+		},
+	}
+
+	cmd.Flags().Uint64Var(
+		&head, FlagHeadSlot, 0, "last slot of the checked window",
+	)
+	cmd.Flags().StringVar(
+		&peer, FlagRefetchFrom, "",
+		"URL of the node API to fetch the missing or corrupt sidecars from",
+	)
+	cmd.Flags().StringVar(
+		&path, FlagReport, "", "path to write the JSON report to",
+	)
+	return cmd
+}
+
+// latestHeight returns the height of the last block of the CometBFT block
+// store of the home directory of the command.
+func latestHeight(cmd *cobra.Command) (uint64, error) {
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	db, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	//#nosec:G115 // the height of the block store is never negative.
+	return uint64(cmtstore.NewBlockStore(db).Height()), nil
+}
+
+// printReport prints the outcome of a check, returning an error if some
+// missing or corrupt blob sidecars were not fetched again.
+func printReport(cmd *cobra.Command, report *integrity.Report) error {
+	cmd.Printf(
+		"Checked %d blob sidecars of %d slots in [%d, %d]\n",
+		report.Sidecars, report.Slots, report.FromSlot, report.ToSlot,
+	)
+	for _, issue := range report.Issues {
+		status := "unresolved"
+		if issue.Refetched {
+			status = "refetched"
+		}
+		cmd.Printf(
+			"  slot %d: %s sidecar %s (%s): %s\n",
+			issue.Slot, issue.Kind, issue.Commitment, status, issue.Reason,
+		)
+	}
+
+	missing, _ := report.Count(integrity.IssueMissing)
+	corrupt, _ := report.Count(integrity.IssueCorrupt)
+	unresolved := report.Unresolved()
+	cmd.Printf(
+		"Missing: %d, corrupt: %d, unresolved: %d\n",
+		missing, corrupt, unresolved,
+	)
+	if unresolved > 0 {
+		return errors.Wrapf(
+			integrity.ErrIssuesFound, "%d unresolved", unresolved,
+		)
+	}
+	return nil
+}
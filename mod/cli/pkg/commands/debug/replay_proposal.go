@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/replay"
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rejections"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+// FlagVerifyPayload is the flag to verify the payload of the replayed
+// proposal with the execution client.
+const FlagVerifyPayload = "verify-payload"
+
+// NewReplayProposalCmd creates a command to replay a rejected proposal
+// against the local state.
+func NewReplayProposalCmd[LoggerT log.AdvancedLogger[LoggerT]](
+	inject types.ComponentInjector[LoggerT],
+) *cobra.Command {
+	var verifyPayload bool
+
+	cmd := &cobra.Command{
+		Use:   "replay-proposal [height]",
+		Short: "replay a rejected proposal against the local state",
+		Long: `Replays a proposal rejected by the node, as kept in the
+data/rejected-proposals directory, through the state transition and the blob
+verifier against the state committed at the preceding height, and prints the
+outcome of every check. Without a height, the latest rejected proposal is
+replayed. The payload is only sent to the execution client, whose engine API
+JWT secret is then required, if --verify-payload is set. The node must be
+stopped, and the local state is never written to.`,
+		Args:         cobra.MaximumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				height int64
+				err    error
+			)
+			if len(args) == 1 {
+				if height, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			v := clicontext.GetViperFromCmd(cmd)
+			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+
+			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			p, report, err := replayProposal(
+				cmd.Context(), height, verifyPayload,
+				func(components ...any) error {
+					return inject(logger, db, cfg, v, components...)
+				},
+			)
+			if errors.Is(err, rejections.ErrProposalNotFound) {
+				// The stack trace of the error is of no use to the user.
+				return stderrors.New(err.Error())
+			}
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf(
+				"Proposal at height %d rejected at %s\n",
+				p.Height, p.RejectedAt.Format(time.RFC3339),
+			)
+			for i, msg := range p.Errors {
+				if i == 0 {
+					cmd.Printf("  error: %s\n", msg)
+					continue
+				}
+				cmd.Printf("  caused by: %s\n", msg)
+			}
+			printReport(cmd, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(
+		&verifyPayload, FlagVerifyPayload, false,
+		"verify the payload with the execution client",
+	)
+	return cmd
+}
+
+// replayProposal replays the rejected proposal of the given height with the
+// replayer built by inject, which only verifies the payload with the
+// execution client if verifyPayload is set.
+func replayProposal(
+	ctx context.Context,
+	height int64,
+	verifyPayload bool,
+	inject func(components ...any) error,
+) (*rejections.Proposal, *replay.Report, error) {
+	if verifyPayload {
+		var replayer PayloadReplayer
+		if err := inject(&replayer); err != nil {
+			return nil, nil, err
+		}
+		return replayer.ReplayProposalWithPayload(ctx, height)
+	}
+
+	var replayer ProposalReplayer
+	if err := inject(&replayer); err != nil {
+		return nil, nil, err
+	}
+	return replayer.ReplayProposal(ctx, height)
+}
+
+// printReport prints the outcome of every check of the replay, followed by
+// the first failing check.
+func printReport(cmd *cobra.Command, report *replay.Report) {
+	cmd.Println("Replay:")
+	for _, check := range report.Checks {
+		switch {
+		case check.Skipped:
+			cmd.Printf("  [SKIP] %s\n", check.Name)
+		case check.Err != nil:
+			cmd.Printf("  [FAIL] %s: %v\n", check.Name, check.Err)
+		default:
+			cmd.Printf("  [ OK ] %s\n", check.Name)
+		}
+	}
+
+	if failure := report.FirstFailure(); failure != nil {
+		cmd.Printf(
+			"First failing check: %s: %v\n", failure.Name, failure.Err,
+		)
+		return
+	}
+	cmd.Println("All checks passed against the local state")
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/beacon/replay"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rejections"
+)
+
+// PayloadReplayer replays the proposals rejected by the node, verifying
+// their payloads with the execution client.
+type PayloadReplayer interface {
+	// ReplayProposalWithPayload replays the latest rejected proposal of the
+	// given height, or the latest rejected proposal of any height if height
+	// is 0, against the local beacon state, verifying its payload with the
+	// execution client. It must not be called on a running node.
+	ReplayProposalWithPayload(
+		ctx context.Context,
+		height int64,
+	) (*rejections.Proposal, *replay.Report, error)
+}
+
+// ProposalReplayer replays the proposals rejected by the node, without
+// verifying their payloads.
+type ProposalReplayer interface {
+	// ReplayProposal replays the latest rejected proposal of the given
+	// height, or the latest rejected proposal of any height if height is 0,
+	// against the local beacon state. It must not be called on a running
+	// node.
+	ReplayProposal(
+		ctx context.Context,
+		height int64,
+	) (*rejections.Proposal, *replay.Report, error)
+}
@@ -55,4 +55,15 @@ type (
 	] func(
 		LoggerT, dbm.DB, io.Writer, *cmtcfg.Config, AppOptions,
 	) AppT
+
+	// ComponentInjector is a function that builds the given components of an
+	// application, without the application itself, by assigning them to the
+	// given pointers.
+	ComponentInjector[
+		LoggerT interface {
+			log.AdvancedLogger[LoggerT]
+		},
+	] func(
+		LoggerT, dbm.DB, *cmtcfg.Config, AppOptions, ...any,
+	) error
 )
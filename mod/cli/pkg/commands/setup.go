@@ -21,6 +21,8 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/blobs"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/debug"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
@@ -46,16 +48,21 @@ func DefaultRootCommandSetup[
 	root *Root,
 	mm *cometbft.Service[LoggerT],
 	appCreator servertypes.AppCreator[T, LoggerT],
+	inject servertypes.ComponentInjector[LoggerT],
 	chainSpec common.ChainSpec,
 ) {
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
+		// `blobs`
+		blobs.Commands(inject),
 		// `comet`
 		cmtcli.Commands(appCreator),
 		// `init`
 		genutilcli.InitCmd(mm),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `debug`
+		debug.Commands(inject),
 		// `deposit`
 		deposit.Commands[ExecutionPayloadT](chainSpec),
 		// `jwt`
@@ -96,6 +96,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCStartupCheckInterval,
 		"rpc startup check interval",
 	)
+	startCmd.Flags().Duration(
+		RPCHealthCheckInteval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval",
+	)
 	startCmd.Flags().Duration(
 		RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
@@ -24,13 +24,19 @@ import (
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
+	"github.com/berachain/beacon-kit/mod/da/pkg/integrity"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store/archive"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/inclusionlist"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rejections"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -44,12 +50,18 @@ type AppOptions interface {
 func DefaultConfig() *Config {
 	return &Config{
 		Engine:            engineclient.DefaultConfig(),
+		Deposit:           deposit.DefaultConfig(),
 		Logger:            log.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
+		BlobArchive:       archive.DefaultConfig(),
+		BlobIntegrity:     integrity.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
+		Relay:             relay.DefaultConfig(),
+		InclusionList:     inclusionlist.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		RejectedProposals: rejections.DefaultConfig(),
 	}
 }
 
@@ -57,18 +69,34 @@ func DefaultConfig() *Config {
 type Config struct {
 	// Engine is the configuration for the execution client.
 	Engine engineclient.Config `mapstructure:"engine"`
+	// Deposit is the configuration for the deposit service.
+	Deposit deposit.Config `mapstructure:"deposit"`
 	// Logger is the configuration for the logger.
 	Logger log.Config `mapstructure:"logger"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// BlobArchive is the configuration for the archival of the blob sidecars
+	// past the data availability period.
+	BlobArchive archive.Config `mapstructure:"blob-archive"`
+	// BlobIntegrity is the configuration for the background integrity checks
+	// of the blob sidecars of the data availability period.
+	BlobIntegrity integrity.Config `mapstructure:"blob-integrity"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// Relay is the configuration for requesting payloads from a relay.
+	Relay relay.Config `mapstructure:"relay"`
+	// InclusionList is the configuration for the inclusion lists of the
+	// validators.
+	InclusionList inclusionlist.Config `mapstructure:"inclusion-list"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// RejectedProposals is the configuration for the proposals rejected by
+	// the node, kept on disk for diagnostics.
+	RejectedProposals rejections.Config `mapstructure:"rejected-proposals"`
 }
 
 // GetEngine returns the execution client configuration.
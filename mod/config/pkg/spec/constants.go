@@ -30,4 +30,8 @@ const (
 
 	// TestnetEth1ChainID is the chain ID for the bArtio testnet.
 	TestnetEth1ChainID uint64 = 80084
+
+	// UnscheduledForkEpoch is the lowest epoch given to the forks that are
+	// not scheduled on any network yet.
+	UnscheduledForkEpoch uint64 = 9999999999999998
 )
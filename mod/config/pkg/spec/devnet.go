@@ -36,5 +36,8 @@ func DevnetChainSpec() chain.Spec[
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = DevnetEth1ChainID
+	// Local devnets start from genesis, so precommits are extended from the
+	// first height on, for inclusion lists.
+	testnetSpec.VoteExtensionsEnableHeight = 1
 	return chain.NewChainSpec(testnetSpec)
 }
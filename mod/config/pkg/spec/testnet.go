@@ -23,6 +23,7 @@ package spec
 import (
 	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -68,6 +69,7 @@ func BaseSpec() chain.SpecData[
 		SlotsPerEpoch:                32,
 		MinEpochsToInactivityPenalty: 4,
 		SlotsPerHistoricalRoot:       8,
+		PayloadTimestampBound:        5,
 		// Signature domains.
 		DomainTypeProposer: common.DomainType{
 			0x00, 0x00, 0x00, 0x00,
@@ -97,12 +99,16 @@ func BaseSpec() chain.SpecData[
 		DepositContractAddress: common.NewExecutionAddressFromHex(
 			"0x4242424242424242424242424242424242424242",
 		),
-		DepositEth1ChainID:        uint64(80084),
-		Eth1FollowDistance:        1,
-		TargetSecondsPerEth1Block: 3,
+		DepositContractDeployBlock: 0,
+		DepositEth1ChainID:         uint64(80084),
+		Eth1FollowDistance:         1,
+		TargetSecondsPerEth1Block:  3,
 		// Fork-related values.
-		DenebPlusForkEpoch: 9999999999999998,
-		ElectraForkEpoch:   9999999999999999,
+		DenebPlusForkEpoch: math.Epoch(UnscheduledForkEpoch),
+		ElectraForkEpoch:   math.Epoch(UnscheduledForkEpoch + 1),
+		// Proposals are not checked against the payload timestamp bound
+		// until the fork is scheduled.
+		PayloadTimestampBoundForkEpoch: math.Epoch(constants.FarFutureEpoch),
 		// State list length constants.
 		EpochsPerHistoricalVector: 8,
 		EpochsPerSlashingsVector:  8,
@@ -2,4 +2,7 @@ package spec
 
 type ChainSpecInput struct {
 	Eth1ChainID uint64 `json:"eth1chain_id"`
+	// VoteExtensionsEnableHeight is the height from which the precommits are
+	// extended, zero leaving them disabled.
+	VoteExtensionsEnableHeight uint64 `json:"vote_extensions_enable_height"`
 }
@@ -42,9 +42,34 @@ rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Interval for checking the connection to the execution client after startup.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Duration for which the previous JWT secret is still tried after the secret file changes.
+jwt-rotation-window = "{{ .BeaconKit.Engine.JWTRotationWindow }}"
+
+[beacon-kit.deposit]
+# Execution block tag up to which deposits are synced, either "finalized" or "safe".
+block-tag = "{{ .BeaconKit.Deposit.BlockTag }}"
+
+# Maximum number of execution blocks queried for deposit logs in a single request.
+max-block-range = {{ .BeaconKit.Deposit.MaxBlockRange }}
+
+# Interval at which deposits are synced in the absence of finalized beacon blocks.
+sync-interval = "{{ .BeaconKit.Deposit.SyncInterval }}"
+
+# Websocket or IPC endpoint of the execution client used to stream deposits as
+# they are emitted. Deposits are only polled if empty.
+subscription-url = "{{ .BeaconKit.Deposit.SubscriptionURL }}"
+
+# Number of execution blocks built on top of a streamed deposit, measured from
+# the execution head, before it is enqueued. Streamed deposits are never
+# enqueued above the block tag, whatever the depth.
+confirmation-depth = {{ .BeaconKit.Deposit.ConfirmationDepth }}
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -64,6 +89,28 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+[beacon-kit.blob-archive]
+# Mode of the archival of the blob sidecars past the data availability period.
+# Options are "disabled" to drop them, "retain" to keep them in the blob store
+# and "segments" to move them into compressed segment files.
+mode = "{{ .BeaconKit.BlobArchive.Mode }}"
+
+# Number of epochs of blob sidecars kept in each segment file.
+segment-epochs = {{ .BeaconKit.BlobArchive.SegmentEpochs }}
+
+[beacon-kit.blob-integrity]
+# Enabled determines if the blob sidecars of the data availability period are
+# checked against the commitments of their blocks in the background.
+enabled = {{ .BeaconKit.BlobIntegrity.Enabled }}
+
+# Interval between two checks of the blob sidecars.
+interval = "{{ .BeaconKit.BlobIntegrity.Interval }}"
+
+# URL of the node API of a peer the missing or corrupt blob sidecars are
+# fetched again from. Leave empty to only report them. Only the sidecars of the
+# blocks of the block store, filled by the block store service, are fetched.
+peer-url = "{{ .BeaconKit.BlobIntegrity.PeerURL }}"
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -77,14 +124,85 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+# The time given to the execution client to fill a payload whose build is
+# refreshed with an updated timestamp because it was started for an earlier round,
+# before it replaces the stale payload. Proposals never wait for it.
+payload-refresh-delay = "{{ .BeaconKit.PayloadBuilder.PayloadRefreshDelay }}"
+
+# Number of CometBFT rounds of the next height searched for proposer turns of this
+# node. Payloads are only built ahead of time when the node is predicted to propose.
+# 0 builds ahead of time for every height.
+speculative-build-rounds = {{ .BeaconKit.PayloadBuilder.SpeculativeBuildRounds }}
+
+[beacon-kit.relay]
+# Enabled determines if payloads built by external builders are requested from the relay.
+enabled = {{ .BeaconKit.Relay.Enabled }}
+
+# URL of the builder API of the relay.
+url = "{{ .BeaconKit.Relay.URL }}"
+
+# BLS public key of the relay. If set, only bids signed by this key are accepted.
+pubkey = "{{ .BeaconKit.Relay.Pubkey }}"
+
+# Timeout for requests to the relay. Late bids are ignored in favour of the local payload,
+# late payloads fail the slot.
+timeout = "{{ .BeaconKit.Relay.Timeout }}"
+
+# Gas limit registered with the relay.
+gas-limit = {{ .BeaconKit.Relay.GasLimit }}
+
+[beacon-kit.inclusion-list]
+# Enabled determines if precommits are extended with inclusion lists built by the
+# execution client. Payloads are checked against the inclusion lists carried by the
+# blocks regardless. Inclusion lists require an execution client implementing the
+# inclusion list extension of the engine API, advertised as engine_getInclusionListV1
+# through engine_exchangeCapabilities. Proposers whose execution client lacks it
+# propose payloads without transactions while inclusion lists apply.
+enabled = {{ .BeaconKit.InclusionList.Enabled }}
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 
+# Append the client codes and commit of the execution client to the graffiti, if they fit.
+graffiti-client-version = {{.BeaconKit.Validator.GraffitiClientVersion}}
+
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# Path of the proposer configuration file, holding the fee recipient, graffiti and relay
+# settings by validator public key. It is reloaded whenever it changes.
+proposer-config-path = "{{.BeaconKit.Validator.ProposerConfigPath}}"
+
+# Percentage the value of relay bids is multiplied by before comparing it with the value of
+# the local payload. 0 always uses the local payload, 100 uses the more valuable one.
+builder-boost-factor = {{.BeaconKit.Validator.BuilderBoostFactor}}
+
+# Moves the signing of blocks to an external validator process, which produces blocks through
+# /eth/v3/validator/blocks/{slot} and publishes them signed through /eth/v2/beacon/blocks.
+# Blocks can only be produced while their slot is being proposed, again in every round.
+external-signer = {{.BeaconKit.Validator.ExternalSigner}}
+
+# Time a proposal waits for the external signer to produce and publish the signed block.
+external-signer-timeout = "{{.BeaconKit.Validator.ExternalSignerTimeout}}"
+
+# Time the retrieval of the payload of a proposal may take before the block is proposed with
+# a payload without transactions instead. 0 waits for the retrieval without a timeout.
+payload-retrieval-timeout = "{{.BeaconKit.Validator.PayloadRetrievalTimeout}}"
+
+# Maximum number of blobs of submitters other than the priority submitters carried by
+# proposals, below the maximum of the chain. 0 applies the maximum of the chain.
+max-blobs-per-block = {{.BeaconKit.Validator.MaxBlobsPerBlock}}
+
+# Minimum blob gas price, in wei, blob transactions of submitters other than the priority
+# submitters must pay to be carried by proposals.
+min-blob-gas-price = {{.BeaconKit.Validator.MinBlobGasPrice}}
+
+# Senders of blob transactions exempt from the blob policy of proposals. Local payloads
+# violating the blob policy are replaced with payloads without transactions.
+priority-submitters = [{{range $i, $submitter := .BeaconKit.Validator.PrioritySubmitters}}{{if $i}}, {{end}}"{{$submitter}}"{{end}}]
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -96,9 +214,16 @@ availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
 
-# Address is the address to bind the node API to.
+# Address is the address to bind the node API to. The endpoints changing the
+# behaviour of the node, such as prepare_beacon_proposer, only serve requests
+# made from the loopback interface.
 address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.rejected-proposals]
+# Capacity is the number of proposals rejected by the node kept on disk for
+# diagnostics with "beacond debug replay-proposal". 0 disables keeping them.
+capacity = {{ .BeaconKit.RejectedProposals.Capacity }}
 `
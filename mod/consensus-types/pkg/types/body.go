@@ -29,7 +29,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -43,10 +42,6 @@ const (
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
-	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
-	// in the merkle tree built from the block body.
-	KZGMerkleIndexDeneb = 26
-
 	// ExtraDataSize is the size of ExtraData in bytes.
 	ExtraDataSize = 32
 )
@@ -67,21 +62,6 @@ func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 	}
 }
 
-// BlockBodyKZGOffset returns the offset of the KZG commitments in the block
-// body.
-// TODO: I still feel like we need to clean this up somehow.
-func BlockBodyKZGOffset(
-	slot math.Slot,
-	cs common.ChainSpec,
-) uint64 {
-	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
-		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
-	default:
-		panic(ErrForkVersionNotSupported)
-	}
-}
-
 // BeaconBlockBody represents the body of a beacon block in the Deneb
 // chain.
 type BeaconBlockBody struct {
@@ -126,12 +106,16 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineStaticBytes(codec, &b.Graffiti)
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
-	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKzgCommitments, MaxBlobCommitmentsDeneb,
+	)
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
-	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKzgCommitments, MaxBlobCommitmentsDeneb,
+	)
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -150,6 +134,68 @@ func (b *BeaconBlockBody) HashTreeRoot() common.Root {
 	return ssz.HashConcurrent(b)
 }
 
+// HashTreeRootBlinded returns the SSZ hash tree root of the blinded form of
+// the BeaconBlockBody, which commits to its execution payload by the header
+// with the given hash tree root. As a header has the same hash tree root as
+// its payload, this is the hash tree root of the body once the payload of
+// the header is set.
+func (b *BeaconBlockBody) HashTreeRootBlinded(
+	headerRoot common.Root,
+) common.Root {
+	return ssz.HashSequential(&blindedBeaconBlockBody{
+		RandaoReveal:               b.RandaoReveal,
+		Eth1Data:                   b.Eth1Data,
+		Graffiti:                   b.Graffiti,
+		Deposits:                   b.Deposits,
+		ExecutionPayloadHeaderRoot: headerRoot,
+		BlobKzgCommitments:         b.BlobKzgCommitments,
+	})
+}
+
+// blindedBeaconBlockBody is the SSZ layout of a BeaconBlockBody with the
+// execution payload replaced by the hash tree root of its header.
+type blindedBeaconBlockBody struct {
+	RandaoReveal               crypto.BLSSignature
+	Eth1Data                   *Eth1Data
+	Graffiti                   [32]byte
+	Deposits                   []*Deposit
+	ExecutionPayloadHeaderRoot common.Root
+	BlobKzgCommitments         []eip4844.KZGCommitment
+}
+
+// SizeSSZ returns the size of the blindedBeaconBlockBody in SSZ.
+func (b *blindedBeaconBlockBody) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 96 + 72 + 32 + 4 + 32 + 4
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
+	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the blindedBeaconBlockBody.
+//
+//nolint:mnd // TODO: chainspec.
+func (b *blindedBeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticObject(codec, &b.Eth1Data)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
+	ssz.DefineStaticBytes(codec, &b.ExecutionPayloadHeaderRoot)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKzgCommitments, MaxBlobCommitmentsDeneb,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKzgCommitments, MaxBlobCommitmentsDeneb,
+	)
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   FastSSZ                                  */
 /* -------------------------------------------------------------------------- */
@@ -206,11 +252,12 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 
 	// Field (5) 'BlobKzgCommitments'
 	{
-		if size := len(b.BlobKzgCommitments); size > 16 {
+		size := len(b.BlobKzgCommitments)
+		if uint64(size) > MaxBlobCommitmentsDeneb {
 			return fastssz.ErrListTooBigFn(
 				"BeaconBlockBody.BlobKzgCommitments",
 				size,
-				16,
+				int(MaxBlobCommitmentsDeneb),
 			)
 		}
 		subIndx := hh.Index()
@@ -218,7 +265,9 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 			hh.PutBytes(i[:])
 		}
 		numItems := uint64(len(b.BlobKzgCommitments))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16)
+		hh.MerkleizeWithMixin(
+			subIndx, numItems, MaxBlobCommitmentsDeneb,
+		)
 	}
 
 	hh.Merkleize(indx)
@@ -155,6 +155,20 @@ func TestBeaconBlockBody_GetTopLevelRoots(t *testing.T) {
 	require.NotNil(t, roots)
 }
 
+func TestBeaconBlockBody_HashTreeRootBlinded(t *testing.T) {
+	body := generateBeaconBlockBody()
+	body.Deposits = []*types.Deposit{{Index: 1}}
+	body.BlobKzgCommitments = []eip4844.KZGCommitment{{1}}
+	header, err := body.GetExecutionPayload().ToHeader(16, 1)
+	require.NoError(t, err)
+	require.Equal(t,
+		body.HashTreeRoot(), body.HashTreeRootBlinded(header.HashTreeRoot()),
+	)
+	require.NotEqual(t,
+		body.HashTreeRoot(), body.HashTreeRootBlinded(common.Root{1}),
+	)
+}
+
 func TestBeaconBlockBody_Empty(t *testing.T) {
 	blockBody := types.BeaconBlockBody{}
 	body := blockBody.Empty(version.Deneb)
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// MaxBlobCommitmentsDeneb is the limit of BlobKzgCommitments in the SSZ
+// encoding of the block body.
+const MaxBlobCommitmentsDeneb uint64 = 16
+
+// KZGInclusion describes where the list of KZG commitments sits in the block
+// body of a fork, from which the inclusion proofs of the blob and data column
+// sidecars are built and verified.
+type KZGInclusion struct {
+	// BodyLength is the number of fields in the block body.
+	BodyLength uint64
+	// Position is the position of BlobKzgCommitments in the block body.
+	Position uint64
+	// MaxBlobCommitments is the limit of BlobKzgCommitments in the SSZ
+	// encoding of the block body.
+	MaxBlobCommitments uint64
+}
+
+// kzgInclusionDeneb is the KZG inclusion of the Deneb block body.
+var kzgInclusionDeneb = KZGInclusion{
+	BodyLength:         BodyLengthDeneb,
+	Position:           KZGPositionDeneb,
+	MaxBlobCommitments: MaxBlobCommitmentsDeneb,
+}
+
+// kzgInclusions is the KZG inclusion of each fork. A fork whose block body
+// changes must have its own entry, as the sidecars of its blocks can neither
+// be built nor verified without one.
+//
+//nolint:gochecknoglobals // lookup table.
+var kzgInclusions = map[uint32]KZGInclusion{
+	version.Deneb:     kzgInclusionDeneb,
+	version.DenebPlus: kzgInclusionDeneb,
+	version.Electra:   kzgInclusionDeneb,
+}
+
+// KZGInclusionForVersion returns the KZG inclusion of the block body of the
+// given fork version.
+func KZGInclusionForVersion(forkVersion uint32) (KZGInclusion, error) {
+	inclusion, ok := kzgInclusions[forkVersion]
+	if !ok {
+		return KZGInclusion{}, errors.Wrap(
+			ErrForkVersionNotSupported,
+			fmt.Sprintf("fork %d", forkVersion),
+		)
+	}
+	return inclusion, nil
+}
+
+// BodyDepth returns the depth of the merkle tree of the fields of the block
+// body, which is the depth of the proof of the list of KZG commitments.
+func (k KZGInclusion) BodyDepth() uint8 {
+	return math.U64(k.BodyLength).NextPowerOfTwo().ILog2Ceil()
+}
+
+// CommitmentsDepth returns the depth of the merkle tree of the list of KZG
+// commitments, without the mixed in length.
+func (k KZGInclusion) CommitmentsDepth() uint8 {
+	return math.U64(k.MaxBlobCommitments).NextPowerOfTwo().ILog2Ceil()
+}
+
+// ProofDepth returns the depth of the inclusion proof of a KZG commitment in
+// the block body.
+func (k KZGInclusion) ProofDepth() uint8 {
+	return k.CommitmentsDepth() + 1 + k.BodyDepth()
+}
+
+// GIndex returns the generalized index of the list of KZG commitments in the
+// block body.
+func (k KZGInclusion) GIndex() uint64 {
+	return 1<<k.BodyDepth() + k.Position
+}
+
+// CommitmentGIndex returns the generalized index of the KZG commitment at the
+// given index in the block body.
+func (k KZGInclusion) CommitmentGIndex(index uint64) uint64 {
+	// The left child of the list holds the commitments, the right one their
+	// number.
+	return (2*k.GIndex())<<k.CommitmentsDepth() + index
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKZGInclusion checks that the KZG inclusion of each fork matches the
+// layout of its block body, so that a field added to the body without
+// updating the table is caught here rather than by failing sidecars.
+func TestKZGInclusion(t *testing.T) {
+	body := generateBeaconBlockBody()
+	body.BlobKzgCommitments = []eip4844.KZGCommitment{{1}, {2}, {3}}
+
+	for _, forkVersion := range []uint32{
+		version.Deneb, version.DenebPlus, version.Electra,
+	} {
+		inclusion, err := types.KZGInclusionForVersion(forkVersion)
+		require.NoError(t, err)
+		require.Equal(t, body.Length(), inclusion.BodyLength)
+
+		commitments, err := merkle.NewTreeWithMaxLeaves[common.Root](
+			body.GetBlobKzgCommitments().Leafify(),
+			inclusion.MaxBlobCommitments,
+		)
+		require.NoError(t, err)
+		commitmentsProof, err := commitments.MerkleProofWithMixin(1)
+		require.NoError(t, err)
+
+		roots := body.GetTopLevelRoots()
+		roots[inclusion.Position] = commitments.HashTreeRoot()
+		bodyTree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+			roots, inclusion.BodyLength,
+		)
+		require.NoError(t, err)
+		bodyProof, err := bodyTree.MerkleProof(inclusion.Position)
+		require.NoError(t, err)
+
+		proof := append(commitmentsProof, bodyProof...)
+		require.Len(t, proof, int(inclusion.ProofDepth()))
+		require.True(t, merkle.IsValidMerkleBranch(
+			body.BlobKzgCommitments[1].HashTreeRoot(),
+			proof,
+			inclusion.ProofDepth(),
+			inclusion.CommitmentGIndex(1),
+			body.HashTreeRoot(),
+		))
+	}
+
+	_, err := types.KZGInclusionForVersion(version.Capella)
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+}
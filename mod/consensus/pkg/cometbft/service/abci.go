@@ -123,8 +123,11 @@ func (s *Service[LoggerT]) InitChain(
 
 	// NOTE: We don't commit, but FinalizeBlock for block InitialHeight starts from
 	// this FinalizeBlockState.
+	// The consensus parameters of the chain spec, which FinalizeBlock keeps
+	// in place, apply from genesis on, e.g. for vote extensions to be enabled
+	// from the height the chain spec schedules them at.
 	return &cmtabci.InitChainResponse{
-		ConsensusParams: req.ConsensusParams,
+		ConsensusParams: s.paramStore.Get(),
 		Validators:      resValidators,
 		AppHash:         s.sm.CommitMultiStore().LastCommitID().Hash,
 	}, nil
@@ -191,27 +194,32 @@ func (s *Service[LoggerT]) PrepareProposal(
 		)
 	}
 
-	// Always reset state given that PrepareProposal can timeout
-	// and be called again in a subsequent round.
-	s.prepareProposalState = s.resetState()
-	s.prepareProposalState.SetContext(
-		s.getContextForProposal(
-			s.prepareProposalState.Context(),
+	s.setExtendedCommit(req.Height, req.LocalLastCommit)
+	slotData := &types.SlotData[
+		*ctypes.AttestationData,
+		*ctypes.SlashingInfo,
+	]{
+		Slot: math.Slot(req.Height),
+		//#nosec:G701 // block times are never before the unix epoch.
+		Time: math.U64(req.Time.Unix()),
+	}
+	blkBz, sidecarsBz, err := s.prepareProposal(req.Height, slotData)
+	if err != nil {
+		s.logger.Error(
+			"failed to prepare proposal, falling back to empty payload",
+			"height",
 			req.Height,
-		),
-	)
-
-	blkBz, sidecarsBz, err := s.Middleware.PrepareProposal(
-		s.prepareProposalState.Context(), &types.SlotData[
-			*ctypes.AttestationData,
-			*ctypes.SlashingInfo,
-		]{
-			Slot: math.Slot(req.Height),
-		},
-	)
+			"time",
+			req.Time,
+			"err",
+			err,
+		)
+		slotData.EmptyPayload = true
+		blkBz, sidecarsBz, err = s.prepareProposal(req.Height, slotData)
+	}
 	if err != nil {
 		s.logger.Error(
-			"failed to prepare proposal",
+			"failed to prepare proposal with empty payload",
 			"height",
 			req.Height,
 			"time",
@@ -219,12 +227,47 @@ func (s *Service[LoggerT]) PrepareProposal(
 			"err",
 			err,
 		)
-		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
+		// Propose a block without beacon block rather than wasting the
+		// round. It still carries the extended commit of the previous
+		// height.
+		blkBz, sidecarsBz = nil, nil
 	}
 
-	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{blkBz, sidecarsBz},
-	}, nil
+	txs, err := s.appendExtendedCommit(
+		req.Height, [][]byte{blkBz, sidecarsBz}, req.LocalLastCommit,
+	)
+	if err != nil {
+		s.logger.Error(
+			"failed to append extended commit to proposal",
+			"height",
+			req.Height,
+			"err",
+			err,
+		)
+		return &cmtabci.PrepareProposalResponse{}, nil
+	}
+
+	return &cmtabci.PrepareProposalResponse{Txs: txs}, nil
+}
+
+// prepareProposal builds the beacon block and blob sidecars of the proposal
+// of the given height and slot data on top of a fresh state.
+func (s *Service[LoggerT]) prepareProposal(
+	height int64,
+	slotData *types.SlotData[*ctypes.AttestationData, *ctypes.SlashingInfo],
+) ([]byte, []byte, error) {
+	// Always reset state given that PrepareProposal can timeout
+	// and be called again in a subsequent round.
+	s.prepareProposalState = s.resetState()
+	s.prepareProposalState.SetContext(
+		s.getContextForProposal(
+			s.prepareProposalState.Context(),
+			height,
+		),
+	)
+	return s.Middleware.PrepareProposal(
+		s.prepareProposalState.Context(), slotData,
+	)
 }
 
 // ProcessProposal implements the ProcessProposal ABCI method and returns a
@@ -259,6 +302,23 @@ func (s *Service[LoggerT]) ProcessProposal(
 		),
 	)
 
+	if err := s.processExtendedCommit(
+		req.Height, req.Txs, req.ProposedLastCommit,
+	); err != nil {
+		s.logger.Error(
+			"failed to process extended commit of proposal",
+			"height",
+			req.Height,
+			"hash",
+			fmt.Sprintf("%X", req.Hash),
+			"err",
+			err,
+		)
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+		}, nil
+	}
+
 	resp, err := s.Middleware.ProcessProposal(
 		s.processProposalState.Context(),
 		req,
@@ -527,6 +587,18 @@ func (s *Service[LoggerT]) CreateQueryContext(
 	), nil
 }
 
+// StateContextAt returns a context holding the state committed at the given
+// height. Writes to the state of the context are never committed.
+func (s *Service[_]) StateContextAt(height int64) (context.Context, error) {
+	if height <= 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidHeight, "no state committed at height %d",
+			height,
+		)
+	}
+	return s.CreateQueryContext(height, false)
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this
 // height
 // are pruned from CometBFT. Given a commitment height and a non-zero local
@@ -190,11 +190,28 @@ func (h *ABCIMiddleware[
 // ProcessProposal processes the proposal for the ABCI middleware.
 // It handles both the beacon block and blob sidecars concurrently.
 func (h *ABCIMiddleware[
-	BeaconBlockT, BlobSidecarsT, _, _,
+	_, _, _, _,
 ]) ProcessProposal(
 	ctx context.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
+	err := h.processProposal(ctx, req)
+	if errors.IsFatal(err) {
+		h.rejections.RecordRejection(
+			ctx, req.Height, req.Time, req.ProposerAddress, req.Txs, err,
+		)
+	}
+	return h.createProcessProposalResponse(err)
+}
+
+// processProposal verifies the beacon block and blob sidecars of the
+// proposal, returning a fatal error if the proposal must be rejected.
+func (h *ABCIMiddleware[
+	BeaconBlockT, BlobSidecarsT, _, _,
+]) processProposal(
+	ctx context.Context,
+	req *cmtabci.ProcessProposalRequest,
+) error {
 	var (
 		err              error
 		startTime        = time.Now()
@@ -223,14 +240,21 @@ func (h *ABCIMiddleware[
 		UnmarshalBeaconBlockFromABCIRequest[BeaconBlockT](
 		req, 0, h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height)),
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return errors.WrapNonFatal(err)
+	}
+
+	// Reject payloads that do not follow the consensus time of the slot.
+	if err = h.validatePayloadTimestamp(
+		blk, math.Slot(req.Height), req.Time,
+	); err != nil {
+		return err
 	}
 
 	// notify that the beacon block has been received.
 	if err = h.dispatcher.Publish(
 		async.NewEvent(ctx, async.BeaconBlockReceived, blk),
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return errors.WrapNonFatal(err)
 	}
 
 	// Request the blob sidecars.
@@ -238,26 +262,58 @@ func (h *ABCIMiddleware[
 		UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
 		req, 1,
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return errors.WrapNonFatal(err)
 	}
 
 	// notify that the sidecars have been received.
 	if err = h.dispatcher.Publish(
 		async.NewEvent(ctx, async.SidecarsReceived, sidecars),
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return errors.WrapNonFatal(err)
 	}
 
 	// err if the built beacon block or sidecars failed verification.
 	_, err = h.waitForBeaconBlockVerification(awaitCtx)
 	if err != nil {
-		return h.createProcessProposalResponse(err)
+		return err
 	}
 	_, err = h.waitForSidecarVerification(awaitCtx)
 	if err != nil {
-		return h.createProcessProposalResponse(err)
+		return err
+	}
+	return nil
+}
+
+// validatePayloadTimestamp returns an error if the timestamp of the execution
+// payload of the block is further than the payload timestamp bound of the
+// chain spec from the consensus time of its slot. Blocks of slots before the
+// payload timestamp bound fork are not checked.
+func (h *ABCIMiddleware[
+	BeaconBlockT, _, _, _,
+]) validatePayloadTimestamp(
+	blk BeaconBlockT,
+	slot math.Slot,
+	consensusTime time.Time,
+) error {
+	if blk.IsNil() || h.chainSpec.SlotToEpoch(slot) <
+		h.chainSpec.PayloadTimestampBoundForkEpoch() {
+		return nil
+	}
+
+	timestamp := blk.GetTimestamp()
+	//#nosec:G701 // payload timestamps and the bound fit in an int64.
+	var (
+		diff  = int64(timestamp.Unwrap()) - consensusTime.Unix()
+		bound = int64(h.chainSpec.PayloadTimestampBound())
+	)
+	if diff > bound || diff < -bound {
+		return errors.Wrapf(
+			ErrPayloadTimestampOutOfBound,
+			"payload timestamp %d, consensus time %d",
+			timestamp.Unwrap(), consensusTime.Unix(),
+		)
 	}
-	return h.createProcessProposalResponse(nil)
+	return nil
 }
 
 // waitForBeaconBlockVerification waits for the built beacon block to be
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// forkEpoch is the epoch of the payload timestamp bound fork of the tests.
+const forkEpoch = 2
+
+type testBlock struct {
+	timestamp math.U64
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) { return nil, nil }
+func (b *testBlock) UnmarshalSSZ([]byte) error   { return nil }
+func (b *testBlock) IsNil() bool                 { return b == nil }
+func (b *testBlock) Empty() *testBlock           { return &testBlock{} }
+func (b *testBlock) GetTimestamp() math.U64      { return b.timestamp }
+func (b *testBlock) NewFromSSZ([]byte, uint32) (*testBlock, error) {
+	return &testBlock{}, nil
+}
+
+type testSidecars struct{}
+
+func (testSidecars) MarshalSSZ() ([]byte, error) { return nil, nil }
+func (testSidecars) UnmarshalSSZ([]byte) error   { return nil }
+func (testSidecars) Empty() testSidecars         { return testSidecars{} }
+
+type testGenesis struct{}
+
+func (testGenesis) UnmarshalJSON([]byte) error { return nil }
+
+// chainSpec has epochs of one slot and a payload timestamp bound of two
+// seconds from forkEpoch.
+type chainSpec struct {
+	common.ChainSpec
+}
+
+func (chainSpec) SlotToEpoch(slot math.Slot) math.Epoch {
+	return math.Epoch(slot)
+}
+
+func (chainSpec) PayloadTimestampBoundForkEpoch() math.Epoch {
+	return forkEpoch
+}
+
+func (chainSpec) PayloadTimestampBound() uint64 { return 2 }
+
+func TestValidatePayloadTimestamp(t *testing.T) {
+	h := &ABCIMiddleware[*testBlock, testSidecars, testGenesis, any]{
+		chainSpec: chainSpec{},
+	}
+	consensusTime := time.Unix(1_000, 0)
+
+	tests := []struct {
+		name      string
+		slot      math.Slot
+		timestamp math.U64
+		wantErr   bool
+	}{
+		{"before fork, within bound", forkEpoch - 1, 1_001, false},
+		{"before fork, after bound", forkEpoch - 1, 1_010, false},
+		{"before fork, before bound", forkEpoch - 1, 990, false},
+		{"at fork, equal time", forkEpoch, 1_000, false},
+		{"at fork, at upper bound", forkEpoch, 1_002, false},
+		{"at fork, at lower bound", forkEpoch, 998, false},
+		{"at fork, after bound", forkEpoch, 1_003, true},
+		{"at fork, before bound", forkEpoch, 997, true},
+		{"after fork, within bound", forkEpoch + 1, 999, false},
+		{"after fork, after bound", forkEpoch + 1, 1_010, true},
+		{"after fork, before bound", forkEpoch + 1, 990, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := h.validatePayloadTimestamp(
+				&testBlock{timestamp: tt.timestamp}, tt.slot, consensusTime,
+			)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrPayloadTimestampOutOfBound)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Proposals without a block are not checked.
+	require.NoError(t, h.validatePayloadTimestamp(
+		nil, forkEpoch, consensusTime,
+	))
+}
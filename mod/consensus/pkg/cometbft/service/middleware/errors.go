@@ -27,6 +27,12 @@ var (
 	// ErrUnexpectedEvent is returned when an unexpected event is encountered.
 	ErrUnexpectedEvent = errors.New("unexpected event")
 
+	// ErrPayloadTimestampOutOfBound is returned when the timestamp of the
+	// execution payload of a proposal is too far from the consensus time.
+	ErrPayloadTimestampOutOfBound = errors.New(
+		"payload timestamp out of bound of consensus time",
+	)
+
 	ErrInitGenesisTimeout = func(errTimeout error) error {
 		return errors.Wrapf(errTimeout,
 			"A timeout occurred while waiting for genesis data processing",
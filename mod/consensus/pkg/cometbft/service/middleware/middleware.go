@@ -45,6 +45,8 @@ type ABCIMiddleware[
 	metrics *ABCIMiddlewareMetrics
 	// logger is the logger for the middleware.
 	logger log.Logger
+	// rejections records the proposals rejected by ProcessProposal.
+	rejections RejectionRecorder
 	// subGenDataProcessed is the channel to hold GenesisDataProcessed events.
 	subGenDataProcessed chan async.Event[validatorUpdates]
 	// subBuiltBeaconBlock is the channel to hold BuiltBeaconBlock events.
@@ -71,6 +73,7 @@ func NewABCIMiddleware[
 	dispatcher types.EventDispatcher,
 	logger log.Logger,
 	telemetrySink TelemetrySink,
+	rejections RejectionRecorder,
 ) *ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, GenesisT, SlotDataT,
 ] {
@@ -80,6 +83,7 @@ func NewABCIMiddleware[
 		chainSpec:                chainSpec,
 		dispatcher:               dispatcher,
 		logger:                   logger,
+		rejections:               rejections,
 		metrics:                  newABCIMiddlewareMetrics(telemetrySink),
 		subGenDataProcessed:      make(chan async.Event[validatorUpdates]),
 		subBuiltBeaconBlock:      make(chan async.Event[BeaconBlockT]),
@@ -21,9 +21,11 @@
 package middleware
 
 import (
+	"context"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -33,6 +35,23 @@ type BeaconBlock[SelfT any] interface {
 	constraints.Nillable
 	constraints.Empty[SelfT]
 	NewFromSSZ([]byte, uint32) (SelfT, error)
+	// GetTimestamp returns the timestamp of the execution payload.
+	GetTimestamp() math.U64
+}
+
+// RejectionRecorder records the proposals rejected by ProcessProposal.
+type RejectionRecorder interface {
+	// RecordRejection records the proposal of the given height and
+	// transactions as rejected with the given error. The context holds the
+	// state the proposal was processed on top of.
+	RecordRejection(
+		ctx context.Context,
+		height int64,
+		consensusTime time.Time,
+		proposerAddress []byte,
+		txs [][]byte,
+		rejection error,
+	)
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -61,20 +61,6 @@ func (Service[_]) ApplySnapshotChunk(
 	return &abci.ApplySnapshotChunkResponse{}, nil
 }
 
-func (Service[_]) ExtendVote(
-	context.Context,
-	*abci.ExtendVoteRequest,
-) (*abci.ExtendVoteResponse, error) {
-	return &abci.ExtendVoteResponse{}, nil
-}
-
-func (Service[_]) VerifyVoteExtension(
-	context.Context,
-	*abci.VerifyVoteExtensionRequest,
-) (*abci.VerifyVoteExtensionResponse, error) {
-	return &abci.VerifyVoteExtensionResponse{}, nil
-}
-
 func (*Service[_]) CheckTx(
 	context.Context,
 	*abci.CheckTxRequest,
@@ -81,3 +81,10 @@ func SetChainID[
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetVoteExtender sets the vote extender the precommits are extended with.
+func SetVoteExtender[
+	LoggerT log.AdvancedLogger[LoggerT],
+](voteExtender VoteExtender) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.voteExtender = voteExtender }
+}
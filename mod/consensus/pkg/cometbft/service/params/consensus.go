@@ -30,6 +30,9 @@ type ChainSpec interface {
 	// GetCometBFTConfigForSlot returns the CometBFT configuration for the given
 	// slot.
 	GetCometBFTConfigForSlot(math.Slot) any
+	// VoteExtensionsEnableHeight returns the height from which the
+	// precommits are extended, zero if they are disabled.
+	VoteExtensionsEnableHeight() uint64
 }
 
 // ConsensusParamsStore is a store for consensus parameters.
@@ -47,8 +50,24 @@ func NewConsensusParamsStore(cs ChainSpec) *ConsensusParamsStore {
 // Get retrieves the consensus parameters from the store.
 // It returns the consensus parameters and an error, if any.
 func (s *ConsensusParamsStore) Get() *cmtproto.ConsensusParams {
-	p := s.cs.
-		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams).
-		ToProto()
+	cp := s.params()
+	p := cp.ToProto()
 	return &p
 }
+
+// VoteExtensionsEnabled returns true if the precommits of the given height
+// are extended.
+func (s *ConsensusParamsStore) VoteExtensionsEnabled(height int64) bool {
+	return s.params().Feature.VoteExtensionsEnabled(height)
+}
+
+// params returns the consensus parameters of the chain spec, with the vote
+// extensions enabled from the height it schedules them at.
+func (s *ConsensusParamsStore) params() cmttypes.ConsensusParams {
+	p := *s.cs.GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams)
+	//#nosec:G701 // heights never exceed the max int64.
+	p.Feature.VoteExtensionsEnableHeight = int64(
+		s.cs.VoteExtensionsEnableHeight(),
+	)
+	return p
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package params_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/params"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// chainSpec is a chain spec with the default consensus parameters.
+type chainSpec struct {
+	voteExtensionsEnableHeight uint64
+}
+
+func (cs chainSpec) GetCometBFTConfigForSlot(math.Slot) any {
+	return cmttypes.DefaultConsensusParams()
+}
+
+func (cs chainSpec) VoteExtensionsEnableHeight() uint64 {
+	return cs.voteExtensionsEnableHeight
+}
+
+func TestConsensusParamsStore_VoteExtensionsEnabled(t *testing.T) {
+	store := params.NewConsensusParamsStore(chainSpec{100})
+	require.False(t, store.VoteExtensionsEnabled(99))
+	require.True(t, store.VoteExtensionsEnabled(100))
+	require.Equal(
+		t, int64(100), store.Get().GetFeature().
+			GetVoteExtensionsEnableHeight().GetValue(),
+	)
+
+	store = params.NewConsensusParamsStore(chainSpec{})
+	require.False(t, store.VoteExtensionsEnabled(100))
+}
+
+// TestConsensusParamsStore_Upgrade checks the consensus parameters that
+// FinalizeBlock returns to a running chain against the update rules of
+// CometBFT, which halts the chain on an invalid update.
+func TestConsensusParamsStore_Upgrade(t *testing.T) {
+	const height = 1000
+	running := *cmttypes.DefaultConsensusParams()
+
+	// A chain spec not scheduling vote extensions keeps the parameters of the
+	// running chain.
+	store := params.NewConsensusParamsStore(chainSpec{})
+	require.NoError(t, running.ValidateUpdate(store.Get(), height))
+
+	// Vote extensions can only be scheduled at a future height.
+	store = params.NewConsensusParamsStore(chainSpec{1})
+	require.Error(t, running.ValidateUpdate(store.Get(), height))
+	store = params.NewConsensusParamsStore(chainSpec{height})
+	require.Error(t, running.ValidateUpdate(store.Get(), height))
+
+	store = params.NewConsensusParamsStore(chainSpec{height + 10})
+	require.NoError(t, running.ValidateUpdate(store.Get(), height))
+	upgraded := running.Update(store.Get())
+
+	// The same parameters are returned at every later height, before and
+	// after vote extensions are enabled.
+	for _, h := range []int64{height + 1, height + 10, height + 100} {
+		require.NoError(t, upgraded.ValidateUpdate(store.Get(), h))
+	}
+	require.True(t, store.VoteExtensionsEnabled(height+10))
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// errNodeNotStarted is returned when the CometBFT node is queried before it
+// is started.
+var errNodeNotStarted = errors.New("cometbft node is not started")
+
+// UpcomingProposerRounds returns the rounds, among the given number of
+// rounds of the given slot starting at the given round, in which the node is
+// the proposer. It follows the proposer priorities of the validator set, as
+// CometBFT does to select the proposer of each round.
+func (s *Service[_]) UpcomingProposerRounds(
+	slot math.Slot,
+	from, rounds uint32,
+) ([]uint32, error) {
+	env := s.rpcEnv
+	if env == nil {
+		return nil, errNodeNotStarted
+	}
+
+	//#nosec:G701 // heights fit into int64.
+	validators, err := env.StateStore.LoadValidators(int64(slot.Unwrap()))
+	if err != nil {
+		return nil, err
+	}
+
+	// CometBFT increments the priorities once per round.
+	if from > 0 {
+		//#nosec:G701 // the rounds searched fit into int32.
+		validators.IncrementProposerPriority(int32(from))
+	}
+	var proposerRounds []uint32
+	address := env.PubKey.Address()
+	for round := from; round < from+rounds; round++ {
+		if round > from {
+			validators.IncrementProposerPriority(1)
+		}
+		if bytes.Equal(validators.GetProposer().Address, address) {
+			proposerRounds = append(proposerRounds, round)
+		}
+	}
+	return proposerRounds, nil
+}
+
+// CurrentRound returns the round CometBFT is in at the height of the given
+// slot, or 0 if it is at another height.
+func (s *Service[_]) CurrentRound(slot math.Slot) (uint32, error) {
+	env := s.rpcEnv
+	if env == nil {
+		return 0, errNodeNotStarted
+	}
+
+	bz, err := env.ConsensusState.GetRoundStateSimpleJSON()
+	if err != nil {
+		return 0, err
+	}
+	var roundState struct {
+		HeightRoundStep string `json:"height/round/step"`
+	}
+	if err = json.Unmarshal(bz, &roundState); err != nil {
+		return 0, err
+	}
+
+	var (
+		height uint64
+		round  uint32
+		step   uint8
+	)
+	if _, err = fmt.Sscanf(
+		roundState.HeightRoundStep, "%d/%d/%d", &height, &round, &step,
+	); err != nil {
+		return 0, err
+	}
+	if height != slot.Unwrap() {
+		return 0, nil
+	}
+	return round, nil
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	rpccore "github.com/cometbft/cometbft/rpc/core"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// stateStore serves the validator set of every height.
+type stateStore struct {
+	sm.Store
+	validators *cmttypes.ValidatorSet
+}
+
+func (s stateStore) LoadValidators(int64) (*cmttypes.ValidatorSet, error) {
+	return s.validators.Copy(), nil
+}
+
+// consensusState is in the given height, round and step.
+type consensusState struct {
+	rpccore.Consensus
+	heightRoundStep string
+}
+
+func (cs consensusState) GetRoundStateSimpleJSON() ([]byte, error) {
+	return []byte(`{"height/round/step":"` + cs.heightRoundStep + `"}`), nil
+}
+
+// newProposerService returns a service whose node is one of two validators
+// of equal power, which take turns proposing, along with the first round
+// the node proposes in.
+func newProposerService(
+	t *testing.T, heightRoundStep string,
+) (*Service[*phuslu.Logger], uint32) {
+	t.Helper()
+	key := ed25519.GenPrivKey()
+	validators := cmttypes.NewValidatorSet([]*cmttypes.Validator{
+		cmttypes.NewValidator(key.PubKey(), 10),
+		cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10),
+	})
+	first := uint32(1)
+	if validators.GetProposer().Address.String() ==
+		key.PubKey().Address().String() {
+		first = 0
+	}
+
+	return &Service[*phuslu.Logger]{
+		rpcEnv: &rpccore.Environment{
+			StateStore:     stateStore{validators: validators},
+			PubKey:         key.PubKey(),
+			ConsensusState: consensusState{heightRoundStep: heightRoundStep},
+		},
+	}, first
+}
+
+func TestUpcomingProposerRounds(t *testing.T) {
+	s, first := newProposerService(t, "")
+
+	rounds, err := s.UpcomingProposerRounds(10, 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{first, first + 2}, rounds)
+
+	// The rounds are searched from the given round.
+	rounds, err = s.UpcomingProposerRounds(10, 1, 4)
+	require.NoError(t, err)
+	if first == 0 {
+		require.Equal(t, []uint32{2, 4}, rounds)
+	} else {
+		require.Equal(t, []uint32{1, 3}, rounds)
+	}
+
+	rounds, err = s.UpcomingProposerRounds(10, 1-first, 1)
+	require.NoError(t, err)
+	require.Empty(t, rounds)
+}
+
+func TestCurrentRound(t *testing.T) {
+	s, _ := newProposerService(t, "10/3/4")
+
+	round, err := s.CurrentRound(10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), round)
+
+	// The round of another height is ignored.
+	round, err = s.CurrentRound(11)
+	require.NoError(t, err)
+	require.Zero(t, round)
+}
+
+func TestProposerScheduleRequiresNode(t *testing.T) {
+	s := &Service[*phuslu.Logger]{}
+	_, err := s.UpcomingProposerRounds(10, 0, 1)
+	require.ErrorIs(t, err, errNodeNotStarted)
+	_, err = s.CurrentRound(10)
+	require.ErrorIs(t, err, errNodeNotStarted)
+}
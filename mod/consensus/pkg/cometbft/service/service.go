@@ -38,6 +38,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	rpccore "github.com/cometbft/cometbft/rpc/core"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -53,10 +54,17 @@ type Service[
 	node   *node.Node
 	cmtCfg *cmtcfg.Config
 
+	// rpcEnv gives access to the stores of the node.
+	rpcEnv *rpccore.Environment
+
 	logger     LoggerT
 	sm         *statem.Manager
 	Middleware MiddlewareI
 
+	// voteExtender extends the precommits of the node, nil if precommits
+	// are not extended.
+	voteExtender VoteExtender
+
 	// prepareProposalState is used for PrepareProposal, which is set based on the
 	// previous block's state. This state is never committed. In case of multiple
 	// consensus rounds, the state is always reset to the previous block's state.
@@ -150,6 +158,10 @@ func (s *Service[_]) Start(
 		return err
 	}
 
+	if s.rpcEnv, err = s.node.ConfigureRPC(); err != nil {
+		return err
+	}
+
 	return s.node.Start()
 }
 
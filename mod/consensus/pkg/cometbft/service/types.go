@@ -65,6 +65,35 @@ type MiddlewareI interface {
 	) (transition.ValidatorUpdates, error)
 }
 
+// VoteExtender is an interface for extending precommits with application
+// data, such as inclusion lists.
+type VoteExtender interface {
+	// ExtendVote returns the vote extension of the precommit of the given
+	// validator for the block of the given height carrying the given
+	// transactions.
+	ExtendVote(
+		ctx context.Context,
+		height int64,
+		txs [][]byte,
+		validatorAddress []byte,
+	) []byte
+	// VerifyVoteExtension returns an error if the vote extension of the
+	// precommit of the given validator at the given height is invalid.
+	VerifyVoteExtension(
+		height int64, validatorAddress []byte, extension []byte,
+	) error
+	// SetCommit records the validators whose precommits are part of the
+	// commit of the given height, along with their voting powers and vote
+	// extensions, and the voting power of the validator set of the height.
+	SetCommit(
+		height int64,
+		validatorAddresses [][]byte,
+		powers []int64,
+		extensions [][]byte,
+		totalPower int64,
+	)
+}
+
 // SlashingInfo is an interface for accessing the slashing info.
 type SlashingInfo[SlashingInfoT any] interface {
 	// New creates a new slashing info instance.
@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// extendedCommitTxIndex is the index of the transaction holding the extended
+// commit of the previous height in a proposal. It follows the beacon block
+// and blob sidecars transactions.
+const extendedCommitTxIndex = 2
+
+var (
+	// errMissingExtendedCommit is returned when a proposal does not carry
+	// the extended commit of the previous height while its precommits are
+	// extended.
+	errMissingExtendedCommit = errors.New("missing extended commit")
+	// errExtendedCommitMismatch is returned when the extended commit carried
+	// by a proposal differs from the commit of the previous height.
+	errExtendedCommitMismatch = errors.New(
+		"extended commit does not match last commit",
+	)
+	// errInvalidExtensionSignature is returned when a vote extension of the
+	// extended commit carried by a proposal is not signed by its validator.
+	errInvalidExtensionSignature = errors.New(
+		"invalid vote extension signature",
+	)
+)
+
+// ExtendVote extends the precommit of the node with the vote extension of
+// the vote extender, if any.
+func (s *Service[_]) ExtendVote(
+	ctx context.Context,
+	req *cmtabci.ExtendVoteRequest,
+) (*cmtabci.ExtendVoteResponse, error) {
+	if s.voteExtender == nil || s.rpcEnv == nil {
+		return &cmtabci.ExtendVoteResponse{}, nil
+	}
+
+	return &cmtabci.ExtendVoteResponse{
+		VoteExtension: s.voteExtender.ExtendVote(
+			ctx, req.Height, req.Txs, s.rpcEnv.PubKey.Address(),
+		),
+	}, nil
+}
+
+// VerifyVoteExtension rejects the precommits whose vote extension is deemed
+// invalid by the vote extender, if any.
+func (s *Service[_]) VerifyVoteExtension(
+	_ context.Context,
+	req *cmtabci.VerifyVoteExtensionRequest,
+) (*cmtabci.VerifyVoteExtensionResponse, error) {
+	if s.voteExtender == nil {
+		return &cmtabci.VerifyVoteExtensionResponse{
+			Status: cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+		}, nil
+	}
+
+	if err := s.voteExtender.VerifyVoteExtension(
+		req.Height, req.ValidatorAddress, req.VoteExtension,
+	); err != nil {
+		s.logger.Warn(
+			"Rejecting vote extension",
+			"height", req.Height,
+			"validator", req.ValidatorAddress,
+			"error", err,
+		)
+		return &cmtabci.VerifyVoteExtensionResponse{
+			Status: cmtabci.VERIFY_VOTE_EXTENSION_STATUS_REJECT,
+		}, nil
+	}
+	return &cmtabci.VerifyVoteExtensionResponse{
+		Status: cmtabci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT,
+	}, nil
+}
+
+// appendExtendedCommit appends the extended commit of the previous height to
+// the transactions of the proposal of the given height, once the precommits
+// of the previous height are extended.
+func (s *Service[_]) appendExtendedCommit(
+	height int64,
+	txs [][]byte,
+	commit cmtabci.ExtendedCommitInfo,
+) ([][]byte, error) {
+	if !s.paramStore.VoteExtensionsEnabled(height - 1) {
+		return txs, nil
+	}
+
+	bz, err := commit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(txs, bz), nil
+}
+
+// processExtendedCommit verifies the extended commit of the previous height
+// carried by the proposal of the given height against the commit of the
+// proposal, and passes it to the vote extender, if any. All nodes thereby
+// check the payload of the proposal against the same vote extensions.
+// Proposals without beacon block, which proposers fall back to when they
+// fail to build one, have no payload to check and need not carry it.
+func (s *Service[_]) processExtendedCommit(
+	height int64,
+	txs [][]byte,
+	commit cmtabci.CommitInfo,
+) error {
+	if !s.paramStore.VoteExtensionsEnabled(height-1) ||
+		!hasBeaconBlock(txs) && len(txs) <= extendedCommitTxIndex {
+		s.setCommit(height, commit)
+		return nil
+	}
+
+	if len(txs) <= extendedCommitTxIndex {
+		return errMissingExtendedCommit
+	}
+	var extendedCommit cmtabci.ExtendedCommitInfo
+	if err := extendedCommit.Unmarshal(
+		txs[extendedCommitTxIndex],
+	); err != nil {
+		return err
+	}
+	if err := s.verifyExtendedCommit(
+		height, extendedCommit, commit,
+	); err != nil {
+		return err
+	}
+	s.setExtendedCommit(height, extendedCommit)
+	return nil
+}
+
+// hasBeaconBlock returns true if the transactions of a proposal carry a
+// beacon block.
+func hasBeaconBlock(txs [][]byte) bool {
+	return uint(len(txs)) > middleware.BeaconBlockTxIndex &&
+		len(txs[middleware.BeaconBlockTxIndex]) > 0
+}
+
+// verifyExtendedCommit returns an error if the extended commit differs from
+// the commit of the previous height, or if its vote extensions are not
+// signed by their validators.
+func (s *Service[_]) verifyExtendedCommit(
+	height int64,
+	extendedCommit cmtabci.ExtendedCommitInfo,
+	commit cmtabci.CommitInfo,
+) error {
+	env := s.rpcEnv
+	if env == nil {
+		return errNodeNotStarted
+	}
+
+	if extendedCommit.Round != commit.Round ||
+		len(extendedCommit.Votes) != len(commit.Votes) {
+		return errExtendedCommitMismatch
+	}
+
+	validators, err := env.StateStore.LoadValidators(height - 1)
+	if err != nil {
+		return err
+	}
+	for i, vote := range extendedCommit.Votes {
+		if !bytes.Equal(
+			vote.Validator.Address, commit.Votes[i].Validator.Address,
+		) || vote.Validator.Power != commit.Votes[i].Validator.Power ||
+			vote.BlockIdFlag != commit.Votes[i].BlockIdFlag {
+			return errExtendedCommitMismatch
+		}
+
+		// Only precommits for the block are extended.
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			if len(vote.VoteExtension) > 0 ||
+				len(vote.ExtensionSignature) > 0 {
+				return errExtendedCommitMismatch
+			}
+			continue
+		}
+
+		_, validator := validators.GetByAddress(vote.Validator.Address)
+		if validator == nil {
+			return errExtendedCommitMismatch
+		}
+		if !validator.PubKey.VerifySignature(
+			cmttypes.VoteExtensionSignBytes(s.chainID, &cmtproto.Vote{
+				Extension: vote.VoteExtension,
+				Height:    height - 1,
+				Round:     extendedCommit.Round,
+			}),
+			vote.ExtensionSignature,
+		) {
+			return errInvalidExtensionSignature
+		}
+	}
+	return nil
+}
+
+// setExtendedCommit passes the commit of the previous height, along with its
+// vote extensions, to the vote extender, if any.
+func (s *Service[_]) setExtendedCommit(
+	height int64,
+	commit cmtabci.ExtendedCommitInfo,
+) {
+	if s.voteExtender == nil {
+		return
+	}
+
+	var (
+		addresses, extensions [][]byte
+		powers                []int64
+		totalPower            int64
+	)
+	for _, vote := range commit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		addresses = append(addresses, vote.Validator.Address)
+		powers = append(powers, vote.Validator.Power)
+		extensions = append(extensions, vote.VoteExtension)
+	}
+	s.voteExtender.SetCommit(
+		height-1, addresses, powers, extensions, totalPower,
+	)
+}
+
+// setCommit passes the commit of the previous height to the vote extender,
+// if any.
+func (s *Service[_]) setCommit(height int64, commit cmtabci.CommitInfo) {
+	if s.voteExtender == nil {
+		return
+	}
+
+	var (
+		addresses  [][]byte
+		powers     []int64
+		totalPower int64
+	)
+	for _, vote := range commit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		addresses = append(addresses, vote.Validator.Address)
+		powers = append(powers, vote.Validator.Power)
+	}
+	s.voteExtender.SetCommit(height-1, addresses, powers, nil, totalPower)
+}
@@ -30,6 +30,11 @@ type SlotData[AttestationDataT, SlashingInfoT any] struct {
 	AttestationData []AttestationDataT
 	// SlashingInfo is the slashing info of the incoming slot.
 	SlashingInfo []SlashingInfoT
+	// Time is the consensus time of the incoming slot, in unix seconds.
+	Time math.U64
+	// EmptyPayload requests the block of the incoming slot to be built with
+	// a payload without transactions, after building it failed.
+	EmptyPayload bool
 }
 
 // New creates a new SlotData instance.
@@ -51,6 +56,17 @@ func (b *SlotData[AttestationDataT, SlashingInfoT]) GetSlot() math.Slot {
 	return b.Slot
 }
 
+// GetTime retrieves the consensus time of the SlotData.
+func (b *SlotData[AttestationDataT, SlashingInfoT]) GetTime() math.U64 {
+	return b.Time
+}
+
+// GetEmptyPayload returns true if the block of the SlotData is to be built
+// with a payload without transactions.
+func (b *SlotData[AttestationDataT, SlashingInfoT]) GetEmptyPayload() bool {
+	return b.EmptyPayload
+}
+
 // GetAttestationData retrieves the attestation data of the SlotData.
 func (b *SlotData[
 	AttestationDataT,
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInconsistentBlobsBundle is returned when the blobs, commitments and
+	// proofs of a blobs bundle differ in length, as the sidecars of the
+	// bundle could not be built without dropping some of them.
+	ErrInconsistentBlobsBundle = errors.New("inconsistent blobs bundle")
+
+	// ErrDataColumnsNotActive is returned when data column sidecars are
+	// built or processed for a slot before the fork enabling them.
+	ErrDataColumnsNotActive = errors.New(
+		"data column sidecars are not active at this slot",
+	)
+
+	// ErrDataColumnsDifferingBlockRoots is returned when the data column
+	// sidecars processed together are from different blocks.
+	ErrDataColumnsDifferingBlockRoots = errors.New(
+		"data column sidecars with differing block roots",
+	)
+
+	// ErrBodyLengthMismatch is returned when the block body has a number of
+	// fields other than the one of the KZG inclusion of its fork.
+	ErrBodyLengthMismatch = errors.New(
+		"block body length does not match the kzg inclusion of its fork",
+	)
+)
@@ -23,11 +23,15 @@ package blob
 import (
 	"time"
 
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -39,10 +43,8 @@ type SidecarFactory[
 ] struct {
 	// chainSpec defines the specifications of the blockchain.
 	chainSpec ChainSpec
-	// kzgPosition is the position of the KZG commitment in the block.
-	//
-	// TODO: This needs to be made configurable / modular.
-	kzgPosition uint64
+	// prover computes the cells and cell proofs of the data columns.
+	prover CellProver
 	// metrics is used to collect and report factory metrics.
 	metrics *factoryMetrics
 }
@@ -54,8 +56,7 @@ func NewSidecarFactory[
 	BeaconBlockHeaderT any,
 ](
 	chainSpec ChainSpec,
-	// todo: calculate from config.
-	kzgPosition uint64,
+	prover CellProver,
 	telemetrySink TelemetrySink,
 ) *SidecarFactory[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
@@ -64,13 +65,13 @@ func NewSidecarFactory[
 		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	]{
 		chainSpec: chainSpec,
-		// TODO: This should be configurable / modular.
-		kzgPosition: kzgPosition,
-		metrics:     newFactoryMetrics(telemetrySink),
+		prover:    prover,
+		metrics:   newFactoryMetrics(telemetrySink),
 	}
 }
 
-// BuildSidecars builds a sidecar.
+// BuildSidecars builds a sidecar. Blocks without blobs, such as blocks with
+// an empty payload, have no sidecars.
 func (f *SidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
 	blk BeaconBlockT,
 	bundle engineprimitives.BlobsBundle,
@@ -89,10 +90,20 @@ func (f *SidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
 	defer f.metrics.measureBuildSidecarsDuration(
 		startTime, math.U64(numBlobs),
 	)
+	if len(commitments) != len(blobs) || len(proofs) != len(blobs) {
+		return nil, ErrInconsistentBlobsBundle
+	}
+	if numBlobs == 0 {
+		return &types.BlobSidecars{Sidecars: sidecars}, nil
+	}
+	inclusion, err := f.kzgInclusion(blk.GetSlot(), body)
+	if err != nil {
+		return nil, err
+	}
 	for i := range numBlobs {
 		g.Go(func() error {
 			inclusionProof, err := f.BuildKZGInclusionProof(
-				body, math.U64(i),
+				body, inclusion, math.U64(i),
 			)
 			if err != nil {
 				return err
@@ -111,9 +122,101 @@ func (f *SidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
 	return &types.BlobSidecars{Sidecars: sidecars}, g.Wait()
 }
 
+// BuildDataColumnSidecars builds the data column sidecars of a block as per
+// EIP-7594, once the fork enabling them is active. Each column holds the cell
+// of the same index of every blob of the block.
+func (f *SidecarFactory[BeaconBlockT, _, _]) BuildDataColumnSidecars(
+	blk BeaconBlockT,
+	bundle engineprimitives.BlobsBundle,
+) ([]*types.DataColumnSidecar, error) {
+	var (
+		blobs       = bundle.GetBlobs()
+		commitments = bundle.GetCommitments()
+		numBlobs    = uint64(len(blobs))
+		cells       = make([][]eip4844.Cell, numBlobs)
+		proofs      = make([][]eip4844.KZGProof, numBlobs)
+		g           = errgroup.Group{}
+	)
+
+	if f.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		return nil, ErrDataColumnsNotActive
+	}
+
+	startTime := time.Now()
+	defer f.metrics.measureBuildDataColumnSidecarsDuration(
+		startTime, math.U64(numBlobs),
+	)
+	if len(commitments) != len(blobs) {
+		return nil, ErrInconsistentBlobsBundle
+	}
+	if numBlobs == 0 {
+		return nil, nil
+	}
+	for i := range numBlobs {
+		g.Go(func() error {
+			var err error
+			cells[i], proofs[i], err = f.prover.ComputeCellsAndKZGProofs(
+				blobs[i],
+			)
+			return err
+		})
+	}
+
+	// All the columns share the proof of the list of commitments.
+	inclusion, err := f.kzgInclusion(blk.GetSlot(), blk.GetBody())
+	if err != nil {
+		return nil, err
+	}
+	inclusionProof, err := f.BuildBlockBodyProof(blk.GetBody(), inclusion)
+	if err != nil {
+		return nil, err
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	sidecars := make([]*types.DataColumnSidecar, eip4844.CellsPerExtBlob)
+	for index := range sidecars {
+		column := make([]*eip4844.Cell, numBlobs)
+		columnProofs := make([]eip4844.KZGProof, numBlobs)
+		for i := range numBlobs {
+			column[i] = &cells[i][index]
+			columnProofs[i] = proofs[i][index]
+		}
+		sidecars[index] = types.BuildDataColumnSidecar(
+			uint64(index), blk.GetHeader(),
+			column,
+			commitments,
+			columnProofs,
+			inclusionProof,
+		)
+	}
+	return sidecars, nil
+}
+
+// kzgInclusion returns the KZG inclusion of the fork active at the given slot,
+// after checking that the block body is laid out as it describes.
+func (f *SidecarFactory[_, BeaconBlockBodyT, _]) kzgInclusion(
+	slot math.Slot,
+	body BeaconBlockBodyT,
+) (ctypes.KZGInclusion, error) {
+	inclusion, err := kzgInclusionForSlot(f.chainSpec, slot)
+	if err != nil {
+		return ctypes.KZGInclusion{}, err
+	}
+	if body.Length() != inclusion.BodyLength {
+		return ctypes.KZGInclusion{}, errors.Wrapf(
+			ErrBodyLengthMismatch,
+			"body: %d, expected: %d", body.Length(), inclusion.BodyLength,
+		)
+	}
+	return inclusion, nil
+}
+
 // BuildKZGInclusionProof builds a KZG inclusion proof.
 func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildKZGInclusionProof(
 	body BeaconBlockBodyT,
+	inclusion ctypes.KZGInclusion,
 	index math.U64,
 ) ([]common.Root, error) {
 	startTime := time.Now()
@@ -121,13 +224,13 @@ func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildKZGInclusionProof(
 
 	// Build the merkle proof to the commitment within the
 	// list of commitments.
-	commitmentsProof, err := f.BuildCommitmentProof(body, index)
+	commitmentsProof, err := f.BuildCommitmentProof(body, inclusion, index)
 	if err != nil {
 		return nil, err
 	}
 
 	// Build the merkle proof for the body root.
-	bodyProof, err := f.BuildBlockBodyProof(body)
+	bodyProof, err := f.BuildBlockBodyProof(body, inclusion)
 	if err != nil {
 		return nil, err
 	}
@@ -140,30 +243,32 @@ func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildKZGInclusionProof(
 // BuildBlockBodyProof builds a block body proof.
 func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildBlockBodyProof(
 	body BeaconBlockBodyT,
+	inclusion ctypes.KZGInclusion,
 ) ([]common.Root, error) {
 	startTime := time.Now()
 	defer f.metrics.measureBuildBlockBodyProofDuration(startTime)
 	tree, err := merkle.NewTreeWithMaxLeaves[common.Root](
 		body.GetTopLevelRoots(),
-		body.Length()-1,
+		inclusion.BodyLength,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return tree.MerkleProof(f.kzgPosition)
+	return tree.MerkleProof(inclusion.Position)
 }
 
 // BuildCommitmentProof builds a commitment proof.
 func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildCommitmentProof(
 	body BeaconBlockBodyT,
+	inclusion ctypes.KZGInclusion,
 	index math.U64,
 ) ([]common.Root, error) {
 	startTime := time.Now()
 	defer f.metrics.measureBuildCommitmentProofDuration(startTime)
 	bodyTree, err := merkle.NewTreeWithMaxLeaves[common.Root](
 		body.GetBlobKzgCommitments().Leafify(),
-		f.chainSpec.MaxBlobCommitmentsPerBlock(),
+		inclusion.MaxBlobCommitments,
 	)
 	if err != nil {
 		return nil, err
@@ -85,3 +85,16 @@ func (fm *factoryMetrics) measureBuildCommitmentProofDuration(
 		startTime,
 	)
 }
+
+// measureBuildDataColumnSidecarsDuration measures the duration of the build
+// of the data column sidecars.
+func (fm *factoryMetrics) measureBuildDataColumnSidecarsDuration(
+	startTime time.Time, numBlobs math.U64,
+) {
+	fm.sink.MeasureSince(
+		"beacon_kit.da.blob.factory.build_data_column_sidecars_duration",
+		startTime,
+		"num_blobs",
+		numBlobs.Base10(),
+	)
+}
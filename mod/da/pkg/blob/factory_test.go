@@ -20,18 +20,104 @@
 
 package blob_test
 
+import (
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/noop"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
 // TODO: Create a mock such that core/types doesn't need
 // to be imported here.
 
 // MockSpec is a mock implementation of the ChainSpec interface used for
 // testing.
-type MockSpec struct{}
+type MockSpec struct {
+	// fork is the fork version active at every slot.
+	fork uint32
+}
+
+// ActiveForkVersionForSlot returns the fork version of the mock.
+func (m *MockSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return m.fork
+}
+
+// noopSink is a TelemetrySink discarding the metrics.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string)        {}
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+func TestBuildDataColumnSidecars(t *testing.T) {
+	body := (&ctypes.BeaconBlockBody{}).Empty(version.Deneb)
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{}
+	for i := range 3 {
+		commitment := eip4844.KZGCommitment{byte(i + 1)}
+		body.BlobKzgCommitments = append(body.BlobKzgCommitments, commitment)
+		bundle.Commitments = append(bundle.Commitments, commitment)
+		bundle.Proofs = append(bundle.Proofs, eip4844.KZGProof{})
+		bundle.Blobs = append(bundle.Blobs, &eip4844.Blob{31: byte(i + 1)})
+	}
+	blk := &ctypes.BeaconBlock{Slot: 7, Body: body}
+
+	prover := noop.NewVerifier()
+	newFactory := func(fork uint32) *blob.SidecarFactory[
+		*ctypes.BeaconBlock, *ctypes.BeaconBlockBody, *ctypes.BeaconBlockHeader,
+	] {
+		return blob.NewSidecarFactory[
+			*ctypes.BeaconBlock,
+			*ctypes.BeaconBlockBody,
+			*ctypes.BeaconBlockHeader,
+		](&MockSpec{fork: fork}, prover, noopSink{})
+	}
+	verifier := blob.NewVerifier[
+		*ctypes.BeaconBlockHeader, *types.BlobSidecar, *types.BlobSidecars,
+	](prover, &MockSpec{fork: version.Electra}, noopSink{})
+	inclusion, err := ctypes.KZGInclusionForVersion(version.Electra)
+	require.NoError(t, err)
+
+	columns, err := newFactory(version.Electra).BuildDataColumnSidecars(
+		blk, bundle,
+	)
+	require.NoError(t, err)
+	require.Len(t, columns, eip4844.CellsPerExtBlob)
+	for index, column := range columns {
+		require.Equal(t, uint64(index), column.GetIndex())
+		require.Len(t, column.GetColumn(), 3)
+		require.True(t, column.HasValidInclusionProof(inclusion))
+	}
+	// The first cell of a blob holds its first field elements.
+	require.Equal(t, byte(2), columns[0].GetColumn()[1][31])
+	require.NoError(t, verifier.VerifyDataColumnSidecars(columns))
+
+	// The columns survive an SSZ round trip.
+	bz, err := columns[5].MarshalSSZ()
+	require.NoError(t, err)
+	decoded := new(types.DataColumnSidecar)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, columns[5], decoded)
+
+	columns[5].KzgCommitments = columns[5].KzgCommitments[:2]
+	columns[5].Column = columns[5].Column[:2]
+	columns[5].KzgProofs = columns[5].KzgProofs[:2]
+	require.ErrorIs(
+		t, verifier.VerifyDataColumnSidecars(columns),
+		types.ErrInvalidInclusionProof,
+	)
 
-// MaxBlobCommitmentsPerBlock returns the maximum number of blob commitments per
-// block.
-// This mock implementation always returns 16.
-func (m *MockSpec) MaxBlobCommitmentsPerBlock() uint64 {
-	return 16
+	// Data columns are not built before the fork enabling them.
+	_, err = newFactory(version.Deneb).BuildDataColumnSidecars(blk, bundle)
+	require.ErrorIs(t, err, blob.ErrDataColumnsNotActive)
 }
 
 // TODO: Re-enable once we can easily decouple from core/types.
@@ -23,9 +23,11 @@ package blob
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // Processor is the blob processor that handles the processing and verification
@@ -45,9 +47,6 @@ type Processor[
 	chainSpec common.ChainSpec
 	// verifier is responsible for verifying the blobs.
 	verifier BlobVerifier[BlobSidecarsT]
-	// blockBodyOffsetFn is a function that calculates the block body offset
-	// based on the slot and chain specifications.
-	blockBodyOffsetFn func(math.Slot, common.ChainSpec) uint64
 	// metrics is used to collect and report processor metrics.
 	metrics *processorMetrics
 }
@@ -65,7 +64,6 @@ func NewProcessor[
 	logger log.Logger,
 	chainSpec common.ChainSpec,
 	verifier BlobVerifier[BlobSidecarsT],
-	blockBodyOffsetFn func(math.Slot, common.ChainSpec) uint64,
 	telemetrySink TelemetrySink,
 ) *Processor[
 	AvailabilityStoreT, BeaconBlockBodyT, BeaconBlockHeaderT,
@@ -75,11 +73,10 @@ func NewProcessor[
 		AvailabilityStoreT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BlobSidecarT, BlobSidecarsT,
 	]{
-		logger:            logger,
-		chainSpec:         chainSpec,
-		verifier:          verifier,
-		blockBodyOffsetFn: blockBodyOffsetFn,
-		metrics:           newProcessorMetrics(telemetrySink),
+		logger:    logger,
+		chainSpec: chainSpec,
+		verifier:  verifier,
+		metrics:   newProcessorMetrics(telemetrySink),
 	}
 }
 
@@ -98,13 +95,15 @@ func (sp *Processor[AvailabilityStoreT, _, _, _, BlobSidecarsT]) VerifySidecars(
 	}
 
 	// Verify the blobs and ensure they match the local state.
-	return sp.verifier.VerifySidecars(
-		sidecars,
-		sp.blockBodyOffsetFn(
-			sidecars.Get(0).GetBeaconBlockHeader().GetSlot(),
-			sp.chainSpec,
-		),
-	)
+	return sp.verifier.VerifySidecars(sidecars)
+}
+
+// VerifySidecarsBatch verifies the blobs of several blocks, returning the
+// error of each block at its index.
+func (sp *Processor[_, _, _, _, BlobSidecarsT]) VerifySidecarsBatch(
+	blocks []BlobSidecarsT,
+) []error {
+	return sp.verifier.VerifySidecarsBatch(blocks)
 }
 
 // slot :=  processes the blobs and ensures they match the local state.
@@ -131,3 +130,41 @@ func (sp *Processor[
 		sidecars,
 	)
 }
+
+// VerifyDataColumnSidecars verifies the data column sidecars of a block, once
+// the fork enabling them is active.
+func (sp *Processor[_, _, _, _, _]) VerifyDataColumnSidecars(
+	columns []*types.DataColumnSidecar,
+) error {
+	// Abort if there are no columns to verify.
+	if len(columns) == 0 {
+		return nil
+	}
+
+	if sp.chainSpec.ActiveForkVersionForSlot(
+		columns[0].GetBeaconBlockHeader().GetSlot(),
+	) < version.Electra {
+		return ErrDataColumnsNotActive
+	}
+	return sp.verifier.VerifyDataColumnSidecars(columns)
+}
+
+// ProcessDataColumnSidecars persists the data column sidecars of a block,
+// which are assumed to be verified.
+func (sp *Processor[_, _, _, _, _]) ProcessDataColumnSidecars(
+	store DataColumnStore,
+	columns []*types.DataColumnSidecar,
+) error {
+	startTime := time.Now()
+	defer sp.metrics.measureProcessDataColumnSidecarsDuration(
+		startTime, math.U64(len(columns)),
+	)
+
+	// Abort if there are no columns to store.
+	if len(columns) == 0 {
+		return nil
+	}
+	return store.PersistDataColumns(
+		columns[0].GetBeaconBlockHeader().GetSlot(), columns,
+	)
+}
@@ -66,3 +66,17 @@ func (pm *processorMetrics) measureProcessSidecarsDuration(
 		numSidecars.Base10(),
 	)
 }
+
+// measureProcessDataColumnSidecarsDuration measures the duration of the data
+// column sidecars processing.
+func (pm *processorMetrics) measureProcessDataColumnSidecarsDuration(
+	startTime time.Time,
+	numColumns math.U64,
+) {
+	pm.sink.MeasureSince(
+		"beacon_kit.da.blob.processor.process_data_columns_duration",
+		startTime,
+		"num_columns",
+		numColumns.Base10(),
+	)
+}
@@ -24,6 +24,8 @@ import (
 	"context"
 	"time"
 
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -47,6 +49,7 @@ type BeaconBlock[
 ] interface {
 	GetBody() BeaconBlockBodyT
 	GetHeader() BeaconBlockHeaderT
+	GetSlot() math.Slot
 }
 
 type BeaconBlockBody interface {
@@ -61,12 +64,28 @@ type BeaconBlockHeader interface {
 
 //nolint:revive // name conflict
 type BlobVerifier[BlobSidecarsT any] interface {
-	VerifyInclusionProofs(scs BlobSidecarsT, kzgOffset uint64) error
+	VerifyInclusionProofs(scs BlobSidecarsT) error
 	VerifyKZGProofs(scs BlobSidecarsT) error
-	VerifySidecars(sidecars BlobSidecarsT, kzgOffset uint64) error
+	VerifySidecars(sidecars BlobSidecarsT) error
+	VerifySidecarsBatch(blocks []BlobSidecarsT) []error
+	VerifyDataColumnSidecars(columns []*types.DataColumnSidecar) error
+}
+
+// CellProver computes the cells of the data columns of a blob, along with
+// their KZG proofs.
+type CellProver interface {
+	ComputeCellsAndKZGProofs(
+		blob *eip4844.Blob,
+	) ([]eip4844.Cell, []eip4844.KZGProof, error)
+}
+
+// DataColumnStore stores the data column sidecars of a slot.
+type DataColumnStore interface {
+	PersistDataColumns(math.Slot, []*types.DataColumnSidecar) error
 }
 
 type Sidecar[BeaconBlockHeaderT any] interface {
+	HashTreeRoot() common.Root
 	GetBeaconBlockHeader() BeaconBlockHeaderT
 	GetBlob() eip4844.Blob
 	GetKzgProof() eip4844.KZGProof
@@ -78,16 +97,19 @@ type Sidecars[SidecarT any] interface {
 	Get(index int) SidecarT
 	GetSidecars() []SidecarT
 	ValidateBlockRoots() error
-	VerifyInclusionProofs(kzgOffset uint64) error
+	VerifyInclusionProofs(inclusion ctypes.KZGInclusion) error
 }
 
 // ChainSpec represents a chain spec.
 type ChainSpec interface {
-	MaxBlobCommitmentsPerBlock() uint64
+	ActiveForkVersionForSlot(slot math.Slot) uint32
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
@@ -25,10 +25,18 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/sourcegraph/conc/iter"
 	"golang.org/x/sync/errgroup"
 )
 
+// verifiedCacheSize is the number of sidecars remembered as verified, which
+// covers the sidecars of many blocks.
+const verifiedCacheSize = 1024
+
 // Verifier is responsible for verifying blobs, including their
 // inclusion and KZG proofs.
 type Verifier[
@@ -38,29 +46,45 @@ type Verifier[
 ] struct {
 	// proofVerifier is used to verify the KZG proofs of the blobs.
 	proofVerifier kzg.BlobProofVerifier
+	// chainSpec defines the specifications of the blockchain, from which the
+	// layout of the inclusion proofs of a slot is derived.
+	chainSpec ChainSpec
+	// verified holds the hash tree roots of the sidecars whose inclusion and
+	// KZG proofs were verified, so that the sidecars of a block are verified
+	// once across the rounds of ProcessProposal and in FinalizeBlock.
+	verified *lru.Cache[common.Root, struct{}]
 	// metrics collects and reports metrics related to the verification process.
 	metrics *verifierMetrics
 }
 
-// NewVerifier creates a new Verifier with the given proof verifier.
+// NewVerifier creates a new Verifier with the given proof verifier and chain
+// spec.
 func NewVerifier[
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BlobSidecarT Sidecar[BeaconBlockHeaderT],
 	BlobSidecarsT Sidecars[BlobSidecarT],
 ](
 	proofVerifier kzg.BlobProofVerifier,
+	chainSpec ChainSpec,
 	telemetrySink TelemetrySink,
 ) *Verifier[BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT] {
+	verified, err := lru.New[common.Root, struct{}](verifiedCacheSize)
+	if err != nil {
+		panic(err)
+	}
 	return &Verifier[BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT]{
 		proofVerifier: proofVerifier,
+		chainSpec:     chainSpec,
+		verified:      verified,
 		metrics:       newVerifierMetrics(telemetrySink),
 	}
 }
 
 // VerifySidecars verifies the blobs for both inclusion as well
-// as the KZG proofs.
-func (bv *Verifier[_, _, BlobSidecarsT]) VerifySidecars(
-	sidecars BlobSidecarsT, kzgOffset uint64,
+// as the KZG proofs. The proofs are not verified again if all the sidecars
+// were already verified.
+func (bv *Verifier[_, BlobSidecarT, BlobSidecarsT]) VerifySidecars(
+	sidecars BlobSidecarsT,
 ) error {
 	var (
 		g, _      = errgroup.WithContext(context.Background())
@@ -72,13 +96,21 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifySidecars(
 		bv.proofVerifier.GetImplementation(),
 	)
 
+	roots := iter.Map(
+		sidecars.GetSidecars(),
+		func(sidecar *BlobSidecarT) common.Root {
+			return (*sidecar).HashTreeRoot()
+		},
+	)
+	if bv.allVerified(roots) {
+		bv.metrics.markVerifiedCacheHit()
+		return sidecars.ValidateBlockRoots()
+	}
+	bv.metrics.markVerifiedCacheMiss()
+
 	// Verify the inclusion proofs on the blobs concurrently.
 	g.Go(func() error {
-		// TODO: KZGOffset needs to be configurable and not
-		// passed in.
-		return bv.VerifyInclusionProofs(
-			sidecars, kzgOffset,
-		)
+		return bv.VerifyInclusionProofs(sidecars)
 	})
 
 	// Verify the KZG proofs on the blobs concurrently.
@@ -90,19 +122,50 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifySidecars(
 		return sidecars.ValidateBlockRoots()
 	})
 
-	// Wait for all goroutines to finish and return the result.
-	return g.Wait()
+	// Wait for all goroutines to finish and remember the verified sidecars.
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	for _, root := range roots {
+		bv.verified.Add(root, struct{}{})
+	}
+	return nil
 }
 
+// allVerified returns whether the sidecars of the given roots were all
+// verified already.
+func (bv *Verifier[_, _, _]) allVerified(roots []common.Root) bool {
+	if len(roots) == 0 {
+		return false
+	}
+	for _, root := range roots {
+		if !bv.verified.Contains(root) {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyInclusionProofs verifies the inclusion proofs of the sidecars,
+// laid out as the block body of the fork active at their slot.
 func (bv *Verifier[_, _, BlobSidecarsT]) VerifyInclusionProofs(
 	scs BlobSidecarsT,
-	kzgOffset uint64,
 ) error {
 	startTime := time.Now()
 	defer bv.metrics.measureVerifyInclusionProofsDuration(
 		startTime, math.U64(scs.Len()),
 	)
-	return scs.VerifyInclusionProofs(kzgOffset)
+
+	if scs.Len() == 0 {
+		return nil
+	}
+	inclusion, err := kzgInclusionForSlot(
+		bv.chainSpec, scs.Get(0).GetBeaconBlockHeader().GetSlot(),
+	)
+	if err != nil {
+		return err
+	}
+	return scs.VerifyInclusionProofs(inclusion)
 }
 
 // VerifyKZGProofs verifies the sidecars.
@@ -132,3 +195,48 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifyKZGProofs(
 		return bv.proofVerifier.VerifyBlobProofBatch(kzg.ArgsFromSidecars(scs))
 	}
 }
+
+// VerifyDataColumnSidecars verifies the data column sidecars of a block, for
+// both the inclusion of their commitments and the KZG proofs of their cells.
+// The cells of all the columns are verified in a single batch.
+func (bv *Verifier[_, _, _]) VerifyDataColumnSidecars(
+	columns []*types.DataColumnSidecar,
+) error {
+	startTime := time.Now()
+	defer bv.metrics.measureVerifyDataColumnSidecarsDuration(
+		startTime, math.U64(len(columns)),
+		bv.proofVerifier.GetImplementation(),
+	)
+
+	if len(columns) == 0 {
+		return nil
+	}
+	if columns[0] == nil {
+		return types.ErrAttemptedToVerifyNilSidecar
+	}
+	inclusion, err := kzgInclusionForSlot(
+		bv.chainSpec, columns[0].GetBeaconBlockHeader().GetSlot(),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		if column == nil {
+			return types.ErrAttemptedToVerifyNilSidecar
+		}
+		if column.BeaconBlockHeader.HashTreeRoot() !=
+			columns[0].BeaconBlockHeader.HashTreeRoot() {
+			return ErrDataColumnsDifferingBlockRoots
+		}
+		if err := column.Validate(); err != nil {
+			return err
+		}
+		if !column.HasValidInclusionProof(inclusion) {
+			return types.ErrInvalidInclusionProof
+		}
+	}
+	return bv.proofVerifier.VerifyCellKZGProofBatch(
+		kzg.ArgsFromDataColumns(columns),
+	)
+}
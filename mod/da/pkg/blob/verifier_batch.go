@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/sourcegraph/conc/iter"
+)
+
+// VerifySidecarsBatch verifies the sidecars of several blocks, returning the
+// error of each block at its index. The inclusion proofs are verified per
+// block, while the KZG proofs of all the blocks are verified together in a
+// random linear combination batch, split across a pool of workers. If the
+// batch fails, the KZG proofs are verified again per block to find the
+// blocks at fault, so that a single bad block does not fail the others.
+func (bv *Verifier[_, BlobSidecarT, BlobSidecarsT]) VerifySidecarsBatch(
+	blocks []BlobSidecarsT,
+) []error {
+	var (
+		errs      = make([]error, len(blocks))
+		roots     = make([][]common.Root, len(blocks))
+		pending   = make([]int, 0, len(blocks))
+		mapper    = iter.Mapper[int, error]{MaxGoroutines: bv.workers()}
+		startTime = time.Now()
+	)
+
+	defer bv.metrics.measureVerifySidecarsBatchDuration(
+		startTime, math.U64(len(blocks)),
+		bv.proofVerifier.GetImplementation(),
+	)
+
+	// Skip the blocks without sidecars, along with the blocks whose sidecars
+	// were all verified already.
+	for i, sidecars := range blocks {
+		if sidecars.Len() == 0 {
+			continue
+		}
+		roots[i] = iter.Map(
+			sidecars.GetSidecars(),
+			func(sidecar *BlobSidecarT) common.Root {
+				return (*sidecar).HashTreeRoot()
+			},
+		)
+		if bv.allVerified(roots[i]) {
+			bv.metrics.markVerifiedCacheHit()
+			errs[i] = sidecars.ValidateBlockRoots()
+			continue
+		}
+		bv.metrics.markVerifiedCacheMiss()
+		pending = append(pending, i)
+	}
+
+	// Verify the inclusion proofs and block roots of each block, leaving
+	// out of the batch the blocks failing them.
+	checks := mapper.Map(pending, func(i *int) error {
+		if err := blocks[*i].ValidateBlockRoots(); err != nil {
+			return err
+		}
+		return bv.VerifyInclusionProofs(blocks[*i])
+	})
+	included := make([]int, 0, len(pending))
+	for k, i := range pending {
+		if errs[i] = checks[k]; errs[i] == nil {
+			included = append(included, i)
+		}
+	}
+
+	// Verify the KZG proofs of all the remaining blocks at once, and fall
+	// back to verifying them per block on failure.
+	if err := bv.verifyKZGProofsBatch(blocks, included); err != nil {
+		bv.metrics.markVerifySidecarsBatchFallback()
+		fallback := mapper.Map(included, func(i *int) error {
+			return bv.VerifyKZGProofs(blocks[*i])
+		})
+		for k, i := range included {
+			errs[i] = fallback[k]
+		}
+	}
+
+	// Remember the sidecars of the blocks that were verified.
+	for _, i := range included {
+		if errs[i] != nil {
+			continue
+		}
+		for _, root := range roots[i] {
+			bv.verified.Add(root, struct{}{})
+		}
+	}
+	return errs
+}
+
+// verifyKZGProofsBatch verifies the KZG proofs of the sidecars of the given
+// blocks, split in one batch per worker.
+func (bv *Verifier[_, _, BlobSidecarsT]) verifyKZGProofsBatch(
+	blocks []BlobSidecarsT,
+	indices []int,
+) error {
+	var (
+		blobs       []*eip4844.Blob
+		proofs      []eip4844.KZGProof
+		commitments []eip4844.KZGCommitment
+	)
+	for _, i := range indices {
+		args := kzg.ArgsFromSidecars(blocks[i])
+		blobs = append(blobs, args.Blobs...)
+		proofs = append(proofs, args.Proofs...)
+		commitments = append(commitments, args.Commitments...)
+	}
+	if len(blobs) == 0 {
+		return nil
+	}
+
+	// Split the blobs in even chunks, one per worker.
+	workers := min(bv.workers(), len(blobs))
+	size := (len(blobs) + workers - 1) / workers
+	chunks := make([]*kzgtypes.BlobProofArgs, 0, workers)
+	for start := 0; start < len(blobs); start += size {
+		end := min(start+size, len(blobs))
+		chunks = append(chunks, &kzgtypes.BlobProofArgs{
+			Blobs:       blobs[start:end],
+			Proofs:      proofs[start:end],
+			Commitments: commitments[start:end],
+		})
+	}
+	return errors.Join(iter.Map(
+		chunks,
+		func(args **kzgtypes.BlobProofArgs) error {
+			return bv.proofVerifier.VerifyBlobProofBatch(*args)
+		},
+	)...)
+}
+
+// workers returns the number of workers verifying a batch of sidecars.
+func (bv *Verifier[_, _, _]) workers() int {
+	return runtime.GOMAXPROCS(0)
+}
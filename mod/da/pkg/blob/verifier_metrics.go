@@ -57,6 +57,23 @@ func (vm *verifierMetrics) measureVerifySidecarsDuration(
 	)
 }
 
+// measureVerifySidecarsBatchDuration measures the duration of the blob
+// verification of a batch of blocks.
+func (vm *verifierMetrics) measureVerifySidecarsBatchDuration(
+	startTime time.Time,
+	numBlocks math.U64,
+	kzgImplementation string,
+) {
+	vm.sink.MeasureSince(
+		"beacon_kit.da.blob.verifier.verify_blobs_batch_duration",
+		startTime,
+		"num_blocks",
+		numBlocks.Base10(),
+		"kzg_implementation",
+		kzgImplementation,
+	)
+}
+
 // measureVerifyInclusionProofsDuration measures the duration of the inclusion
 // proofs verification.
 func (vm *verifierMetrics) measureVerifyInclusionProofsDuration(
@@ -87,3 +104,44 @@ func (vm *verifierMetrics) measureVerifyKZGProofsDuration(
 		kzgImplementation,
 	)
 }
+
+// measureVerifyDataColumnSidecarsDuration measures the duration of the data
+// column sidecars verification.
+func (vm *verifierMetrics) measureVerifyDataColumnSidecarsDuration(
+	startTime time.Time,
+	numColumns math.U64,
+	kzgImplementation string,
+) {
+	vm.sink.MeasureSince(
+		"beacon_kit.da.blob.verifier.verify_data_columns_duration",
+		startTime,
+		"num_columns",
+		numColumns.Base10(),
+		"kzg_implementation",
+		kzgImplementation,
+	)
+}
+
+// markVerifiedCacheHit increments the number of sidecar verifications skipped
+// since all the sidecars were already verified.
+func (vm *verifierMetrics) markVerifiedCacheHit() {
+	vm.sink.IncrementCounter(
+		"beacon_kit.da.blob.verifier.verified_cache_hit",
+	)
+}
+
+// markVerifiedCacheMiss increments the number of sidecar verifications not
+// found in the cache of verified sidecars.
+func (vm *verifierMetrics) markVerifiedCacheMiss() {
+	vm.sink.IncrementCounter(
+		"beacon_kit.da.blob.verifier.verified_cache_miss",
+	)
+}
+
+// markVerifySidecarsBatchFallback increments the number of batches of blocks
+// whose KZG proofs were verified again per block, after the batch failed.
+func (vm *verifierMetrics) markVerifySidecarsBatchFallback() {
+	vm.sink.IncrementCounter(
+		"beacon_kit.da.blob.verifier.verify_blobs_batch_fallback",
+	)
+}
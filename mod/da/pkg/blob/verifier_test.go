@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob_test
+
+import (
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/noop"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// countingVerifier is a no-op proof verifier counting the batches of blob
+// proofs it verifies.
+type countingVerifier struct {
+	noop.Verifier
+	batches int
+}
+
+func (v *countingVerifier) VerifyBlobProofBatch(*kzgtypes.BlobProofArgs) error {
+	v.batches++
+	return nil
+}
+
+// buildSidecars builds the sidecars of a block at the given slot carrying
+// the given number of blobs.
+func buildSidecars(
+	t *testing.T, spec *MockSpec, slot uint64, numBlobs int,
+) *types.BlobSidecars {
+	t.Helper()
+	body := (&ctypes.BeaconBlockBody{}).Empty(version.Deneb)
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{}
+	for i := range numBlobs {
+		commitment := eip4844.KZGCommitment{byte(i + 1)}
+		body.BlobKzgCommitments = append(body.BlobKzgCommitments, commitment)
+		bundle.Commitments = append(bundle.Commitments, commitment)
+		bundle.Proofs = append(bundle.Proofs, eip4844.KZGProof{})
+		bundle.Blobs = append(bundle.Blobs, &eip4844.Blob{})
+	}
+	blk := &ctypes.BeaconBlock{Slot: math.Slot(slot), Body: body}
+	sidecars, err := blob.NewSidecarFactory[
+		*ctypes.BeaconBlock,
+		*ctypes.BeaconBlockBody,
+		*ctypes.BeaconBlockHeader,
+	](spec, noop.NewVerifier(), noopSink{}).
+		BuildSidecars(blk, bundle)
+	require.NoError(t, err)
+	return sidecars
+}
+
+var errBadProof = errors.New("bad proof")
+
+// rejectingVerifier is a no-op proof verifier rejecting the batches of blob
+// proofs holding the bad proof, and counting the batches it verifies.
+type rejectingVerifier struct {
+	noop.Verifier
+	bad     eip4844.KZGProof
+	batches atomic.Int32
+}
+
+func (v *rejectingVerifier) VerifyBlobProof(
+	_ *eip4844.Blob, proof eip4844.KZGProof, _ eip4844.KZGCommitment,
+) error {
+	if proof == v.bad {
+		return errBadProof
+	}
+	return nil
+}
+
+func (v *rejectingVerifier) VerifyBlobProofBatch(
+	args *kzgtypes.BlobProofArgs,
+) error {
+	v.batches.Add(1)
+	if slices.Contains(args.Proofs, v.bad) {
+		return errBadProof
+	}
+	return nil
+}
+
+func TestVerifySidecars_Cache(t *testing.T) {
+	spec := &MockSpec{fork: version.Deneb}
+	sidecars := buildSidecars(t, spec, 7, 2)
+
+	proofs := &countingVerifier{}
+	verifier := blob.NewVerifier[
+		*ctypes.BeaconBlockHeader, *types.BlobSidecar, *types.BlobSidecars,
+	](proofs, spec, noopSink{})
+
+	// The sidecars are verified once across the rounds.
+	for range 3 {
+		require.NoError(t, verifier.VerifySidecars(sidecars))
+	}
+	require.Equal(t, 1, proofs.batches)
+
+	// A sidecar never verified triggers the verification of the batch.
+	sidecars.Sidecars[1].KzgProof = eip4844.KZGProof{1}
+	require.NoError(t, verifier.VerifySidecars(sidecars))
+	require.Equal(t, 2, proofs.batches)
+
+	// A sidecar failing its inclusion proof is not cached.
+	sidecars.Sidecars[0].KzgCommitment = eip4844.KZGCommitment{9}
+	for range 2 {
+		require.ErrorIs(
+			t, verifier.VerifySidecars(sidecars),
+			types.ErrInvalidInclusionProof,
+		)
+	}
+
+	// Sidecars of a fork without a known block body layout are rejected.
+	verifier = blob.NewVerifier[
+		*ctypes.BeaconBlockHeader, *types.BlobSidecar, *types.BlobSidecars,
+	](proofs, &MockSpec{fork: version.Capella}, noopSink{})
+	sidecars.Sidecars[0].KzgCommitment = eip4844.KZGCommitment{1}
+	require.ErrorIs(
+		t, verifier.VerifySidecars(sidecars),
+		ctypes.ErrForkVersionNotSupported,
+	)
+}
+
+func TestVerifySidecarsBatch(t *testing.T) {
+	spec := &MockSpec{fork: version.Deneb}
+	blocks := []*types.BlobSidecars{
+		buildSidecars(t, spec, 1, 2),
+		buildSidecars(t, spec, 2, 0),
+		buildSidecars(t, spec, 3, 1),
+		buildSidecars(t, spec, 4, 3),
+	}
+	proofs := &rejectingVerifier{bad: eip4844.KZGProof{0xff}}
+	verifier := blob.NewVerifier[
+		*ctypes.BeaconBlockHeader, *types.BlobSidecar, *types.BlobSidecars,
+	](proofs, spec, noopSink{})
+
+	// The blocks are verified together, and not again once cached.
+	for _, err := range verifier.VerifySidecarsBatch(blocks) {
+		require.NoError(t, err)
+	}
+	batches := proofs.batches.Load()
+	require.Positive(t, batches)
+	for _, err := range verifier.VerifySidecarsBatch(blocks) {
+		require.NoError(t, err)
+	}
+	require.Equal(t, batches, proofs.batches.Load())
+
+	// A bad KZG proof fails its block only, and a bad inclusion proof leaves
+	// its block out of the batch.
+	blocks[0].Sidecars[1].KzgProof = proofs.bad
+	blocks[2].Sidecars[0].KzgCommitment = eip4844.KZGCommitment{9}
+	blocks[3].Sidecars[2].KzgProof = eip4844.KZGProof{1}
+	errs := verifier.VerifySidecarsBatch(blocks)
+	require.ErrorIs(t, errs[0], errBadProof)
+	require.NoError(t, errs[1])
+	require.ErrorIs(t, errs[2], types.ErrInvalidInclusionProof)
+	require.NoError(t, errs[3])
+	require.Greater(t, proofs.batches.Load(), batches)
+
+	// The verified block is cached, while the failed ones are not.
+	require.NoError(t, verifier.VerifySidecars(blocks[3]))
+	require.ErrorIs(
+		t, verifier.VerifySidecars(blocks[0]), errBadProof,
+	)
+}
@@ -22,12 +22,17 @@ package da
 
 import (
 	"context"
+	"sync"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 )
 
+// maxBatchBlocks is the maximum number of queued blocks whose sidecars are
+// verified together.
+const maxBatchBlocks = 32
+
 // The Data Availability service is responsible for verifying and processing
 // incoming blob sidecars.
 //
@@ -47,6 +52,13 @@ type Service[
 	subSidecarsReceived chan async.Event[BlobSidecarsT]
 	// subFinalBlobSidecars is a channel holding FinalSidecarsReceived events.
 	subFinalBlobSidecars chan async.Event[BlobSidecarsT]
+	// finalMu protects finalQueue.
+	finalMu sync.Mutex
+	// finalQueue holds the FinalSidecarsReceived events not yet handled, so
+	// that the dispatcher never waits on, nor drops them for, the event loop.
+	finalQueue []async.Event[BlobSidecarsT]
+	// finalQueued is signalled when events are added to finalQueue.
+	finalQueued chan struct{}
 }
 
 // NewService returns a new DA service.
@@ -72,6 +84,7 @@ func NewService[
 		logger:               logger,
 		subSidecarsReceived:  make(chan async.Event[BlobSidecarsT]),
 		subFinalBlobSidecars: make(chan async.Event[BlobSidecarsT]),
+		finalQueued:          make(chan struct{}, 1),
 	}
 }
 
@@ -99,11 +112,32 @@ func (s *Service[_, _]) Start(ctx context.Context) error {
 		return err
 	}
 
-	// start the main event loop to listen and handle events.
+	// queue the FinalSidecarsReceived events and start the main event loop
+	// to listen and handle events.
+	go s.queueFinalSidecars(ctx)
 	go s.eventLoop(ctx)
 	return nil
 }
 
+// queueFinalSidecars moves the FinalSidecarsReceived events into the queue
+// of the event loop as soon as they are received.
+func (s *Service[_, _]) queueFinalSidecars(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.subFinalBlobSidecars:
+			s.finalMu.Lock()
+			s.finalQueue = append(s.finalQueue, event)
+			s.finalMu.Unlock()
+			select {
+			case s.finalQueued <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
 // eventLoop listens and handles SidecarsReceived and FinalSidecarsReceived
 // events.
 func (s *Service[_, _]) eventLoop(ctx context.Context) {
@@ -113,8 +147,8 @@ func (s *Service[_, _]) eventLoop(ctx context.Context) {
 			return
 		case event := <-s.subSidecarsReceived:
 			s.handleSidecarsReceived(event)
-		case event := <-s.subFinalBlobSidecars:
-			s.handleFinalSidecarsReceived(event)
+		case <-s.finalQueued:
+			s.handleFinalSidecarsReceived(s.dequeueFinalSidecars())
 		}
 	}
 }
@@ -123,18 +157,34 @@ func (s *Service[_, _]) eventLoop(ctx context.Context) {
 /*                               Event Handlers                             */
 /* -------------------------------------------------------------------------- */
 
-// handleFinalSidecarsReceived handles the BlobSidecarsProcessRequest
-// event.
-// It processes the sidecars and publishes a BlobSidecarsProcessed event.
+// handleFinalSidecarsReceived handles the FinalSidecarsReceived events of
+// one or more blocks, in the order they were received.
+// It verifies the sidecars of all the blocks together and processes the
+// sidecars of each block verified.
 func (s *Service[_, BlobSidecarsT]) handleFinalSidecarsReceived(
-	msg async.Event[BlobSidecarsT],
+	msgs []async.Event[BlobSidecarsT],
 ) {
-	if err := s.processSidecars(msg.Context(), msg.Data()); err != nil {
-		s.logger.Error(
-			"Failed to process blob sidecars",
-			"error",
-			err,
-		)
+	if len(msgs) == 0 {
+		return
+	}
+	blocks := make([]BlobSidecarsT, len(msgs))
+	for i, msg := range msgs {
+		blocks[i] = msg.Data()
+	}
+
+	errs := s.bp.VerifySidecarsBatch(blocks)
+	for i, sidecars := range blocks {
+		err := errs[i]
+		if err == nil {
+			err = s.bp.ProcessSidecars(s.avs, sidecars)
+		}
+		if err != nil {
+			s.logger.Error(
+				"Failed to process blob sidecars",
+				"error",
+				err,
+			)
+		}
 	}
 }
 
@@ -167,17 +217,26 @@ func (s *Service[_, BlobSidecarsT]) handleSidecarsReceived(
 /*                                   helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// ProcessSidecars processes the blob sidecars.
-func (s *Service[_, BlobSidecarsT]) processSidecars(
-	_ context.Context,
-	sidecars BlobSidecarsT,
-) error {
-	// startTime := time.Now()
-	// defer s.metrics.measureBlobProcessingDuration(startTime)
-	return s.bp.ProcessSidecars(
-		s.avs,
-		sidecars,
-	)
+// dequeueFinalSidecars returns the queued FinalSidecarsReceived events, up
+// to maxBatchBlocks events, so that the sidecars of the blocks queued during
+// sync are verified together. The sidecars verified during ProcessProposal
+// are found in the cache of the verifier and are not verified again. The
+// event loop is signalled again if events remain queued.
+func (s *Service[
+	_, BlobSidecarsT,
+]) dequeueFinalSidecars() []async.Event[BlobSidecarsT] {
+	s.finalMu.Lock()
+	defer s.finalMu.Unlock()
+	n := min(len(s.finalQueue), maxBatchBlocks)
+	events := s.finalQueue[:n:n]
+	s.finalQueue = s.finalQueue[n:]
+	if len(s.finalQueue) > 0 {
+		select {
+		case s.finalQueued <- struct{}{}:
+		default:
+		}
+	}
+	return events
 }
 
 // VerifyIncomingBlobs receives blobs from the network and processes them.
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/da"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/stretchr/testify/require"
+)
+
+// brokerTimeout is the time the broker waits for a subscriber to take an
+// event before dropping it.
+const brokerTimeout = time.Second
+
+type sidecars struct {
+	block int
+}
+
+func (s *sidecars) Len() int { return 1 }
+
+func (s *sidecars) IsNil() bool { return s == nil }
+
+type dispatcher struct {
+	subs map[async.EventID]any
+}
+
+func (d *dispatcher) Publish(async.BaseEvent) error { return nil }
+
+func (d *dispatcher) Subscribe(eventID async.EventID, ch any) error {
+	d.subs[eventID] = ch
+	return nil
+}
+
+func (d *dispatcher) Unsubscribe(async.EventID, any) error { return nil }
+
+// blobProcessor records the batches of blocks verified, and blocks the
+// verification of the first batch until released.
+type blobProcessor struct {
+	release chan struct{}
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (p *blobProcessor) ProcessSidecars(struct{}, *sidecars) error {
+	return nil
+}
+
+func (p *blobProcessor) VerifySidecars(*sidecars) error { return nil }
+
+func (p *blobProcessor) VerifySidecarsBatch(blocks []*sidecars) []error {
+	<-p.release
+	batch := make([]int, len(blocks))
+	for i, block := range blocks {
+		batch[i] = block.block
+	}
+	p.mu.Lock()
+	p.batches = append(p.batches, batch)
+	p.mu.Unlock()
+	return make([]error, len(blocks))
+}
+
+func (p *blobProcessor) processed() []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var blocks []int
+	for _, batch := range p.batches {
+		blocks = append(blocks, batch...)
+	}
+	return blocks
+}
+
+func TestServiceBatchesFinalSidecarsWithoutDroppingThem(t *testing.T) {
+	const blocks = 40
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := &dispatcher{subs: make(map[async.EventID]any)}
+	bp := &blobProcessor{release: make(chan struct{})}
+	svc := da.NewService[struct{}, *sidecars](
+		struct{}{}, bp, d, noop.NewLogger[any](),
+	)
+	require.NoError(t, svc.Start(ctx))
+	sub, ok := d.subs[async.FinalSidecarsReceived].(chan async.Event[*sidecars])
+	require.True(t, ok)
+
+	// The events of the blocks finalized while the sidecars of the first
+	// block are verified are taken within the timeout of the broker.
+	for block := range blocks {
+		select {
+		case sub <- async.NewEvent(
+			ctx, async.FinalSidecarsReceived, &sidecars{block: block},
+		):
+		case <-time.After(brokerTimeout):
+			t.Fatalf("event of block %d dropped", block)
+		}
+	}
+	close(bp.release)
+
+	expected := make([]int, blocks)
+	for block := range blocks {
+		expected[block] = block
+	}
+	require.Eventually(t, func() bool {
+		return len(bp.processed()) == blocks
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, expected, bp.processed())
+
+	// The blocks queued behind the first one are verified together, by
+	// batches of up to 32 blocks.
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+	require.Len(t, bp.batches[1], 32)
+	for _, batch := range bp.batches {
+		require.LessOrEqual(t, len(batch), 32)
+	}
+}
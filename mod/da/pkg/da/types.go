@@ -32,6 +32,9 @@ type BlobProcessor[AvailabilityStoreT any, BlobSidecarsT any] interface {
 	VerifySidecars(
 		sidecars BlobSidecarsT,
 	) error
+	// VerifySidecarsBatch verifies the blobs of several blocks, returning the
+	// error of each block at its index.
+	VerifySidecarsBatch(blocks []BlobSidecarsT) []error
 }
 
 // BlobSidecar is the interface for the blob sidecar.
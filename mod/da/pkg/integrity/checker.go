@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Checker checks that the blob sidecars of the availability store still
+// match the commitments of their slots: every commitment must have a stored
+// sidecar holding it, included by its proof in the body of the block of the
+// slot, and whose blob matches it by its KZG proof. The block bodies are not
+// stored, so the commitments are checked against the body root of the block
+// header of the sidecars, which must be the same for all the sidecars of a
+// slot.
+type Checker struct {
+	// store is the availability store checked.
+	store AvailabilityStore
+	// blocks is the block store the sidecars fetched again are checked
+	// against.
+	blocks BlockStore
+	// verifier verifies the inclusion and KZG proofs of the sidecars.
+	verifier Verifier
+	// chainSpec defines the data availability window.
+	chainSpec ChainSpec
+	// client requests the blob sidecars from peers.
+	client *http.Client
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// metrics collects and reports the results of the checks.
+	metrics *checkerMetrics
+}
+
+// New creates a new Checker of the given availability store.
+func New(
+	store AvailabilityStore,
+	blocks BlockStore,
+	verifier Verifier,
+	chainSpec ChainSpec,
+	telemetrySink TelemetrySink,
+	logger log.Logger,
+) *Checker {
+	return &Checker{
+		store:     store,
+		blocks:    blocks,
+		verifier:  verifier,
+		chainSpec: chainSpec,
+		client:    &http.Client{},
+		logger:    logger,
+		metrics:   newCheckerMetrics(telemetrySink),
+	}
+}
+
+// Window returns the first and last slots of the data availability window
+// ending at the given slot, matching the slots kept by the pruner.
+func (c *Checker) Window(head math.Slot) (math.Slot, math.Slot) {
+	window := math.Slot(
+		c.chainSpec.MinEpochsForBlobsSidecarsRequest() *
+			c.chainSpec.SlotsPerEpoch(),
+	)
+	if head < window {
+		return 0, head
+	}
+	return head - window, head
+}
+
+// CheckBlobs checks the blob sidecars of the slots of the data availability
+// window ending at the given slot. If peer is set, the blob sidecars of the
+// slots with issues are fetched again from the blob API of the node at that
+// URL, verified and stored. The block header of the fetched sidecars must be
+// the one of the block of the slot in the block store, so only the slots of
+// the blocks it holds can be fetched again.
+func (c *Checker) CheckBlobs(
+	ctx context.Context,
+	head math.Slot,
+	peer string,
+) (*Report, error) {
+	startTime := time.Now()
+	defer c.metrics.measureCheckDuration(startTime)
+
+	from, to := c.Window(head)
+	report := &Report{FromSlot: from, ToSlot: to, Issues: []Issue{}}
+	for slot := from; slot <= to; slot++ {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		commitments, intact, issues := c.checkSlot(slot)
+		if len(commitments) == 0 && len(issues) == 0 {
+			continue
+		}
+		report.Slots++
+		report.Sidecars += len(commitments)
+		if len(issues) == 0 {
+			continue
+		}
+
+		if peer != "" {
+			if err := c.refetch(
+				ctx, peer, slot, commitments, intact,
+			); err != nil {
+				c.logger.Warn(
+					"Failed to fetch blob sidecars again",
+					"slot", slot.Base10(), "peer", peer, "error", err,
+				)
+			} else {
+				c.metrics.markRefetched()
+				for i := range issues {
+					issues[i].Refetched = true
+				}
+			}
+		}
+		report.Issues = append(report.Issues, issues...)
+	}
+
+	c.metrics.setReport(report)
+	return report, nil
+}
+
+// checkSlot checks the blob sidecars of a slot, returning the commitments of
+// the slot, the sidecars found intact and the issues of the others.
+func (c *Checker) checkSlot(
+	slot math.Slot,
+) ([][]byte, []*types.BlobSidecar, []Issue) {
+	commitments, found, err := c.store.SlotCommitments(slot)
+	if err != nil {
+		return nil, nil, []Issue{{
+			Slot:   slot,
+			Kind:   IssueCorrupt,
+			Reason: err.Error(),
+		}}
+	}
+	if !found {
+		return nil, nil, nil
+	}
+
+	var (
+		issues    []Issue
+		sidecars  = make([]*types.BlobSidecar, 0, len(commitments))
+		stored    = make([][]byte, 0, len(commitments))
+		issueWith = func(commitment []byte, kind IssueKind, err error) {
+			issues = append(issues, Issue{
+				Slot:       slot,
+				Commitment: hex.EncodeBytes(commitment),
+				Kind:       kind,
+				Reason:     err.Error(),
+			})
+		}
+	)
+	for _, commitment := range commitments {
+		sidecar, getErr := c.store.GetSidecar(slot, commitment)
+		switch {
+		case errors.Is(getErr, store.ErrSidecarNotFound):
+			issueWith(commitment, IssueMissing, getErr)
+		case getErr != nil:
+			issueWith(commitment, IssueCorrupt, getErr)
+		case !bytes.Equal(sidecar.KzgCommitment[:], commitment):
+			issueWith(commitment, IssueCorrupt, ErrCommitmentMismatch)
+		case sidecar.BeaconBlockHeader == nil ||
+			sidecar.BeaconBlockHeader.GetSlot() != slot:
+			issueWith(commitment, IssueCorrupt, ErrSlotMismatch)
+		default:
+			sidecars = append(sidecars, sidecar)
+			stored = append(stored, commitment)
+		}
+	}
+
+	// Verify the proofs of the sidecars of the slot at once, and one by one
+	// on failure to find the corrupt ones.
+	errs := c.verify(sidecars)
+	intact := make([]*types.BlobSidecar, 0, len(sidecars))
+	for i, sidecar := range sidecars {
+		if errs[i] == nil && len(intact) > 0 &&
+			sidecar.BeaconBlockHeader.HashTreeRoot() !=
+				intact[0].BeaconBlockHeader.HashTreeRoot() {
+			errs[i] = ErrBlockRootMismatch
+		}
+		if errs[i] != nil {
+			issueWith(stored[i], IssueCorrupt, errs[i])
+			continue
+		}
+		intact = append(intact, sidecar)
+	}
+	return commitments, intact, issues
+}
+
+// verify verifies the inclusion and KZG proofs of the given sidecars of a
+// slot, returning the error of each sidecar at its index.
+func (c *Checker) verify(sidecars []*types.BlobSidecar) []error {
+	errs := make([]error, len(sidecars))
+	if len(sidecars) == 0 || c.verifyProofs(
+		&types.BlobSidecars{Sidecars: sidecars},
+	) == nil {
+		return errs
+	}
+	for i, sidecar := range sidecars {
+		errs[i] = c.verifyProofs(
+			&types.BlobSidecars{Sidecars: []*types.BlobSidecar{sidecar}},
+		)
+	}
+	return errs
+}
+
+// verifyProofs verifies the inclusion and KZG proofs of the sidecars.
+func (c *Checker) verifyProofs(sidecars *types.BlobSidecars) error {
+	if err := c.verifier.VerifyInclusionProofs(sidecars); err != nil {
+		return err
+	}
+	return c.verifier.VerifyKZGProofs(sidecars)
+}
+
+// refetch fetches the blob sidecars of the given commitments of a slot from
+// the blob API of a peer, and stores them once verified. The sidecars must
+// belong to the block of the slot in the block store, and to the block of the
+// sidecars of the slot found intact, if any.
+func (c *Checker) refetch(
+	ctx context.Context,
+	peer string,
+	slot math.Slot,
+	commitments [][]byte,
+	intact []*types.BlobSidecar,
+) error {
+	fetched, err := fetchSidecars(ctx, c.client, peer, slot)
+	if err != nil {
+		return err
+	}
+
+	// Keep the fetched sidecars of the commitments of the slot, in order.
+	byCommitment := make(map[string]*types.BlobSidecar, len(fetched))
+	for _, sidecar := range fetched {
+		byCommitment[string(sidecar.KzgCommitment[:])] = sidecar
+	}
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(commitments)),
+	}
+	for _, commitment := range commitments {
+		sidecar, ok := byCommitment[string(commitment)]
+		if !ok {
+			return errors.Wrapf(
+				ErrIncompleteSidecars,
+				"missing commitment %s", hex.EncodeBytes(commitment),
+			)
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+
+	if err = sidecars.ValidateBlockRoots(); err != nil {
+		return err
+	}
+	header := sidecars.Sidecars[0].BeaconBlockHeader
+	if header.GetSlot() != slot {
+		return ErrSlotMismatch
+	}
+	root := header.HashTreeRoot()
+	if len(intact) > 0 &&
+		root != intact[0].BeaconBlockHeader.HashTreeRoot() {
+		return ErrBlockRootMismatch
+	}
+	blockSlot, err := c.blocks.GetSlotByBlockRoot(root)
+	if err != nil {
+		return errors.Wrapf(ErrUnknownBlock, "%s: %v", root, err)
+	}
+	if blockSlot != slot {
+		return errors.Wrapf(
+			ErrUnknownBlock, "%s is at slot %d", root, blockSlot,
+		)
+	}
+	if err = c.verifyProofs(sidecars); err != nil {
+		return err
+	}
+	return c.store.Persist(slot, sidecars)
+}
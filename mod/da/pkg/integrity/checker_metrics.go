@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity
+
+import (
+	"time"
+)
+
+// checkerMetrics is a struct that contains metrics for the checker.
+type checkerMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newCheckerMetrics creates a new checkerMetrics.
+func newCheckerMetrics(sink TelemetrySink) *checkerMetrics {
+	return &checkerMetrics{
+		sink: sink,
+	}
+}
+
+// measureCheckDuration measures the duration of a check of the availability
+// store.
+func (cm *checkerMetrics) measureCheckDuration(startTime time.Time) {
+	cm.sink.MeasureSince(
+		"beacon_kit.da.integrity.check_duration", startTime,
+	)
+}
+
+// markRefetched increments the number of slots whose blob sidecars were
+// fetched again from a peer.
+func (cm *checkerMetrics) markRefetched() {
+	cm.sink.IncrementCounter("beacon_kit.da.integrity.refetched_slots")
+}
+
+// setReport sets the gauges of the sidecars checked and of the issues of
+// each kind left unresolved by the last check.
+func (cm *checkerMetrics) setReport(report *Report) {
+	cm.sink.SetGauge(
+		"beacon_kit.da.integrity.checked_sidecars", int64(report.Sidecars),
+	)
+	for _, kind := range []IssueKind{IssueMissing, IssueCorrupt} {
+		_, unresolved := report.Count(kind)
+		cm.sink.SetGauge(
+			"beacon_kit.da.integrity.unresolved_sidecars",
+			int64(unresolved),
+			"kind", string(kind),
+		)
+	}
+}
@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/integrity"
+	kzgnoop "github.com/berachain/beacon-kit/mod/da/pkg/kzg/noop"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSpec is the chain spec of the tests, with Deneb active at every slot
+// and a data availability window of 4 slots.
+type mockSpec struct{}
+
+func (mockSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return version.Deneb
+}
+
+func (mockSpec) MinEpochsForBlobsSidecarsRequest() uint64 {
+	return 4
+}
+
+func (mockSpec) SlotsPerEpoch() uint64 {
+	return 1
+}
+
+// noopSink is a TelemetrySink discarding the metrics.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string)        {}
+func (noopSink) SetGauge(string, int64, ...string)         {}
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// memStore is an in-memory availability store, holding the commitments of
+// each slot and the encoded sidecars of each commitment.
+type memStore struct {
+	commitments map[math.Slot][][]byte
+	sidecars    map[math.Slot]map[string][]byte
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		commitments: make(map[math.Slot][][]byte),
+		sidecars:    make(map[math.Slot]map[string][]byte),
+	}
+}
+
+func (s *memStore) SlotCommitments(slot math.Slot) ([][]byte, bool, error) {
+	commitments, ok := s.commitments[slot]
+	return commitments, ok, nil
+}
+
+func (s *memStore) GetSidecar(
+	slot math.Slot,
+	commitment []byte,
+) (*types.BlobSidecar, error) {
+	bz, ok := s.sidecars[slot][string(commitment)]
+	if !ok {
+		return nil, store.ErrSidecarNotFound
+	}
+	sidecar := new(types.BlobSidecar)
+	return sidecar, sidecar.UnmarshalSSZ(bz)
+}
+
+func (s *memStore) Persist(slot math.Slot, sidecars *types.BlobSidecars) error {
+	if s.sidecars[slot] == nil {
+		s.sidecars[slot] = make(map[string][]byte)
+	}
+	commitments := make([][]byte, 0, sidecars.Len())
+	for _, sidecar := range sidecars.Sidecars {
+		bz, err := sidecar.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		commitments = append(commitments, sidecar.KzgCommitment[:])
+		s.sidecars[slot][string(sidecar.KzgCommitment[:])] = bz
+	}
+	s.commitments[slot] = commitments
+	return nil
+}
+
+// memBlocks is an in-memory block store.
+type memBlocks map[common.Root]math.Slot
+
+func (b memBlocks) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
+	if slot, ok := b[root]; ok {
+		return slot, nil
+	}
+	return 0, errors.New("block not found")
+}
+
+// buildSidecars builds the blob sidecars of a block of the given slot and
+// proposer.
+func buildSidecars(
+	t *testing.T,
+	slot math.Slot,
+	proposer math.ValidatorIndex,
+	n int,
+) *types.BlobSidecars {
+	t.Helper()
+	body := (&ctypes.BeaconBlockBody{}).Empty(version.Deneb)
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{}
+	for i := range n {
+		commitment := eip4844.KZGCommitment{byte(slot), byte(i + 1)}
+		body.BlobKzgCommitments = append(body.BlobKzgCommitments, commitment)
+		bundle.Commitments = append(bundle.Commitments, commitment)
+		bundle.Proofs = append(bundle.Proofs, eip4844.KZGProof{})
+		bundle.Blobs = append(bundle.Blobs, &eip4844.Blob{})
+	}
+	sidecars, err := blob.NewSidecarFactory[
+		*ctypes.BeaconBlock,
+		*ctypes.BeaconBlockBody,
+		*ctypes.BeaconBlockHeader,
+	](mockSpec{}, kzgnoop.NewVerifier(), noopSink{}).BuildSidecars(
+		&ctypes.BeaconBlock{Slot: slot, ProposerIndex: proposer, Body: body},
+		bundle,
+	)
+	require.NoError(t, err)
+	return sidecars
+}
+
+// servePeer serves the given blob sidecars of each slot as the blob API of
+// a peer.
+func servePeer(
+	t *testing.T,
+	blocks map[math.Slot]*types.BlobSidecars,
+) *httptest.Server {
+	t.Helper()
+	type sidecarJSON struct {
+		Index             uint64                `json:"index,string"`
+		Blob              *eip4844.Blob         `json:"blob"`
+		KZGCommitment     eip4844.KZGCommitment `json:"kzg_commitment"`
+		KZGProof          eip4844.KZGProof      `json:"kzg_proof"`
+		SignedBlockHeader struct {
+			Message *ctypes.BeaconBlockHeader `json:"message"`
+		} `json:"signed_block_header"`
+		InclusionProof []common.Root `json:"kzg_commitment_inclusion_proof"`
+	}
+
+	mux := http.NewServeMux()
+	for slot, sidecars := range blocks {
+		data := make([]sidecarJSON, 0, sidecars.Len())
+		for _, sidecar := range sidecars.Sidecars {
+			s := sidecarJSON{
+				Index:          sidecar.Index,
+				Blob:           &sidecar.Blob,
+				KZGCommitment:  sidecar.KzgCommitment,
+				KZGProof:       sidecar.KzgProof,
+				InclusionProof: sidecar.InclusionProof,
+			}
+			s.SignedBlockHeader.Message = sidecar.BeaconBlockHeader
+			data = append(data, s)
+		}
+		mux.HandleFunc(
+			"/eth/v1/beacon/blob_sidecars/"+slot.Base10(),
+			func(w http.ResponseWriter, _ *http.Request) {
+				require.NoError(t, json.NewEncoder(w).Encode(
+					map[string]any{"data": data},
+				))
+			},
+		)
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCheckBlobs(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		s       = newMemStore()
+		known   = memBlocks{}
+		blocks  = map[math.Slot]*types.BlobSidecars{}
+		checker = integrity.New(
+			s,
+			known,
+			blob.NewVerifier[
+				*ctypes.BeaconBlockHeader,
+				*types.BlobSidecar,
+				*types.BlobSidecars,
+			](kzgnoop.NewVerifier(), mockSpec{}, noopSink{}),
+			mockSpec{},
+			noopSink{},
+			noop.NewLogger[any](),
+		)
+	)
+	for slot, n := range map[math.Slot]int{1: 1, 3: 2, 5: 2} {
+		blocks[slot] = buildSidecars(t, slot, 0, n)
+		require.NoError(t, s.Persist(slot, blocks[slot]))
+	}
+
+	// The window ending at slot 6 holds the sidecars of slots 3 and 5.
+	report, err := checker.CheckBlobs(ctx, 6, "")
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), report.FromSlot)
+	require.Equal(t, math.Slot(6), report.ToSlot)
+	require.Equal(t, 2, report.Slots)
+	require.Equal(t, 4, report.Sidecars)
+	require.Empty(t, report.Issues)
+
+	// Sidecars removed, failing their proofs or stored under another
+	// commitment are reported.
+	c3, c5 := s.commitments[3], s.commitments[5]
+	delete(s.sidecars[3], string(c3[0]))
+	corrupt := *blocks[3].Sidecars[1]
+	corrupt.InclusionProof = append(
+		[]common.Root{{1}}, corrupt.InclusionProof[1:]...,
+	)
+	bz, err := corrupt.MarshalSSZ()
+	require.NoError(t, err)
+	s.sidecars[3][string(c3[1])] = bz
+	s.sidecars[5][string(c5[0])] = s.sidecars[5][string(c5[1])]
+
+	report, err = checker.CheckBlobs(ctx, 6, "")
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 3)
+	total, unresolved := report.Count(integrity.IssueMissing)
+	require.Equal(t, 1, total)
+	require.Equal(t, 1, unresolved)
+	total, unresolved = report.Count(integrity.IssueCorrupt)
+	require.Equal(t, 2, total)
+	require.Equal(t, 2, unresolved)
+
+	// Sidecars of another block than the intact ones are rejected, and so
+	// are the blocks a peer does not serve.
+	other := servePeer(t, map[math.Slot]*types.BlobSidecars{
+		5: buildSidecars(t, 5, 1, 2),
+	})
+	report, err = checker.CheckBlobs(ctx, 6, other.URL)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.Unresolved())
+
+	// Sidecars of blocks unknown to the block store are rejected.
+	peer := servePeer(t, blocks).URL
+	report, err = checker.CheckBlobs(ctx, 6, peer)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.Unresolved())
+
+	// Sidecars fetched again from a peer serving the blocks are stored.
+	for slot, sidecars := range blocks {
+		known[sidecars.Sidecars[0].BeaconBlockHeader.HashTreeRoot()] = slot
+	}
+	report, err = checker.CheckBlobs(ctx, 6, peer)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 3)
+	require.Zero(t, report.Unresolved())
+
+	report, err = checker.CheckBlobs(ctx, 6, "")
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity
+
+import "time"
+
+// defaultInterval is the default interval between two checks of the
+// availability store.
+const defaultInterval = time.Hour
+
+// Config is the configuration for the background integrity checks of the
+// availability store.
+type Config struct {
+	// Enabled determines if the availability store is checked in the
+	// background.
+	Enabled bool `mapstructure:"enabled"`
+	// Interval is the interval between two checks of the data availability
+	// window.
+	Interval time.Duration `mapstructure:"interval"`
+	// PeerURL is the URL of the node API of a peer the missing or corrupt
+	// blob sidecars are fetched again from, if set. Only the sidecars of the
+	// blocks of the block store are fetched again.
+	PeerURL string `mapstructure:"peer-url"`
+}
+
+// DefaultConfig returns the default configuration for the integrity checks
+// of the availability store.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:  false,
+		Interval: defaultInterval,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.Enabled && c.Interval <= 0 {
+		return ErrZeroInterval
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrIssuesFound is returned when the check of the availability store
+	// found missing or corrupt blob sidecars that were not fetched again.
+	ErrIssuesFound = errors.New("missing or corrupt blob sidecars found")
+	// ErrPeerRequest is returned when the blob API of a peer fails to serve
+	// the blob sidecars of a slot.
+	ErrPeerRequest = errors.New("peer request failed")
+	// ErrIncompleteSidecars is returned when the blob sidecars served by a
+	// peer miss some of the commitments of a slot.
+	ErrIncompleteSidecars = errors.New("incomplete blob sidecars")
+	// ErrBlockRootMismatch is returned when the blob sidecars of a slot
+	// belong to a different block than the other sidecars of the slot.
+	ErrBlockRootMismatch = errors.New("block root mismatch")
+	// ErrUnknownBlock is returned when the blob sidecars served by a peer
+	// belong to a block unknown to the block store.
+	ErrUnknownBlock = errors.New(
+		"blob sidecars of a block unknown to the block store",
+	)
+	// ErrCommitmentMismatch is returned when a stored blob sidecar does not
+	// hold the commitment it is stored under.
+	ErrCommitmentMismatch = errors.New("commitment mismatch")
+	// ErrSlotMismatch is returned when a stored blob sidecar belongs to a
+	// block of another slot.
+	ErrSlotMismatch = errors.New("slot mismatch")
+	// ErrZeroInterval is returned when the background checks are enabled
+	// with a zero interval.
+	ErrZeroInterval = errors.New("integrity check interval must be positive")
+)
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// fetchTimeout is the timeout of a request for the blob sidecars of a slot.
+const fetchTimeout = 30 * time.Second
+
+// blobSidecarsPath is the path of the blob API serving the blob sidecars of
+// a block.
+const blobSidecarsPath = "/eth/v1/beacon/blob_sidecars/"
+
+// blobSidecarJSON is a blob sidecar served by the blob API.
+type blobSidecarJSON struct {
+	Index             uint64                `json:"index,string"`
+	Blob              *eip4844.Blob         `json:"blob"`
+	KZGCommitment     eip4844.KZGCommitment `json:"kzg_commitment"`
+	KZGProof          eip4844.KZGProof      `json:"kzg_proof"`
+	SignedBlockHeader struct {
+		Message *ctypes.BeaconBlockHeader `json:"message"`
+	} `json:"signed_block_header"`
+	InclusionProof []common.Root `json:"kzg_commitment_inclusion_proof"`
+}
+
+// fetchSidecars requests the blob sidecars of a slot from the blob API of
+// the node at the given URL.
+func fetchSidecars(
+	ctx context.Context,
+	client *http.Client,
+	peer string,
+	slot math.Slot,
+) ([]*types.BlobSidecar, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet,
+		strings.TrimSuffix(peer, "/")+blobSidecarsPath+slot.Base10(), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(ErrPeerRequest, err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(ErrPeerRequest, "status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Data []*blobSidecarJSON `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, errors.Wrap(ErrPeerRequest, err.Error())
+	}
+
+	sidecars := make([]*types.BlobSidecar, 0, len(body.Data))
+	for _, data := range body.Data {
+		if data == nil || data.Blob == nil ||
+			data.SignedBlockHeader.Message == nil {
+			return nil, errors.Wrap(ErrPeerRequest, "incomplete blob sidecar")
+		}
+		sidecars = append(sidecars, &types.BlobSidecar{
+			Index:             data.Index,
+			Blob:              *data.Blob,
+			KzgCommitment:     data.KZGCommitment,
+			KzgProof:          data.KZGProof,
+			BeaconBlockHeader: data.SignedBlockHeader.Message,
+			InclusionProof:    data.InclusionProof,
+		})
+	}
+	return sidecars, nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// IssueKind is the kind of an issue found with a blob sidecar.
+type IssueKind string
+
+const (
+	// IssueMissing is the kind of the blob sidecars listed in the
+	// commitments of their slot but not stored.
+	IssueMissing IssueKind = "missing"
+	// IssueCorrupt is the kind of the stored blob sidecars that cannot be
+	// decoded, do not match their commitment, or fail their proofs.
+	IssueCorrupt IssueKind = "corrupt"
+)
+
+// Issue is a missing or corrupt blob sidecar of the availability store.
+type Issue struct {
+	// Slot is the slot of the blob sidecar.
+	Slot math.Slot `json:"slot"`
+	// Commitment is the KZG commitment the blob sidecar is stored under, or
+	// empty if the commitments of the slot could not be read.
+	Commitment string `json:"commitment"`
+	// Kind is the kind of the issue.
+	Kind IssueKind `json:"kind"`
+	// Reason describes the issue.
+	Reason string `json:"reason"`
+	// Refetched is whether the blob sidecars of the slot were fetched again
+	// from a peer and stored.
+	Refetched bool `json:"refetched"`
+}
+
+// Report is the report of a check of the availability store.
+type Report struct {
+	// FromSlot is the first slot checked.
+	FromSlot math.Slot `json:"from_slot"`
+	// ToSlot is the last slot checked.
+	ToSlot math.Slot `json:"to_slot"`
+	// Slots is the number of slots holding blob sidecars.
+	Slots int `json:"slots"`
+	// Sidecars is the number of commitments of the blob sidecars checked.
+	Sidecars int `json:"sidecars"`
+	// Issues are the missing or corrupt blob sidecars found.
+	Issues []Issue `json:"issues"`
+}
+
+// Count returns the number of issues of the given kind, and how many of them
+// were not fixed by fetching the blob sidecars again.
+func (r *Report) Count(kind IssueKind) (int, int) {
+	var total, unresolved int
+	for _, issue := range r.Issues {
+		if issue.Kind != kind {
+			continue
+		}
+		total++
+		if !issue.Refetched {
+			unresolved++
+		}
+	}
+	return total, unresolved
+}
+
+// Unresolved returns the number of issues not fixed by fetching the blob
+// sidecars again.
+func (r *Report) Unresolved() int {
+	_, missing := r.Count(IssueMissing)
+	_, corrupt := r.Count(IssueCorrupt)
+	return missing + corrupt
+}
+
+// WriteFile writes the report to the given path as JSON.
+func (r *Report) WriteFile(path string) error {
+	bz, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	//#nosec:G306 // the report is not sensitive.
+	return os.WriteFile(path, bz, 0o644)
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Service checks the blob sidecars of the data availability window ending at
+// the last finalized block in the background, at the configured interval.
+type Service[BeaconBlockT BeaconBlock] struct {
+	// config is the configuration for the service.
+	config Config
+	// checker checks the availability store.
+	checker *Checker
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// head is the slot of the last finalized block.
+	head atomic.Uint64
+	// subFinalizedBlocks is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlocks chan async.Event[BeaconBlockT]
+}
+
+// NewService creates a new integrity check service.
+func NewService[BeaconBlockT BeaconBlock](
+	config Config,
+	checker *Checker,
+	dispatcher asynctypes.EventDispatcher,
+	logger log.Logger,
+) *Service[BeaconBlockT] {
+	return &Service[BeaconBlockT]{
+		config:             config,
+		checker:            checker,
+		dispatcher:         dispatcher,
+		logger:             logger,
+		subFinalizedBlocks: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_]) Name() string {
+	return "blob-integrity"
+}
+
+// Start subscribes the service to BeaconBlockFinalized events, tracking the
+// last finalized block, and starts checking the availability store.
+func (s *Service[_]) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlocks,
+	); err != nil {
+		return err
+	}
+
+	go s.eventLoop(ctx)
+	go s.checkLoop(ctx)
+	return nil
+}
+
+// eventLoop tracks the slot of the last finalized block.
+func (s *Service[_]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.subFinalizedBlocks:
+			s.head.Store(event.Data().GetSlot().Unwrap())
+		}
+	}
+}
+
+// checkLoop checks the availability store at the configured interval, once
+// a block was finalized.
+func (s *Service[_]) checkLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if head := s.head.Load(); head > 0 {
+				s.check(ctx, math.Slot(head))
+			}
+		}
+	}
+}
+
+// check checks the data availability window ending at the given slot and
+// logs the outcome.
+func (s *Service[_]) check(ctx context.Context, head math.Slot) {
+	report, err := s.checker.CheckBlobs(ctx, head, s.config.PeerURL)
+	if err != nil {
+		s.logger.Error("Failed to check blob sidecars", "error", err)
+		return
+	}
+
+	missing, unresolvedMissing := report.Count(IssueMissing)
+	corrupt, unresolvedCorrupt := report.Count(IssueCorrupt)
+	if missing+corrupt == 0 {
+		s.logger.Info(
+			"Checked blob sidecars",
+			"from", report.FromSlot.Base10(), "to", report.ToSlot.Base10(),
+			"num_sidecars", report.Sidecars,
+		)
+		return
+	}
+	s.logger.Warn(
+		"Found missing or corrupt blob sidecars",
+		"from", report.FromSlot.Base10(), "to", report.ToSlot.Base10(),
+		"num_sidecars", report.Sidecars,
+		"missing", missing, "corrupt", corrupt,
+		"unresolved", unresolvedMissing+unresolvedCorrupt,
+	)
+}
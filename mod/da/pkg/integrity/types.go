@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package integrity
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// AvailabilityStore is the store whose blob sidecars are checked.
+type AvailabilityStore interface {
+	// SlotCommitments returns the commitments of the blob sidecars of a
+	// slot, and whether the slot was found.
+	SlotCommitments(slot math.Slot) ([][]byte, bool, error)
+	// GetSidecar returns the blob sidecar of a commitment of a slot.
+	GetSidecar(slot math.Slot, commitment []byte) (*types.BlobSidecar, error)
+	// Persist stores the blob sidecars of a slot.
+	Persist(slot math.Slot, sidecars *types.BlobSidecars) error
+}
+
+// BeaconBlock is the finalized block the data availability window ends at.
+type BeaconBlock interface {
+	GetSlot() math.Slot
+}
+
+// BlockStore is the store of the blocks known to the node, the blob sidecars
+// fetched again must belong to.
+type BlockStore interface {
+	// GetSlotByBlockRoot returns the slot of the block of the given root.
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+}
+
+// ChainSpec represents a chain spec.
+type ChainSpec interface {
+	// MinEpochsForBlobsSidecarsRequest returns the number of epochs of the
+	// data availability window.
+	MinEpochsForBlobsSidecarsRequest() uint64
+	// SlotsPerEpoch returns the number of slots per epoch.
+	SlotsPerEpoch() uint64
+}
+
+// Verifier verifies the inclusion and KZG proofs of the blob sidecars,
+// without relying on any cache of sidecars verified earlier.
+type Verifier interface {
+	// VerifyInclusionProofs verifies the inclusion proofs of the sidecars.
+	VerifyInclusionProofs(sidecars *types.BlobSidecars) error
+	// VerifyKZGProofs verifies the KZG proofs of the sidecars.
+	VerifyKZGProofs(sidecars *types.BlobSidecars) error
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
+}
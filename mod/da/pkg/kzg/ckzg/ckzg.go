@@ -21,7 +21,11 @@
 package ckzg
 
 import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	ckzg4844 "github.com/ethereum/c-kzg-4844/bindings/go"
 )
@@ -30,7 +34,12 @@ import (
 const Implementation = "ethereum/c-kzg-4844"
 
 // Verifier is a verifier that utilizies the CKZG library.
-type Verifier struct{}
+type Verifier struct {
+	// cells computes and verifies the cell proofs with go-eth-kzg, as
+	// c-kzg-4844 v1 does not support them and v2 cannot be linked along
+	// with the v1 used by go-ethereum.
+	cells *peerdas.Context
+}
 
 // GetImplementation returns the implementation of the verifier.
 func (v Verifier) GetImplementation() string {
@@ -40,8 +49,13 @@ func (v Verifier) GetImplementation() string {
 // NewVerifier creates a new CKZG verifier.
 //
 //nolint:mnd // lots of random numbers because cryptography.
-func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
-	if err := gokzg4844.CheckTrustedSetupIsWellFormed(ts); err != nil {
+func NewVerifier(ts *goethkzg.JSONTrustedSetup) (*Verifier, error) {
+	if err := gokzg4844.CheckTrustedSetupIsWellFormed(
+		&gokzg4844.JSONTrustedSetup{
+			SetupG2:         ts.SetupG2,
+			SetupG1Lagrange: ts.SetupG1Lagrange,
+		},
+	); err != nil {
 		return nil, err
 	}
 	g1s := make(
@@ -58,5 +72,27 @@ func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
 	if err := ckzg4844.LoadTrustedSetup(g1s, g2s); err != nil {
 		return nil, err
 	}
-	return &Verifier{}, nil
+	v := &Verifier{}
+	// The trusted setups of EIP-4844 lack the monomial points, which leaves
+	// the verifier without cell proofs.
+	if peerdas.HasMonomialSetup(ts) {
+		var err error
+		if v.cells, err = peerdas.New(ts); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// ComputeCellsAndKZGProofs extends the blob into its cells, along with the
+// KZG proof of each cell.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip4844.Cell, []eip4844.KZGProof, error) {
+	return v.cells.ComputeCellsAndKZGProofs(blob)
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells.
+func (v Verifier) VerifyCellKZGProofBatch(args *types.CellProofArgs) error {
+	return v.cells.VerifyCellKZGProofBatch(args)
 }
@@ -26,6 +26,7 @@ import (
 	"unsafe"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	ckzg4844 "github.com/ethereum/c-kzg-4844/bindings/go"
 )
@@ -71,3 +72,15 @@ func (v Verifier) VerifyBlobProofBatch(
 	}
 	return nil
 }
+
+// ComputeKZGProof computes the KZG proof opening the commitment of the blob
+// at the point z, along with the evaluation of the blob at z.
+func (v Verifier) ComputeKZGProof(
+	blob *eip4844.Blob,
+	z bytes.B32,
+) (eip4844.KZGProof, bytes.B32, error) {
+	proof, y, err := ckzg4844.ComputeKZGProof(
+		(*ckzg4844.Blob)(blob), (ckzg4844.Bytes32)(z),
+	)
+	return eip4844.KZGProof(proof), bytes.B32(y), err
+}
@@ -24,6 +24,7 @@ package ckzg
 
 import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
@@ -42,3 +43,11 @@ func (v Verifier) VerifyBlobProofBatch(
 ) error {
 	return ErrCGONotEnabled
 }
+
+// ComputeKZGProof will error since cgo is not enabled.
+func (v Verifier) ComputeKZGProof(
+	*eip4844.Blob,
+	bytes.B32,
+) (eip4844.KZGProof, bytes.B32, error) {
+	return eip4844.KZGProof{}, bytes.B32{}, ErrCGONotEnabled
+}
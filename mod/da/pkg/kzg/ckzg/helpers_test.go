@@ -29,7 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/ckzg"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -57,7 +57,7 @@ func setupVerifier() (*ckzg.Verifier, error) {
 		return nil, err
 	}
 
-	var ts gokzg4844.JSONTrustedSetup
+	var ts goethkzg.JSONTrustedSetup
 	if errUnmarshal := json.Unmarshal(file, &ts); errUnmarshal != nil {
 		return nil, errUnmarshal
 	}
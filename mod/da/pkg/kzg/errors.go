@@ -28,4 +28,8 @@ var (
 	ErrUnsupportedKzgImplementation = errors.New(
 		"unsupported KZG implementation",
 	)
+
+	// ErrInvalidEvaluation is returned when the evaluation of a blob at the
+	// point of one of its field elements differs from the field element.
+	ErrInvalidEvaluation = errors.New("invalid blob evaluation")
 )
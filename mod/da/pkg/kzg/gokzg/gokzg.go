@@ -23,8 +23,11 @@ package gokzg
 import (
 	"unsafe"
 
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 )
 
@@ -33,15 +36,29 @@ const Implementation = "crate-crypto/go-kzg-4844"
 // Verifier is a KZG verifier that uses the Go implementation of KZG.
 type Verifier struct {
 	*gokzg4844.Context
+	// cells computes and verifies the cell proofs with go-eth-kzg, as
+	// go-kzg-4844 does not support them.
+	cells *peerdas.Context
 }
 
 // NewVerifier creates a new GoKZGVerifier.
-func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
-	ctx, err := gokzg4844.NewContext4096(ts)
+func NewVerifier(ts *goethkzg.JSONTrustedSetup) (*Verifier, error) {
+	ctx, err := gokzg4844.NewContext4096(&gokzg4844.JSONTrustedSetup{
+		SetupG2:         ts.SetupG2,
+		SetupG1Lagrange: ts.SetupG1Lagrange,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &Verifier{ctx}, nil
+	v := &Verifier{Context: ctx}
+	// The trusted setups of EIP-4844 lack the monomial points, which leaves
+	// the verifier without cell proofs.
+	if peerdas.HasMonomialSetup(ts) {
+		if v.cells, err = peerdas.New(ts); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
 }
 
 // GetImplementation returns the implementation of the verifier.
@@ -83,3 +100,28 @@ func (v Verifier) VerifyBlobProofBatch(
 			*(*[]gokzg4844.KZGProof)(unsafe.Pointer(&args.Proofs)),
 		)
 }
+
+// ComputeKZGProof computes the KZG proof opening the commitment of the blob
+// at the point z, along with the evaluation of the blob at z.
+func (v Verifier) ComputeKZGProof(
+	blob *eip4844.Blob,
+	z bytes.B32,
+) (eip4844.KZGProof, bytes.B32, error) {
+	proof, y, err := v.Context.ComputeKZGProof(
+		(*gokzg4844.Blob)(blob), (gokzg4844.Scalar)(z), 0,
+	)
+	return eip4844.KZGProof(proof), bytes.B32(y), err
+}
+
+// ComputeCellsAndKZGProofs extends the blob into its cells, along with the
+// KZG proof of each cell.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip4844.Cell, []eip4844.KZGProof, error) {
+	return v.cells.ComputeCellsAndKZGProofs(blob)
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells.
+func (v Verifier) VerifyCellKZGProofBatch(args *types.CellProofArgs) error {
+	return v.cells.VerifyCellKZGProofBatch(args)
+}
@@ -25,10 +25,11 @@ import (
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
@@ -134,8 +135,26 @@ func TestGetImplementation(t *testing.T) {
 	require.Equal(t, gokzg.Implementation, impl)
 }
 
-// setupVerifier reads the trusted setup file and creates a new GoKZGVerifier.
-func setupVerifier() (*gokzg.Verifier, error) {
+func TestVerifier_WithoutMonomialSetup(t *testing.T) {
+	ts, err := readTrustedSetup()
+	require.NoError(t, err)
+	// The trusted setups of EIP-4844 only carry the Lagrange points.
+	ts.SetupG1Monomial = [goethkzg.ScalarsPerBlob]string{}
+	verifier, err := gokzg.NewVerifier(ts)
+	require.NoError(t, err)
+
+	blob, proof, commitment := setupTestData(t, "test_data.json")
+	require.NoError(t, verifier.VerifyBlobProof(blob, proof, commitment))
+
+	_, _, err = verifier.ComputeCellsAndKZGProofs(blob)
+	require.ErrorIs(t, err, peerdas.ErrMissingMonomialSetup)
+	require.ErrorIs(t, verifier.VerifyCellKZGProofBatch(
+		&types.CellProofArgs{},
+	), peerdas.ErrMissingMonomialSetup)
+}
+
+// readTrustedSetup reads the trusted setup file.
+func readTrustedSetup() (*goethkzg.JSONTrustedSetup, error) {
 	fs := afero.NewOsFs()
 	fileName := "kzg-trusted-setup.json"
 	fullPath := filepath.Join(baseDir, fileName)
@@ -145,12 +164,21 @@ func setupVerifier() (*gokzg.Verifier, error) {
 		return nil, err
 	}
 
-	var ts gokzg4844.JSONTrustedSetup
+	var ts goethkzg.JSONTrustedSetup
 	if errUnmarshal := json.Unmarshal(file, &ts); errUnmarshal != nil {
 		return nil, errUnmarshal
 	}
+	return &ts, nil
+}
+
+// setupVerifier reads the trusted setup file and creates a new GoKZGVerifier.
+func setupVerifier() (*gokzg.Verifier, error) {
+	ts, err := readTrustedSetup()
+	if err != nil {
+		return nil, err
+	}
 
-	verifier, errVerifier := gokzg.NewVerifier(&ts)
+	verifier, errVerifier := gokzg.NewVerifier(ts)
 	if errVerifier != nil {
 		return nil, errVerifier
 	}
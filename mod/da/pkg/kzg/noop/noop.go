@@ -21,7 +21,9 @@
 package noop
 
 import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
@@ -53,3 +55,29 @@ func (v Verifier) VerifyBlobProofBatch(
 ) error {
 	return nil
 }
+
+// ComputeKZGProof evaluates the blob at the point z, with an empty proof.
+func (v Verifier) ComputeKZGProof(
+	blob *eip4844.Blob,
+	z bytes.B32,
+) (eip4844.KZGProof, bytes.B32, error) {
+	y, err := peerdas.EvaluateBlob(blob, z)
+	return eip4844.KZGProof{}, y, err
+}
+
+// ComputeCellsAndKZGProofs extends the blob into its cells, with empty
+// proofs.
+func (v Verifier) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip4844.Cell, []eip4844.KZGProof, error) {
+	cells, err := peerdas.ComputeCells(blob)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cells, make([]eip4844.KZGProof, len(cells)), nil
+}
+
+// VerifyCellKZGProofBatch is a no-op.
+func (v Verifier) VerifyCellKZGProofBatch(*types.CellProofArgs) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package peerdas
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// ComputeCells extends the blob into its cells, without their proofs.
+func ComputeCells(blob *eip4844.Blob) ([]eip4844.Cell, error) {
+	coeffs, err := blobToCoefficients(blob)
+	if err != nil {
+		return nil, err
+	}
+	return computeCells(coeffs), nil
+}
+
+// blobToCoefficients parses the blob, holding the evaluations of its
+// polynomial in bit-reversed order, into the coefficients of the polynomial.
+func blobToCoefficients(blob *eip4844.Blob) ([]fr.Element, error) {
+	coeffs := make([]fr.Element, fieldElementsPerBlob)
+	for i := range coeffs {
+		if err := coeffs[i].SetBytesCanonical(
+			blob[i*bytesPerFieldElement : (i+1)*bytesPerFieldElement],
+		); err != nil {
+			return nil, errors.Wrapf(
+				ErrInvalidFieldElement, "blob element %d", i,
+			)
+		}
+	}
+	bitReverse(coeffs)
+	ifft(coeffs, rootOfUnity(fieldElementsPerBlob))
+	return coeffs, nil
+}
+
+// computeCells evaluates the polynomial over the extended domain, and splits
+// the evaluations in bit-reversed order into cells. The first half of the
+// cells is thus the blob itself.
+func computeCells(coeffs []fr.Element) []eip4844.Cell {
+	ext := make([]fr.Element, fieldElementsPerExtBlob)
+	copy(ext, coeffs)
+	fft(ext, rootOfUnity(fieldElementsPerExtBlob))
+	bitReverse(ext)
+
+	cells := make([]eip4844.Cell, eip4844.CellsPerExtBlob)
+	for i := range ext {
+		cell, j := i/eip4844.FieldElementsPerCell, i%eip4844.FieldElementsPerCell
+		bz := ext[i].Bytes()
+		copy(cells[cell][j*bytesPerFieldElement:], bz[:])
+	}
+	return cells
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package peerdas
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// primitiveRoot is the generator of the multiplicative group of the scalar
+// field used to derive the roots of unity, as per the Deneb specification.
+const primitiveRoot = 7
+
+// rootOfUnity returns a primitive n-th root of unity of the scalar field,
+// n being a power of two.
+func rootOfUnity(n uint64) fr.Element {
+	exp := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+	exp.Div(exp, new(big.Int).SetUint64(n))
+	var root fr.Element
+	root.SetUint64(primitiveRoot)
+	return *root.Exp(root, exp)
+}
+
+// reverseBits reverses the lowest log2(n) bits of i.
+func reverseBits(i, n uint64) uint64 {
+	return bits.Reverse64(i) >> (bits.LeadingZeros64(n) + 1)
+}
+
+// bitReverse applies the bit-reversal permutation to the list in place.
+func bitReverse[T any](list []T) {
+	n := uint64(len(list))
+	for i := range n {
+		if j := reverseBits(i, n); i < j {
+			list[i], list[j] = list[j], list[i]
+		}
+	}
+}
+
+// fft evaluates in place the polynomial of the given coefficients over the
+// powers of root, a primitive len(values)-th root of unity. Both the input
+// and the output are in natural order.
+func fft(values []fr.Element, root fr.Element) {
+	n := uint64(len(values))
+	bitReverse(values)
+	for size := uint64(2); size <= n; size <<= 1 {
+		var step fr.Element
+		step.Exp(root, new(big.Int).SetUint64(n/size))
+		half := size >> 1
+		for start := uint64(0); start < n; start += size {
+			var w, t fr.Element
+			w.SetOne()
+			for j := range half {
+				t.Mul(&w, &values[start+j+half])
+				values[start+j+half].Sub(&values[start+j], &t)
+				values[start+j].Add(&values[start+j], &t)
+				w.Mul(&w, &step)
+			}
+		}
+	}
+}
+
+// ifft interpolates in place the coefficients of the polynomial of the given
+// evaluations over the powers of root.
+func ifft(values []fr.Element, root fr.Element) {
+	var inv, nInv fr.Element
+	inv.Inverse(&root)
+	fft(values, inv)
+	nInv.SetUint64(uint64(len(values)))
+	nInv.Inverse(&nInv)
+	for i := range values {
+		values[i].Mul(&values[i], &nInv)
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package peerdas
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidFieldElement is returned when a blob or a cell holds a
+	// non-canonical field element.
+	ErrInvalidFieldElement = errors.New("invalid field element")
+	// ErrInvalidFieldElementIndex is returned when the index of a field
+	// element is out of the range of a blob.
+	ErrInvalidFieldElementIndex = errors.New("invalid field element index")
+	// ErrInvalidCellIndex is returned when a cell index is out of range.
+	ErrInvalidCellIndex = errors.New("invalid cell index")
+	// ErrInvalidTrustedSetup is returned when the trusted setup does not
+	// hold the points needed to compute and verify cell proofs.
+	ErrInvalidTrustedSetup = errors.New("invalid trusted setup")
+	// ErrMissingMonomialSetup is returned when cell proofs are computed or
+	// verified with a trusted setup that lacks the G1 points in monomial
+	// form.
+	ErrMissingMonomialSetup = errors.New(
+		"trusted setup lacks the G1 points in monomial form",
+	)
+	// ErrInconsistentCellArgs is returned when the cells, indices, proofs
+	// and commitments of a batch have different lengths.
+	ErrInconsistentCellArgs = errors.New("inconsistent cell proof arguments")
+	// ErrInvalidCellProof is returned when a batch of cell proofs fails to
+	// verify.
+	ErrInvalidCellProof = errors.New("invalid cell proof")
+)
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package peerdas
+
+import (
+	"math/big"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// EvaluationPoint returns the point at which the polynomial of a blob
+// evaluates to the field element of the blob at the given index, that is the
+// root of unity of the domain of the blob at the index in bit-reversed order.
+func EvaluationPoint(index uint64) (bytes.B32, error) {
+	if index >= fieldElementsPerBlob {
+		return bytes.B32{}, ErrInvalidFieldElementIndex
+	}
+	var point fr.Element
+	root := rootOfUnity(fieldElementsPerBlob)
+	point.Exp(
+		root,
+		new(big.Int).SetUint64(reverseBits(index, fieldElementsPerBlob)),
+	)
+	return point.Bytes(), nil
+}
+
+// EvaluateBlob evaluates the polynomial of the blob at the given point.
+func EvaluateBlob(blob *eip4844.Blob, z bytes.B32) (bytes.B32, error) {
+	coeffs, err := blobToCoefficients(blob)
+	if err != nil {
+		return bytes.B32{}, err
+	}
+	var x, y fr.Element
+	if err = x.SetBytesCanonical(z[:]); err != nil {
+		return bytes.B32{}, ErrInvalidFieldElement
+	}
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y.Mul(&y, &x)
+		y.Add(&y, &coeffs[i])
+	}
+	return y.Bytes(), nil
+}
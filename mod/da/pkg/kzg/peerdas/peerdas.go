@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package peerdas implements the cells and cell KZG proofs of the EIP-7594
+// data column sampling. The cell proofs are computed and verified by
+// crate-crypto/go-eth-kzg.
+package peerdas
+
+import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
+)
+
+const (
+	// bytesPerFieldElement is the size of a serialized field element.
+	bytesPerFieldElement = 32
+	// fieldElementsPerBlob is the number of field elements in a blob.
+	fieldElementsPerBlob = 4096
+	// fieldElementsPerExtBlob is the number of field elements in an
+	// extended blob.
+	fieldElementsPerExtBlob = 2 * fieldElementsPerBlob
+)
+
+// Context computes and verifies cell KZG proofs. A nil Context stands for a
+// trusted setup without the G1 points in monomial form, which only serves the
+// proofs of EIP-4844.
+type Context struct {
+	*goethkzg.Context
+}
+
+// HasMonomialSetup reports whether the trusted setup holds the G1 points in
+// monomial form needed by the cell proofs. The trusted setup files of
+// EIP-4844 do not carry them.
+func HasMonomialSetup(ts *goethkzg.JSONTrustedSetup) bool {
+	return ts.SetupG1Monomial[0] != ""
+}
+
+// New creates a new Context from the trusted setup, which must hold the G1
+// points in monomial form along with the Lagrange ones.
+func New(ts *goethkzg.JSONTrustedSetup) (*Context, error) {
+	if len(ts.SetupG2) <= eip4844.FieldElementsPerCell {
+		return nil, errors.Wrapf(
+			ErrInvalidTrustedSetup, "%d G2 points, need at least %d",
+			len(ts.SetupG2), eip4844.FieldElementsPerCell+1,
+		)
+	}
+	if !HasMonomialSetup(ts) {
+		return nil, ErrMissingMonomialSetup
+	}
+
+	ctx, err := goethkzg.NewContext4096(ts)
+	if err != nil {
+		return nil, err
+	}
+	return &Context{Context: ctx}, nil
+}
+
+// ComputeCellsAndKZGProofs extends the blob into its cells, along with the
+// KZG proof of each cell as per compute_cells_and_kzg_proofs of EIP-7594.
+func (c *Context) ComputeCellsAndKZGProofs(
+	blob *eip4844.Blob,
+) ([]eip4844.Cell, []eip4844.KZGProof, error) {
+	if c == nil {
+		return nil, nil, ErrMissingMonomialSetup
+	}
+	cells, proofs, err := c.Context.ComputeCellsAndKZGProofs(
+		(*goethkzg.Blob)(blob), 0,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		outCells  = make([]eip4844.Cell, eip4844.CellsPerExtBlob)
+		outProofs = make([]eip4844.KZGProof, eip4844.CellsPerExtBlob)
+	)
+	for i := range outCells {
+		outCells[i] = eip4844.Cell(*cells[i])
+		outProofs[i] = eip4844.KZGProof(proofs[i])
+	}
+	return outCells, outProofs, nil
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells as per
+// verify_cell_kzg_proof_batch of EIP-7594.
+func (c *Context) VerifyCellKZGProofBatch(args *types.CellProofArgs) error {
+	if c == nil {
+		return ErrMissingMonomialSetup
+	}
+	n := len(args.Cells)
+	if len(args.Commitments) != n || len(args.CellIndices) != n ||
+		len(args.Proofs) != n {
+		return ErrInconsistentCellArgs
+	}
+
+	var (
+		commitments = make([]goethkzg.KZGCommitment, n)
+		cells       = make([]*goethkzg.Cell, n)
+		proofs      = make([]goethkzg.KZGProof, n)
+	)
+	for k := range n {
+		if args.CellIndices[k] >= eip4844.CellsPerExtBlob {
+			return errors.Wrapf(
+				ErrInvalidCellIndex, "%d", args.CellIndices[k],
+			)
+		}
+		commitments[k] = goethkzg.KZGCommitment(args.Commitments[k])
+		cells[k] = (*goethkzg.Cell)(&args.Cells[k])
+		proofs[k] = goethkzg.KZGProof(args.Proofs[k])
+	}
+
+	if err := c.Context.VerifyCellKZGProofBatch(
+		commitments, args.CellIndices, cells, proofs,
+	); err != nil {
+		return errors.Join(ErrInvalidCellProof, err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package peerdas_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+var baseDir = "../../../../../testing/files/"
+
+func TestCellKZGProofs(t *testing.T) {
+	ctx, blob, commitment := setup(t)
+
+	cells, proofs, err := ctx.ComputeCellsAndKZGProofs(blob)
+	require.NoError(t, err)
+	require.Len(t, cells, eip4844.CellsPerExtBlob)
+	require.Len(t, proofs, eip4844.CellsPerExtBlob)
+
+	// The first half of the extended blob is the blob itself.
+	for i := range eip4844.CellsPerExtBlob / 2 {
+		require.Equal(t, blob[i*len(cells[i]):(i+1)*len(cells[i])], cells[i][:])
+	}
+	withoutProofs, err := peerdas.ComputeCells(blob)
+	require.NoError(t, err)
+	require.Equal(t, cells, withoutProofs)
+
+	args := &types.CellProofArgs{}
+	for i := range cells {
+		args.Commitments = append(args.Commitments, commitment)
+		args.CellIndices = append(args.CellIndices, uint64(i))
+		args.Cells = append(args.Cells, cells[i])
+		args.Proofs = append(args.Proofs, proofs[i])
+	}
+	require.NoError(t, ctx.VerifyCellKZGProofBatch(args))
+
+	// A single cell is verified against its own coset.
+	require.NoError(t, ctx.VerifyCellKZGProofBatch(&types.CellProofArgs{
+		Commitments: []eip4844.KZGCommitment{commitment},
+		CellIndices: []uint64{77},
+		Cells:       []eip4844.Cell{cells[77]},
+		Proofs:      []eip4844.KZGProof{proofs[77]},
+	}))
+	require.ErrorIs(t, ctx.VerifyCellKZGProofBatch(&types.CellProofArgs{
+		Commitments: []eip4844.KZGCommitment{commitment},
+		CellIndices: []uint64{78},
+		Cells:       []eip4844.Cell{cells[77]},
+		Proofs:      []eip4844.KZGProof{proofs[77]},
+	}), peerdas.ErrInvalidCellProof)
+
+	// Tampering with any cell of the batch invalidates it.
+	args.Cells[100][31] ^= 1
+	require.ErrorIs(
+		t, ctx.VerifyCellKZGProofBatch(args), peerdas.ErrInvalidCellProof,
+	)
+}
+
+func TestVerifyCellKZGProofBatch_InvalidArgs(t *testing.T) {
+	ctx, _, commitment := setup(t)
+
+	require.ErrorIs(t, ctx.VerifyCellKZGProofBatch(&types.CellProofArgs{
+		Commitments: []eip4844.KZGCommitment{commitment},
+	}), peerdas.ErrInconsistentCellArgs)
+	require.ErrorIs(t, ctx.VerifyCellKZGProofBatch(&types.CellProofArgs{
+		Commitments: []eip4844.KZGCommitment{commitment},
+		CellIndices: []uint64{eip4844.CellsPerExtBlob},
+		Cells:       make([]eip4844.Cell, 1),
+		Proofs:      make([]eip4844.KZGProof, 1),
+	}), peerdas.ErrInvalidCellIndex)
+}
+
+func setup(t *testing.T) (
+	*peerdas.Context, *eip4844.Blob, eip4844.KZGCommitment,
+) {
+	t.Helper()
+	fs := afero.NewOsFs()
+
+	file, err := afero.ReadFile(
+		fs, filepath.Join(baseDir, "kzg-trusted-setup.json"),
+	)
+	require.NoError(t, err)
+	var ts goethkzg.JSONTrustedSetup
+	require.NoError(t, json.Unmarshal(file, &ts))
+	ctx, err := peerdas.New(&ts)
+	require.NoError(t, err)
+
+	file, err = afero.ReadFile(fs, filepath.Join(baseDir, "test_data.json"))
+	require.NoError(t, err)
+	var data struct {
+		Input struct {
+			Blob       string `json:"blob"`
+			Commitment string `json:"commitment"`
+		} `json:"input"`
+	}
+	require.NoError(t, json.Unmarshal(file, &data))
+
+	var (
+		blob       eip4844.Blob
+		commitment eip4844.KZGCommitment
+	)
+	require.NoError(t, blob.UnmarshalJSON([]byte(`"`+data.Input.Blob+`"`)))
+	require.NoError(t, commitment.UnmarshalJSON(
+		[]byte(`"`+data.Input.Commitment+`"`),
+	))
+	return ctx, &blob, commitment
+}
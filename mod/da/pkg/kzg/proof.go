@@ -25,8 +25,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
 	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 )
 
 // BlobProofVerifier is a verifier for blobs.
@@ -46,13 +47,27 @@ type BlobProofVerifier interface {
 	// For most implementations it is more efficient than VerifyBlobProof when
 	// verifying multiple proofs.
 	VerifyBlobProofBatch(*kzgtypes.BlobProofArgs) error
+	// ComputeKZGProof computes the KZG proof opening the commitment of the
+	// blob at the point z, along with the evaluation y of the blob at z.
+	ComputeKZGProof(
+		blob *eip4844.Blob,
+		z bytes.B32,
+	) (eip4844.KZGProof, bytes.B32, error)
+	// ComputeCellsAndKZGProofs extends the blob into the cells of the data
+	// columns, along with the KZG proof of each cell, as per EIP-7594.
+	ComputeCellsAndKZGProofs(
+		blob *eip4844.Blob,
+	) ([]eip4844.Cell, []eip4844.KZGProof, error)
+	// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells
+	// against the commitments of their blobs.
+	VerifyCellKZGProofBatch(*kzgtypes.CellProofArgs) error
 }
 
 // NewBlobProofVerifier creates a new BlobVerifier with the given
 // implementation.
 func NewBlobProofVerifier(
 	impl string,
-	ts *gokzg4844.JSONTrustedSetup,
+	ts *goethkzg.JSONTrustedSetup,
 ) (BlobProofVerifier, error) {
 	switch impl {
 	case gokzg.Implementation:
@@ -88,3 +103,25 @@ func ArgsFromSidecars[
 	}
 	return proofArgs
 }
+
+// ArgsFromDataColumns converts data column sidecars to the CellProofArgs of
+// all their cells.
+func ArgsFromDataColumns[
+	DataColumnSidecarT kzgtypes.DataColumnSidecar,
+](
+	columns []DataColumnSidecarT,
+) *kzgtypes.CellProofArgs {
+	proofArgs := &kzgtypes.CellProofArgs{}
+	for _, column := range columns {
+		commitments, proofs := column.GetKzgCommitments(), column.GetKzgProofs()
+		for i, cell := range column.GetColumn() {
+			proofArgs.Commitments = append(proofArgs.Commitments, commitments[i])
+			proofArgs.CellIndices = append(
+				proofArgs.CellIndices, column.GetIndex(),
+			)
+			proofArgs.Cells = append(proofArgs.Cells, *cell)
+			proofArgs.Proofs = append(proofArgs.Proofs, proofs[i])
+		}
+	}
+	return proofArgs
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg
+
+import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+// bytesPerFieldElement is the size of a serialized field element.
+const bytesPerFieldElement = 32
+
+// BlobSampler serves the field elements of blobs, each along with the KZG
+// proof opening the commitment of its blob at its evaluation point, so that
+// light clients can sample the availability of blobs without downloading
+// them.
+type BlobSampler struct {
+	// prover computes the KZG proofs of the field elements.
+	prover BlobProofVerifier
+}
+
+// NewBlobSampler creates a new BlobSampler computing the KZG proofs with the
+// given prover.
+func NewBlobSampler(prover BlobProofVerifier) *BlobSampler {
+	return &BlobSampler{prover: prover}
+}
+
+// SampleBlob returns the field element of the blob at the given index, along
+// with its evaluation point and the KZG proof of the evaluation.
+func (s *BlobSampler) SampleBlob(
+	blob *eip4844.Blob,
+	index uint64,
+) (z, y bytes.B32, proof eip4844.KZGProof, err error) {
+	if z, err = peerdas.EvaluationPoint(index); err != nil {
+		return z, y, proof, err
+	}
+	if proof, y, err = s.prover.ComputeKZGProof(blob, z); err != nil {
+		return z, y, proof, err
+	}
+
+	// The blob evaluates to its field element at the point of the element.
+	start := index * bytesPerFieldElement
+	if y != bytes.B32(blob[start:start+bytesPerFieldElement]) {
+		return z, y, proof, ErrInvalidEvaluation
+	}
+	return z, y, proof, nil
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/noop"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobSampler(t *testing.T) {
+	ts, err := loadTrustedSetupFromFile()
+	require.NoError(t, err)
+	verifier, err := gokzg.NewVerifier(ts)
+	require.NoError(t, err)
+
+	// Fill the blob with canonical field elements.
+	blob := &eip4844.Blob{}
+	for i := range blob {
+		if i%32 != 0 {
+			blob[i] = byte(i * 7)
+		}
+	}
+	commitment, err := verifier.BlobToKZGCommitment(
+		(*gokzg4844.Blob)(blob), 0,
+	)
+	require.NoError(t, err)
+
+	sampler := kzg.NewBlobSampler(verifier)
+	for _, index := range []uint64{0, 1, 2048, 4095} {
+		z, y, proof, sampleErr := sampler.SampleBlob(blob, index)
+		require.NoError(t, sampleErr)
+		require.Equal(t, blob[index*32:(index+1)*32], y[:])
+		require.NoError(t, verifier.VerifyKZGProof(
+			commitment, gokzg4844.Scalar(z), gokzg4844.Scalar(y),
+			gokzg4844.KZGProof(proof),
+		))
+	}
+
+	// The evaluation of the no-op prover matches the field elements too.
+	_, y, _, err := kzg.NewBlobSampler(noop.NewVerifier()).
+		SampleBlob(blob, 5)
+	require.NoError(t, err)
+	require.Equal(t, blob[5*32:6*32], y[:])
+
+	_, _, _, err = sampler.SampleBlob(blob, 4096)
+	require.ErrorIs(t, err, peerdas.ErrInvalidFieldElementIndex)
+}
@@ -35,6 +35,13 @@ type BlobSidecars[BlobSidecarT BlobSidecar] interface {
 	GetSidecars() []BlobSidecarT
 }
 
+type DataColumnSidecar interface {
+	GetIndex() uint64
+	GetColumn() []*eip4844.Cell
+	GetKzgCommitments() []eip4844.KZGCommitment
+	GetKzgProofs() []eip4844.KZGProof
+}
+
 // BlobProofArgs represents the arguments for a blob proof.
 type BlobProofArgs struct {
 	// Blob is the blob.
@@ -44,3 +51,15 @@ type BlobProofArgs struct {
 	// Commitment is the KZG commitment.
 	Commitments []eip4844.KZGCommitment
 }
+
+// CellProofArgs represents the arguments for a batch of cell proofs.
+type CellProofArgs struct {
+	// Commitments are the KZG commitments of the blobs of the cells.
+	Commitments []eip4844.KZGCommitment
+	// CellIndices are the indices of the cells in their extended blobs.
+	CellIndices []uint64
+	// Cells are the cells.
+	Cells []eip4844.Cell
+	// Proofs are the KZG proofs of the cells.
+	Proofs []eip4844.KZGProof
+}
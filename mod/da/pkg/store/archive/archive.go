@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Archive is the cold tier of the availability store. It keeps the blob
+// sidecars of the slots past the data availability period in compressed,
+// append-only segment files, one per range of slots, indexed in memory by
+// slot and versioned hash.
+type Archive struct {
+	// logger is the logger of the archive.
+	logger log.Logger
+	// dir is the directory of the segments.
+	dir string
+	// slotsPerSegment is the number of slots of each segment.
+	slotsPerSegment uint64
+	// encoder compresses the payloads of the records.
+	encoder *zstd.Encoder
+	// decoder decompresses the payloads of the records.
+	decoder *zstd.Decoder
+
+	// mu protects the indexes and the appends to the segments.
+	mu sync.RWMutex
+	// slots indexes the records by slot.
+	slots map[uint64]location
+	// hashes indexes the sidecars by versioned hash.
+	hashes map[common.ExecutionHash]hashLocation
+	// next is the slot past the highest archived slot.
+	next uint64
+}
+
+// New opens the archive in the given directory, indexing its segments and
+// truncating the records left partially written by a crash.
+func New(
+	dir string,
+	slotsPerSegment uint64,
+	logger log.Logger,
+) (*Archive, error) {
+	if slotsPerSegment == 0 {
+		return nil, ErrZeroSegmentEpochs
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	encoder, err := zstd.NewWriter(
+		nil, zstd.WithEncoderLevel(zstd.SpeedBetterCompression),
+	)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Archive{
+		logger:          logger,
+		dir:             dir,
+		slotsPerSegment: slotsPerSegment,
+		encoder:         encoder,
+		decoder:         decoder,
+		slots:           make(map[uint64]location),
+		hashes:          make(map[common.ExecutionHash]hashLocation),
+	}
+	return a, a.load()
+}
+
+// load indexes the segments of the archive.
+func (a *Archive) load() error {
+	entries, err := os.ReadDir(a.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		segment, ok := parseSegmentName(entry.Name())
+		if !ok || entry.IsDir() {
+			continue
+		}
+		if err = a.loadSegment(segment); err != nil {
+			return errors.Wrapf(err, "segment %s", entry.Name())
+		}
+	}
+	return nil
+}
+
+// loadSegment indexes the records of a segment.
+func (a *Archive) loadSegment(segment uint64) error {
+	path := filepath.Join(a.dir, segmentName(segment))
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	records, end, err := scanSegment(f, segment, info.Size())
+	if err != nil {
+		return err
+	}
+	if end < info.Size() {
+		a.logger.Warn(
+			"Truncating partially written archive record",
+			"segment", segment, "offset", end, "size", info.Size(),
+		)
+		if err = f.Truncate(end); err != nil {
+			return err
+		}
+	}
+	for _, r := range records {
+		a.index(r)
+	}
+	return nil
+}
+
+// index adds a record to the indexes, overriding an earlier record of the
+// same slot.
+func (a *Archive) index(r record) {
+	a.slots[r.slot] = r.location
+	for i, hash := range r.hashes {
+		a.hashes[hash] = hashLocation{slot: r.slot, index: i}
+	}
+	a.next = max(a.next, r.slot+1)
+}
+
+// Next returns the slot past the highest archived slot.
+func (a *Archive) Next() uint64 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.next
+}
+
+// Has returns true if the blob sidecars of the slot are archived.
+func (a *Archive) Has(slot uint64) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	_, ok := a.slots[slot]
+	return ok
+}
+
+// HasVersionedHash returns true if the sidecar of the versioned hash is
+// archived for the slot.
+func (a *Archive) HasVersionedHash(
+	slot uint64, hash common.ExecutionHash,
+) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	loc, ok := a.hashes[hash]
+	return ok && loc.slot == slot
+}
+
+// Put archives the blob sidecars of a slot. Slots already archived are kept
+// as they are.
+func (a *Archive) Put(slot uint64, sidecars *types.BlobSidecars) error {
+	if sidecars.IsNil() || sidecars.Len() == 0 || a.Has(slot) {
+		return nil
+	}
+
+	hashes := make([]common.ExecutionHash, sidecars.Len())
+	for i, sidecar := range sidecars.Sidecars {
+		if sidecar == nil {
+			return ErrNilSidecar
+		}
+		hashes[i] = sidecar.KzgCommitment.ToVersionedHash()
+	}
+	bz, err := sidecars.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	payload := a.encoder.EncodeAll(bz, nil)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	segment := slot - slot%a.slotsPerSegment
+	f, err := os.OpenFile(
+		filepath.Join(a.dir, segmentName(segment)),
+		os.O_CREATE|os.O_RDWR|os.O_APPEND,
+		//#nosec:G302 // blob sidecars are public.
+		0o644,
+	)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	rec := encodeRecord(slot, hashes, payload)
+	if _, err = f.Write(rec); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+
+	a.index(record{
+		slot:   slot,
+		hashes: hashes,
+		location: location{
+			segment:  segment,
+			offset:   info.Size() + int64(len(rec)-len(payload)),
+			length:   uint32(len(payload)), //#nosec:G115 // see encodeRecord.
+			checksum: crc32.ChecksumIEEE(payload),
+		},
+	})
+	return nil
+}
+
+// Get returns the archived blob sidecars of a slot.
+func (a *Archive) Get(slot uint64) (*types.BlobSidecars, error) {
+	a.mu.RLock()
+	loc, ok := a.slots[slot]
+	a.mu.RUnlock()
+	if !ok {
+		return nil, errors.Wrapf(ErrNotArchived, "slot %d", slot)
+	}
+	return a.read(loc)
+}
+
+// GetByVersionedHash returns the archived blob sidecar of a versioned hash
+// and its slot.
+func (a *Archive) GetByVersionedHash(
+	hash common.ExecutionHash,
+) (*types.BlobSidecar, uint64, error) {
+	a.mu.RLock()
+	hashLoc, ok := a.hashes[hash]
+	loc := a.slots[hashLoc.slot]
+	a.mu.RUnlock()
+	if !ok {
+		return nil, 0, errors.Wrapf(ErrNotArchived, "versioned hash %s", hash)
+	}
+
+	sidecars, err := a.read(loc)
+	if err != nil {
+		return nil, 0, err
+	}
+	if hashLoc.index >= sidecars.Len() {
+		return nil, 0, errors.Wrapf(
+			ErrCorruptRecord, "versioned hash %s", hash,
+		)
+	}
+	return sidecars.Get(hashLoc.index), hashLoc.slot, nil
+}
+
+// read reads the blob sidecars of a record.
+func (a *Archive) read(loc location) (*types.BlobSidecars, error) {
+	f, err := os.Open(filepath.Join(a.dir, segmentName(loc.segment)))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	payload, err := readPayload(f, loc)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := a.decoder.DecodeAll(payload, nil)
+	if err != nil {
+		return nil, errors.Join(ErrCorruptRecord, err)
+	}
+	sidecars := &types.BlobSidecars{}
+	if err = sidecars.UnmarshalSSZ(bz); err != nil {
+		return nil, errors.Join(ErrCorruptRecord, err)
+	}
+	return sidecars, nil
+}
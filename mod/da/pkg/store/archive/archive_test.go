@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func testSidecars(slot uint64, n int) *types.BlobSidecars {
+	sidecars := &types.BlobSidecars{}
+	for i := range n {
+		commitment := eip4844.KZGCommitment{byte(slot), byte(i)}
+		blob := &eip4844.Blob{byte(slot), byte(i)}
+		sidecars.Sidecars = append(sidecars.Sidecars, types.BuildBlobSidecar(
+			math.U64(i),
+			&ctypes.BeaconBlockHeader{Slot: math.Slot(slot)},
+			blob,
+			commitment,
+			[48]byte{},
+			make([]common.Root, 8),
+		))
+	}
+	return sidecars
+}
+
+func TestArchive(t *testing.T) {
+	dir := t.TempDir()
+	a, err := archive.New(dir, 4, noop.NewLogger[any]())
+	require.NoError(t, err)
+	require.Zero(t, a.Next())
+
+	for _, slot := range []uint64{1, 2, 5} {
+		require.NoError(t, a.Put(slot, testSidecars(slot, 2)))
+	}
+	require.Equal(t, uint64(6), a.Next())
+	require.False(t, a.Has(3))
+
+	got, err := a.Get(5)
+	require.NoError(t, err)
+	require.Equal(t, testSidecars(5, 2), got)
+	_, err = a.Get(3)
+	require.ErrorIs(t, err, archive.ErrNotArchived)
+
+	want := testSidecars(2, 2).Get(1)
+	hash := common.ExecutionHash(want.KzgCommitment.ToVersionedHash())
+	sidecar, slot, err := a.GetByVersionedHash(hash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), slot)
+	require.Equal(t, want, sidecar)
+	require.True(t, a.HasVersionedHash(2, hash))
+	require.False(t, a.HasVersionedHash(1, hash))
+
+	// Slots 1 and 2 share the first segment, slot 5 the second.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// A record partially written by a crash is truncated on reopen.
+	path := filepath.Join(dir, entries[1].Name())
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{7, 0, 0, 0, 0, 0, 0, 0, 1})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	a, err = archive.New(dir, 4, noop.NewLogger[any]())
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), a.Next())
+	got, err = a.Get(5)
+	require.NoError(t, err)
+	require.Equal(t, testSidecars(5, 2), got)
+	require.NoError(t, a.Put(7, testSidecars(7, 1)))
+	require.True(t, a.Has(7))
+
+	// A corrupt payload fails its checksum.
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	_, err = a.Get(7)
+	require.ErrorIs(t, err, archive.ErrCorruptRecord)
+}
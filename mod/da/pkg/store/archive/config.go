@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+// Mode is the archival mode of the availability store.
+type Mode string
+
+const (
+	// ModeDisabled drops the blob sidecars past the data availability
+	// period.
+	ModeDisabled Mode = "disabled"
+	// ModeRetain keeps the blob sidecars past the data availability period
+	// in the availability store indefinitely.
+	ModeRetain Mode = "retain"
+	// ModeSegments moves the blob sidecars past the data availability
+	// period into compressed segment files.
+	ModeSegments Mode = "segments"
+)
+
+// defaultSegmentEpochs is the default number of epochs per segment.
+const defaultSegmentEpochs = 256
+
+// Config is the configuration for the archival of blob sidecars.
+type Config struct {
+	// Mode is the archival mode, one of "disabled", "retain" or "segments".
+	Mode Mode `mapstructure:"mode"`
+	// SegmentEpochs is the number of epochs of blob sidecars kept in each
+	// segment file.
+	SegmentEpochs uint64 `mapstructure:"segment-epochs"`
+}
+
+// DefaultConfig returns the default configuration for the archival of blob
+// sidecars.
+func DefaultConfig() Config {
+	return Config{
+		Mode:          ModeDisabled,
+		SegmentEpochs: defaultSegmentEpochs,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	switch c.Mode {
+	case "", ModeDisabled, ModeRetain:
+		return nil
+	case ModeSegments:
+		if c.SegmentEpochs == 0 {
+			return ErrZeroSegmentEpochs
+		}
+		return nil
+	default:
+		return errors.Wrapf(ErrUnknownMode, "%q", c.Mode)
+	}
+}
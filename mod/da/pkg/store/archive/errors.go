@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnknownMode is returned when the archival mode is unknown.
+	ErrUnknownMode = errors.New("unknown archival mode")
+
+	// ErrZeroSegmentEpochs is returned when segments of zero epochs are
+	// configured.
+	ErrZeroSegmentEpochs = errors.New("segment epochs must be positive")
+
+	// ErrNilSidecar is returned when an attempt is made to archive a nil
+	// sidecar.
+	ErrNilSidecar = errors.New("attempted to archive nil sidecar")
+
+	// ErrNotArchived is returned when the blob sidecars looked up are not in
+	// the archive.
+	ErrNotArchived = errors.New("blob sidecars not archived")
+
+	// ErrCorruptRecord is returned when a record of a segment fails its
+	// checksum.
+	ErrCorruptRecord = errors.New("corrupt archive record")
+)
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package archive
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// A segment is an append-only file of records, one per slot. A record is
+// laid out as:
+//
+//	slot          uint64
+//	count         uint32
+//	hashes        count * [32]byte (versioned hashes of the sidecars)
+//	length        uint32
+//	checksum      uint32 (crc32 of the payload)
+//	payload       length bytes (zstd compressed ssz of the sidecars)
+//
+// The header of a record is read without its payload to index the segment
+// when the archive is opened.
+const (
+	// segmentExt is the file extension of segments.
+	segmentExt = ".seg"
+	// slotSize is the size of the slot of a record.
+	slotSize = 8
+	// uint32Size is the size of the counts and lengths of a record.
+	uint32Size = 4
+	// hashSize is the size of a versioned hash.
+	hashSize = 32
+)
+
+// location is the location of the record of a slot.
+type location struct {
+	// segment is the first slot of the segment of the record.
+	segment uint64
+	// offset is the offset of the payload in the segment.
+	offset int64
+	// length is the length of the payload.
+	length uint32
+	// checksum is the checksum of the payload.
+	checksum uint32
+}
+
+// hashLocation is the location of the sidecar of a versioned hash.
+type hashLocation struct {
+	// slot is the slot of the sidecar.
+	slot uint64
+	// index is the index of the sidecar in the record of the slot.
+	index int
+}
+
+// record is the header of a record read from a segment.
+type record struct {
+	slot   uint64
+	hashes []common.ExecutionHash
+	location
+}
+
+// segmentName returns the name of the segment starting at the given slot.
+func segmentName(segment uint64) string {
+	return fmt.Sprintf("%020d%s", segment, segmentExt)
+}
+
+// parseSegmentName returns the first slot of the segment of the given name.
+func parseSegmentName(name string) (uint64, bool) {
+	if filepath.Ext(name) != segmentExt {
+		return 0, false
+	}
+	segment, err := strconv.ParseUint(
+		strings.TrimSuffix(name, segmentExt), 10, 64,
+	)
+	return segment, err == nil
+}
+
+// encodeRecord encodes the record of a slot with its compressed payload.
+func encodeRecord(
+	slot uint64, hashes []common.ExecutionHash, payload []byte,
+) []byte {
+	bz := make(
+		[]byte, 0,
+		slotSize+3*uint32Size+len(hashes)*hashSize+len(payload),
+	)
+	bz = binary.LittleEndian.AppendUint64(bz, slot)
+	//#nosec:G115 // a block has few sidecars.
+	bz = binary.LittleEndian.AppendUint32(bz, uint32(len(hashes)))
+	for _, hash := range hashes {
+		bz = append(bz, hash[:]...)
+	}
+	//#nosec:G115 // the sidecars of a block are far smaller than 4GiB.
+	bz = binary.LittleEndian.AppendUint32(bz, uint32(len(payload)))
+	bz = binary.LittleEndian.AppendUint32(bz, crc32.ChecksumIEEE(payload))
+	return append(bz, payload...)
+}
+
+// scanSegment reads the headers of the records of a segment. It returns the
+// offset past the last complete record, which is lower than the size of the
+// segment if a record was only partially written.
+func scanSegment(
+	f *os.File, segment uint64, size int64,
+) ([]record, int64, error) {
+	var (
+		records []record
+		offset  int64
+		fixed   = make([]byte, slotSize+uint32Size)
+		tail    = make([]byte, 2*uint32Size)
+	)
+	for offset < size {
+		r := record{location: location{segment: segment}}
+		pos := offset
+		if _, err := f.ReadAt(fixed, pos); err != nil {
+			return records, offset, ignoreEOF(err)
+		}
+		pos += int64(len(fixed))
+		r.slot = binary.LittleEndian.Uint64(fixed)
+		count := binary.LittleEndian.Uint32(fixed[slotSize:])
+
+		r.hashes = make([]common.ExecutionHash, count)
+		for i := range r.hashes {
+			if _, err := f.ReadAt(r.hashes[i][:], pos); err != nil {
+				return records, offset, ignoreEOF(err)
+			}
+			pos += hashSize
+		}
+
+		if _, err := f.ReadAt(tail, pos); err != nil {
+			return records, offset, ignoreEOF(err)
+		}
+		pos += int64(len(tail))
+		r.length = binary.LittleEndian.Uint32(tail)
+		r.checksum = binary.LittleEndian.Uint32(tail[uint32Size:])
+		r.offset = pos
+
+		if pos+int64(r.length) > size {
+			return records, offset, nil
+		}
+		offset = pos + int64(r.length)
+		records = append(records, r)
+	}
+	return records, offset, nil
+}
+
+// readPayload reads and checks the payload at the given location.
+func readPayload(f *os.File, loc location) ([]byte, error) {
+	payload := make([]byte, loc.length)
+	if _, err := f.ReadAt(payload, loc.offset); err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(payload) != loc.checksum {
+		return nil, errors.Wrapf(
+			ErrCorruptRecord, "segment %d offset %d", loc.segment, loc.offset,
+		)
+	}
+	return payload, nil
+}
+
+// ignoreEOF treats a record cut short by the end of the segment as a
+// partially written record rather than an error.
+func ignoreEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/store/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// DataColumnKeyPrefix is the prefix of the keys of the data column sidecars
+// of a slot in the DB.
+const DataColumnKeyPrefix = "data_column_"
+
+// PersistDataColumns stores the data column sidecars of a slot next to its
+// blob sidecars, so that they are pruned along with them. Unlike the blob
+// sidecars, the data columns are never archived since they can be recomputed
+// from the blobs.
+func (s *Store[BeaconBlockT]) PersistDataColumns(
+	slot math.Slot,
+	columns []*types.DataColumnSidecar,
+) error {
+	// Exit early if there are no columns to store.
+	if len(columns) == 0 {
+		return nil
+	}
+
+	// Skip the columns from outside the required DA period, unless the hot
+	// tier is retained.
+	if s.mode != archive.ModeRetain && !s.chainSpec.WithinDAPeriod(
+		columns[0].BeaconBlockHeader.GetSlot(), slot,
+	) {
+		return nil
+	}
+
+	for _, column := range columns {
+		if column == nil {
+			return ErrAttemptedToStoreNilSidecar
+		}
+		bz, err := column.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if err = s.IndexDB.Set(
+			slot.Unwrap(), dataColumnKey(column.Index), bz,
+		); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Info("Successfully stored all data column sidecars 🧱",
+		"slot", slot.Base10(), "num_columns", len(columns),
+	)
+	return nil
+}
+
+// GetDataColumnsFromStore returns the data column sidecars of the given
+// indices stored for a slot, skipping the ones not stored.
+func (s *Store[BeaconBlockT]) GetDataColumnsFromStore(
+	slot math.Slot,
+	indices []uint64,
+) ([]*types.DataColumnSidecar, error) {
+	columns := make([]*types.DataColumnSidecar, 0, len(indices))
+	for _, index := range indices {
+		found, err := s.IndexDB.Has(slot.Unwrap(), dataColumnKey(index))
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		bz, err := s.IndexDB.Get(slot.Unwrap(), dataColumnKey(index))
+		if err != nil {
+			return nil, err
+		}
+		column := new(types.DataColumnSidecar)
+		if err = column.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// dataColumnKey returns the key of the data column sidecar of an index.
+func dataColumnKey(index uint64) []byte {
+	return []byte(DataColumnKeyPrefix + strconv.FormatUint(index, 10))
+}
@@ -34,4 +34,12 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrVersionedHashNotFound is returned when no stored blob sidecar has
+	// the versioned hash looked up.
+	ErrVersionedHashNotFound = errors.New("versioned hash not found")
+
+	// ErrSidecarNotFound is returned when the blob sidecar of a commitment
+	// of a slot is not stored.
+	ErrSidecarNotFound = errors.New("blob sidecar not found")
 )
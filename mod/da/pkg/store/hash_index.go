@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// hashIndexEntrySize is the size of an entry of the versioned hash index,
+// holding the slot and the index of the sidecar.
+const hashIndexEntrySize = 16
+
+// LookupVersionedHash returns the slot and the index of the blob sidecar of
+// a versioned hash, looking up the archive for the slots no longer in the
+// hot tier.
+func (s *Store[BeaconBlockT]) LookupVersionedHash(
+	hash common.ExecutionHash,
+) (math.Slot, uint64, error) {
+	bz, err := s.hashes.Get(hashKey(hash))
+	if err == nil && len(bz) == hashIndexEntrySize {
+		return math.Slot(binary.LittleEndian.Uint64(bz)),
+			binary.LittleEndian.Uint64(bz[8:]), nil
+	}
+
+	if s.archive != nil {
+		if sidecar, slot, aErr := s.archive.GetByVersionedHash(
+			hash,
+		); aErr == nil {
+			return math.Slot(slot), sidecar.GetIndex(), nil
+		}
+	}
+	return 0, 0, errors.Wrapf(ErrVersionedHashNotFound, "%s", hash)
+}
+
+// indexSidecars indexes the blob sidecars of a slot by versioned hash.
+func (s *Store[BeaconBlockT]) indexSidecars(
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) error {
+	for _, sidecar := range sidecars.Sidecars {
+		bz := make([]byte, 0, hashIndexEntrySize)
+		bz = binary.LittleEndian.AppendUint64(bz, slot.Unwrap())
+		bz = binary.LittleEndian.AppendUint64(bz, sidecar.GetIndex())
+		if err := s.hashes.Set(
+			hashKey(sidecar.KzgCommitment.ToVersionedHash()), bz,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unindexCommitments removes the blob sidecars of the commitments of a slot
+// from the versioned hash index. The entries of the blobs included again by
+// a later slot point to that slot and are kept.
+func (s *Store[BeaconBlockT]) unindexCommitments(
+	slot math.Slot,
+	commitments [][]byte,
+) error {
+	for _, commitment := range commitments {
+		key := hashKey(eip4844.KZGCommitment(commitment).ToVersionedHash())
+		bz, err := s.hashes.Get(key)
+		if err != nil || len(bz) != hashIndexEntrySize {
+			continue
+		}
+		if math.Slot(binary.LittleEndian.Uint64(bz)) != slot {
+			continue
+		}
+		if err = s.hashes.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reindex indexes by versioned hash the blob sidecars of the given slots of
+// the hot tier which are missing from the index, such as the sidecars
+// stored before the index was introduced. It returns the number of
+// sidecars indexed.
+func (s *Store[BeaconBlockT]) Reindex(slots []uint64) (int, error) {
+	var indexed int
+	for _, slot := range slots {
+		commitments, found, err := s.getCommitments(math.Slot(slot))
+		if err != nil {
+			return indexed, err
+		}
+		if !found {
+			continue
+		}
+
+		missing := &types.BlobSidecars{}
+		for _, commitment := range commitments {
+			if _, err = s.hashes.Get(hashKey(
+				eip4844.KZGCommitment(commitment).ToVersionedHash(),
+			)); err == nil {
+				continue
+			}
+			sidecar, sErr := s.GetSidecar(math.Slot(slot), commitment)
+			if sErr != nil {
+				return indexed, sErr
+			}
+			missing.Sidecars = append(missing.Sidecars, sidecar)
+		}
+		if err = s.indexSidecars(math.Slot(slot), missing); err != nil {
+			return indexed, err
+		}
+		indexed += len(missing.Sidecars)
+	}
+	return indexed, nil
+}
+
+// hashKey returns the key of a versioned hash in the index, spreading the
+// keys over directories by their first byte past the version.
+func hashKey(hash common.ExecutionHash) []byte {
+	return []byte(fmt.Sprintf("%02x/%s", hash[1], hash.Hex()))
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/berachain/beacon-kit/mod/da/pkg/store/archive"
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -38,23 +39,43 @@ const SlotCommitmentsKey = "slot_commitments"
 type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	// IndexDB is a basic database interface.
 	IndexDB
+	// mode is the archival mode of the blob sidecars past the data
+	// availability period.
+	mode archive.Mode
+	// archive is the cold tier of the store, set in the segments mode.
+	archive *archive.Archive
+	// hashes indexes the blob sidecars of the hot tier by versioned hash.
+	hashes HashIndexDB
+	// evicted is the slot up to which the hot tier was evicted.
+	evicted uint64
 	// logger is used for logging.
 	logger log.Logger
 	// chainSpec contains the chain specification.
 	chainSpec common.ChainSpec
 }
 
-// New creates a new instance of the AvailabilityStore.
+// New creates a new instance of the AvailabilityStore. The cold archive is
+// only used in the segments mode.
 func New[BeaconBlockT BeaconBlockBody](
 	db IndexDB,
+	hashes HashIndexDB,
+	mode archive.Mode,
+	cold *archive.Archive,
 	logger log.Logger,
 	chainSpec common.ChainSpec,
 ) *Store[BeaconBlockT] {
-	return &Store[BeaconBlockT]{
+	s := &Store[BeaconBlockT]{
 		IndexDB:   db,
+		hashes:    hashes,
+		mode:      mode,
 		chainSpec: chainSpec,
 		logger:    logger,
 	}
+	if mode == archive.ModeSegments {
+		s.archive = cold
+		s.evicted = cold.Next()
+	}
+	return s
 }
 
 // IsDataAvailable ensures that all blobs referenced in the block are
@@ -67,7 +88,13 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	for _, commitment := range body.GetBlobKzgCommitments() {
 		// Check if the block data is available in the IndexDB
 		blockData, err := s.IndexDB.Has(slot.Unwrap(), commitment[:])
-		if err != nil || !blockData {
+		if err == nil && blockData {
+			continue
+		}
+		// Fall back to the archive for the slots already archived.
+		if s.archive == nil || !s.archive.HasVersionedHash(
+			slot.Unwrap(), commitment.ToVersionedHash(),
+		) {
 			return false
 		}
 	}
@@ -86,7 +113,8 @@ func (s *Store[BeaconBlockT]) Persist(
 	}
 
 	// Check to see if we are required to store the sidecar anymore, if
-	// this sidecar is from outside the required DA period, we can skip it.
+	// this sidecar is from outside the required DA period, we can skip it,
+	// unless it is archived.
 	if !s.chainSpec.WithinDAPeriod(
 		// slot in which the sidecar was included.
 		// (Safe to assume all sidecars are in same slot at this point).
@@ -94,7 +122,14 @@ func (s *Store[BeaconBlockT]) Persist(
 		// current slot
 		slot,
 	) {
-		return nil
+		switch s.mode {
+		case archive.ModeRetain:
+			// Kept in the hot tier below.
+		case archive.ModeSegments:
+			return s.archive.Put(slot.Unwrap(), sidecars)
+		default:
+			return nil
+		}
 	}
 
 	// Create error channel and wait group for parallel processing
@@ -162,30 +197,148 @@ func (s *Store[BeaconBlockT]) Persist(
 		return err
 	}
 
+	// Index the sidecars by versioned hash once they are all stored.
+	if err := s.indexSidecars(slot, sidecars); err != nil {
+		return err
+	}
+
 	s.logger.Info("Successfully stored all blob sidecars 🚗",
 		"slot", slot.Base10(), "num_sidecars", sidecars.Len(),
 	)
 	return nil
 }
 
-// GetBlobsFromStore returns all blob sidecars for a given slot.
+// GetBlobsFromStore returns all blob sidecars for a given slot, reading
+// from the archive the slots no longer in the hot tier.
 func (s *Store[BeaconBlockT]) GetBlobsFromStore(
 	slot math.Slot,
 ) (*types.BlobSidecars, error) {
-	// Get the commitment list for this slot
+	sidecars, found, err := s.getFromHot(slot)
+	if err != nil || found {
+		return sidecars, err
+	}
+	if s.archive != nil && s.archive.Has(slot.Unwrap()) {
+		return s.archive.Get(slot.Unwrap())
+	}
+	return sidecars, nil
+}
+
+// SlotCommitments returns the commitments of the blob sidecars of a slot in
+// the hot tier, and whether the slot was found in it.
+func (s *Store[BeaconBlockT]) SlotCommitments(
+	slot math.Slot,
+) ([][]byte, bool, error) {
+	return s.getCommitments(slot)
+}
+
+// GetSidecar returns the blob sidecar of the given commitment of a slot in
+// the hot tier, failing with ErrSidecarNotFound if it is not stored.
+func (s *Store[BeaconBlockT]) GetSidecar(
+	slot math.Slot,
+	commitment []byte,
+) (*types.BlobSidecar, error) {
+	found, err := s.IndexDB.Has(slot.Unwrap(), commitment)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrSidecarNotFound
+	}
+	bz, err := s.IndexDB.Get(slot.Unwrap(), commitment)
+	if err != nil {
+		return nil, err
+	}
+	sidecar := new(types.BlobSidecar)
+	if err = sidecar.UnmarshalSSZ(bz); err != nil {
+		return nil, err
+	}
+	return sidecar, nil
+}
+
+// Prune removes the blob sidecars of the slots in [start, end) from the hot
+// tier, moving them to the archive first in the segments mode. Nothing is
+// pruned in the retain mode.
+func (s *Store[BeaconBlockT]) Prune(start, end uint64) error {
+	if s.mode == archive.ModeRetain {
+		return nil
+	}
+	if err := s.evictRange(start, end); err != nil {
+		return err
+	}
+	return s.IndexDB.Prune(start, end)
+}
+
+// evictRange removes the blob sidecars of the slots in [start, end) of the
+// hot tier from the versioned hash index, moving them to the archive first
+// in the segments mode. It is only called by the pruner.
+func (s *Store[BeaconBlockT]) evictRange(start, end uint64) error {
+	var archived int
+	for slot := max(start, s.evicted); slot < end; slot++ {
+		commitments, found, err := s.getCommitments(math.Slot(slot))
+		if err != nil {
+			return err
+		}
+		if !found || len(commitments) == 0 {
+			continue
+		}
+
+		if s.archive != nil {
+			var sidecars *types.BlobSidecars
+			if sidecars, _, err = s.getFromHot(math.Slot(slot)); err != nil {
+				return err
+			}
+			if err = s.archive.Put(slot, sidecars); err != nil {
+				return err
+			}
+			archived++
+		}
+		if err = s.unindexCommitments(math.Slot(slot), commitments); err != nil {
+			return err
+		}
+	}
+	s.evicted = max(s.evicted, end)
+
+	if archived > 0 {
+		s.logger.Info("Archived blob sidecars 🧊",
+			"start", start, "end", end, "num_slots", archived,
+		)
+	}
+	return nil
+}
+
+// getCommitments returns the commitments of the blob sidecars of a slot in
+// the hot tier, and whether the slot was found in it.
+func (s *Store[BeaconBlockT]) getCommitments(
+	slot math.Slot,
+) ([][]byte, bool, error) {
 	serializedCommitments, err := s.IndexDB.Get(
 		slot.Unwrap(),
 		[]byte(SlotCommitmentsKey),
 	)
 	if err != nil {
-		return &types.BlobSidecars{Sidecars: make([]*types.BlobSidecar, 0)}, nil // Return empty if not found
+		return nil, false, nil
 	}
 
 	slotCommitments := &types.SlotCommitments{}
 	if err = slotCommitments.UnmarshalSSZ(serializedCommitments); err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	return slotCommitments.Commitments, true, nil
+}
+
+// getFromHot returns the blob sidecars of a slot in the hot tier, and
+// whether the slot was found in it.
+func (s *Store[BeaconBlockT]) getFromHot(
+	slot math.Slot,
+) (*types.BlobSidecars, bool, error) {
+	// Get the commitment list for this slot
+	commitments, found, err := s.getCommitments(slot)
+	if err != nil {
+		return nil, false, err
+	}
+	if !found {
+		return &types.BlobSidecars{Sidecars: make([]*types.BlobSidecar, 0)}, false, nil // Return empty if not found
 	}
-	commitments := slotCommitments.Commitments
 
 	// Create error channel and wait group for parallel processing
 	errChan := make(chan error, len(commitments))
@@ -228,9 +381,9 @@ func (s *Store[BeaconBlockT]) GetBlobsFromStore(
 	// Check for any errors
 	for err := range errChan {
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 	}
 
-	return &types.BlobSidecars{Sidecars: sidecars}, nil
+	return &types.BlobSidecars{Sidecars: sidecars}, true, nil
 }
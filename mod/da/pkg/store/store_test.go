@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store/archive"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotFound = errors.New("not found")
+
+// memDB is an in-memory IndexDB.
+type memDB map[string][]byte
+
+func (db memDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db[fmt.Sprintf("%d/%x", index, key)]
+	return ok, nil
+}
+
+func (db memDB) Set(index uint64, key []byte, value []byte) error {
+	db[fmt.Sprintf("%d/%x", index, key)] = value
+	return nil
+}
+
+func (db memDB) Get(index uint64, key []byte) ([]byte, error) {
+	if value, ok := db[fmt.Sprintf("%d/%x", index, key)]; ok {
+		return value, nil
+	}
+	return nil, errNotFound
+}
+
+func (db memDB) Prune(start uint64, end uint64) error {
+	for ; start < end; start++ {
+		prefix := fmt.Sprintf("%d/", start)
+		for key := range db {
+			if len(key) > len(prefix) && key[:len(prefix)] == prefix {
+				delete(db, key)
+			}
+		}
+	}
+	return nil
+}
+
+// memKV is an in-memory HashIndexDB.
+type memKV map[string][]byte
+
+func (kv memKV) Get(key []byte) ([]byte, error) {
+	if value, ok := kv[string(key)]; ok {
+		return value, nil
+	}
+	return nil, errNotFound
+}
+
+func (kv memKV) Set(key []byte, value []byte) error {
+	kv[string(key)] = value
+	return nil
+}
+
+func (kv memKV) Delete(key []byte) error {
+	delete(kv, string(key))
+	return nil
+}
+
+func testSidecars(slot uint64, n int) *types.BlobSidecars {
+	sidecars := &types.BlobSidecars{}
+	for i := range n {
+		sidecars.Sidecars = append(sidecars.Sidecars, types.BuildBlobSidecar(
+			math.U64(i),
+			&ctypes.BeaconBlockHeader{Slot: math.Slot(slot)},
+			&eip4844.Blob{byte(slot), byte(i)},
+			eip4844.KZGCommitment{byte(slot), byte(i)},
+			[48]byte{},
+			make([]common.Root, 8),
+		))
+	}
+	return sidecars
+}
+
+func TestStoreVersionedHashIndex(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    1,
+			MinEpochsForBlobsSidecarsRequest: 4,
+			MaxBlobsPerBlock:                 6,
+		},
+	)
+	cold, err := archive.New(t.TempDir(), 32, noop.NewLogger[any]())
+	require.NoError(t, err)
+
+	for _, mode := range []archive.Mode{
+		archive.ModeDisabled, archive.ModeSegments,
+	} {
+		t.Run(string(mode), func(t *testing.T) {
+			s := store.New[*ctypes.BeaconBlockBody](
+				memDB{}, memKV{}, mode, cold, noop.NewLogger[any](), cs,
+			)
+			sidecars := testSidecars(10, 2)
+			require.NoError(t, s.Persist(10, sidecars))
+
+			hash := common.ExecutionHash(
+				sidecars.Get(1).KzgCommitment.ToVersionedHash(),
+			)
+			slot, index, err := s.LookupVersionedHash(hash)
+			require.NoError(t, err)
+			require.Equal(t, math.Slot(10), slot)
+			require.Equal(t, uint64(1), index)
+
+			// The index follows the sidecars out of the hot tier.
+			require.NoError(t, s.Prune(0, 11))
+			slot, index, err = s.LookupVersionedHash(hash)
+			if mode == archive.ModeDisabled {
+				require.ErrorIs(t, err, store.ErrVersionedHashNotFound)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, math.Slot(10), slot)
+			require.Equal(t, uint64(1), index)
+			got, err := s.GetBlobsFromStore(10)
+			require.NoError(t, err)
+			require.Equal(t, sidecars, got)
+		})
+	}
+}
+
+func TestStoreVersionedHashIndexOfBlobsIncludedAgain(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    1,
+			MinEpochsForBlobsSidecarsRequest: 4,
+			MaxBlobsPerBlock:                 6,
+		},
+	)
+	s := store.New[*ctypes.BeaconBlockBody](
+		memDB{}, memKV{}, archive.ModeDisabled, nil,
+		noop.NewLogger[any](), cs,
+	)
+
+	// The blob of slot 10 is included again by slot 11.
+	sidecars := testSidecars(10, 1)
+	again := testSidecars(11, 2)
+	again.Sidecars[1].KzgCommitment = sidecars.Get(0).KzgCommitment
+	require.NoError(t, s.Persist(10, sidecars))
+	require.NoError(t, s.Persist(11, again))
+
+	hash := common.ExecutionHash(
+		sidecars.Get(0).KzgCommitment.ToVersionedHash(),
+	)
+	require.NoError(t, s.Prune(0, 11))
+	slot, index, err := s.LookupVersionedHash(hash)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(11), slot)
+	require.Equal(t, uint64(1), index)
+
+	require.NoError(t, s.Prune(11, 12))
+	_, _, err = s.LookupVersionedHash(hash)
+	require.ErrorIs(t, err, store.ErrVersionedHashNotFound)
+}
+
+func TestStoreReindex(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    1,
+			MinEpochsForBlobsSidecarsRequest: 4,
+			MaxBlobsPerBlock:                 6,
+		},
+	)
+	db := memDB{}
+	sidecars := testSidecars(10, 2)
+	require.NoError(t, store.New[*ctypes.BeaconBlockBody](
+		db, memKV{}, archive.ModeDisabled, nil, noop.NewLogger[any](), cs,
+	).Persist(10, sidecars))
+
+	// The sidecars were stored before the index existed.
+	s := store.New[*ctypes.BeaconBlockBody](
+		db, memKV{}, archive.ModeDisabled, nil, noop.NewLogger[any](), cs,
+	)
+	hash := common.ExecutionHash(
+		sidecars.Get(1).KzgCommitment.ToVersionedHash(),
+	)
+	_, _, err := s.LookupVersionedHash(hash)
+	require.ErrorIs(t, err, store.ErrVersionedHashNotFound)
+
+	n, err := s.Reindex([]uint64{9, 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	slot, index, err := s.LookupVersionedHash(hash)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(10), slot)
+	require.Equal(t, uint64(1), index)
+
+	// Indexed sidecars are not indexed again.
+	n, err = s.Reindex([]uint64{10})
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestStoreDataColumns(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    1,
+			MinEpochsForBlobsSidecarsRequest: 4,
+			MaxBlobsPerBlock:                 6,
+		},
+	)
+	s := store.New[*ctypes.BeaconBlockBody](
+		memDB{}, memKV{}, archive.ModeDisabled, nil,
+		noop.NewLogger[any](), cs,
+	)
+
+	columns := make([]*types.DataColumnSidecar, 4)
+	for i := range columns {
+		columns[i] = types.BuildDataColumnSidecar(
+			uint64(i),
+			&ctypes.BeaconBlockHeader{Slot: 10},
+			[]*eip4844.Cell{{byte(i)}},
+			[]eip4844.KZGCommitment{{1}},
+			[]eip4844.KZGProof{{byte(i)}},
+			make([]common.Root, types.KZGCommitmentsInclusionProofDepth),
+		)
+	}
+	require.NoError(t, s.PersistDataColumns(10, columns))
+
+	got, err := s.GetDataColumnsFromStore(10, []uint64{3, 1, 100})
+	require.NoError(t, err)
+	require.Equal(t, []*types.DataColumnSidecar{columns[3], columns[1]}, got)
+
+	// The columns are pruned along with the slot.
+	require.NoError(t, s.Prune(0, 11))
+	got, err = s.GetDataColumnsFromStore(10, []uint64{3, 1})
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
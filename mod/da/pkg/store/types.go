@@ -44,6 +44,14 @@ type IndexDB interface {
 	Prune(start uint64, end uint64) error
 }
 
+// HashIndexDB is a key-value database indexing the blob sidecars by
+// versioned hash.
+type HashIndexDB interface {
+	Get(key []byte) ([]byte, error)
+	Set(key []byte, value []byte) error
+	Delete(key []byte) error
+}
+
 // BeaconBlockBody is the body of a beacon block.
 type BeaconBlockBody interface {
 	// GetBlobKzgCommitments returns the KZG commitments for the blob.
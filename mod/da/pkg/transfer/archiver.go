@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transfer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Archiver exports the blob sidecars of the availability store into
+// archives, and imports them into the availability store of another node.
+// Archives are directories of SSZ encoded blob sidecars, one file per slot,
+// described by a manifest.
+type Archiver struct {
+	// store is the availability store of the node.
+	store AvailabilityStore
+	// blocks is the block store the imported blob sidecars are checked
+	// against.
+	blocks BlockStore
+	// verifier verifies the proofs of the imported blob sidecars.
+	verifier Verifier
+	// chainSpec defines the specifications of the blockchain.
+	chainSpec ChainSpec
+	// logger is used to log the progress of the transfers.
+	logger log.Logger
+}
+
+// New creates a new archiver.
+func New(
+	store AvailabilityStore,
+	blocks BlockStore,
+	verifier Verifier,
+	chainSpec ChainSpec,
+	logger log.Logger,
+) *Archiver {
+	return &Archiver{
+		store:     store,
+		blocks:    blocks,
+		verifier:  verifier,
+		chainSpec: chainSpec,
+		logger:    logger,
+	}
+}
+
+// ExportBlobs writes the blob sidecars of the slots in [from, to] into an
+// archive in the given directory, which must not hold one already. The
+// manifest is written last, so an interrupted export has none.
+func (a *Archiver) ExportBlobs(
+	ctx context.Context,
+	dir string,
+	from, to math.Slot,
+) (*Manifest, error) {
+	if from > to {
+		return nil, errors.Wrapf(ErrInvalidSlotRange, "%d > %d", from, to)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, ManifestName)); err == nil {
+		return nil, errors.Wrapf(ErrArchiveExists, "%s", dir)
+	}
+
+	m := &Manifest{
+		Version:  ManifestVersion,
+		ChainID:  a.chainSpec.DepositEth1ChainID(),
+		FromSlot: from.Unwrap(),
+		ToSlot:   to.Unwrap(),
+		Entries:  make([]Entry, 0),
+	}
+	for slot := from; slot <= to; slot++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		sidecars, err := a.store.GetBlobsFromStore(slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "slot %d", slot)
+		}
+		if sidecars.IsNil() || sidecars.Len() == 0 {
+			continue
+		}
+		entry, err := a.exportSlot(dir, slot, sidecars)
+		if err != nil {
+			return nil, errors.Wrapf(err, "slot %d", slot)
+		}
+		m.Entries = append(m.Entries, entry)
+	}
+
+	if err := writeManifest(dir, m); err != nil {
+		return nil, err
+	}
+	a.logger.Info("Exported blob sidecars 📦",
+		"dir", dir, "from", from.Base10(), "to", to.Base10(),
+		"num_slots", len(m.Entries), "num_sidecars", m.NumSidecars(),
+	)
+	return m, nil
+}
+
+// exportSlot writes the blob sidecars of a slot into the archive.
+func (a *Archiver) exportSlot(
+	dir string,
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) (Entry, error) {
+	if sidecars.Sidecars[0] == nil {
+		return Entry{}, types.ErrAttemptedToVerifyNilSidecar
+	}
+	bz, err := sidecars.MarshalSSZ()
+	if err != nil {
+		return Entry{}, err
+	}
+	name := sidecarsFileName(slot.Unwrap())
+	//#nosec:G306 // blob sidecars are public.
+	if err = os.WriteFile(filepath.Join(dir, name), bz, 0o644); err != nil {
+		return Entry{}, err
+	}
+	sum := sha256.Sum256(bz)
+	return Entry{
+		Slot:      slot.Unwrap(),
+		BlockRoot: sidecars.Sidecars[0].BeaconBlockHeader.HashTreeRoot(),
+		Sidecars:  sidecars.Len(),
+		File:      name,
+		SHA256:    hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// ImportBlobs imports the blob sidecars of the archive in the given
+// directory into the availability store. The sidecars of every slot are
+// checked against the manifest, their block header against the block store
+// and their inclusion and KZG proofs verified before they are stored, and
+// the import stops at the first slot failing. Importing an archive again is
+// harmless.
+func (a *Archiver) ImportBlobs(
+	ctx context.Context,
+	dir string,
+) (*Manifest, error) {
+	m, err := readManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if chainID := a.chainSpec.DepositEth1ChainID(); m.ChainID != chainID {
+		return nil, errors.Wrapf(
+			ErrChainIDMismatch, "archive: %d, node: %d", m.ChainID, chainID,
+		)
+	}
+
+	for _, entry := range m.Entries {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err = a.importSlot(dir, entry); err != nil {
+			return nil, errors.Wrapf(err, "slot %d", entry.Slot)
+		}
+	}
+	a.logger.Info("Imported blob sidecars 📦",
+		"dir", dir, "from", m.FromSlot, "to", m.ToSlot,
+		"num_slots", len(m.Entries), "num_sidecars", m.NumSidecars(),
+	)
+	return m, nil
+}
+
+// importSlot verifies and stores the blob sidecars of a slot of the archive.
+func (a *Archiver) importSlot(dir string, entry Entry) error {
+	// Only the base name is used, so that a manifest cannot point outside of
+	// the archive.
+	bz, err := os.ReadFile(filepath.Join(dir, filepath.Base(entry.File)))
+	if err != nil {
+		return err
+	}
+	if sum := sha256.Sum256(bz); hex.EncodeToString(sum[:]) != entry.SHA256 {
+		return errors.Wrapf(ErrChecksumMismatch, "%s", entry.File)
+	}
+
+	sidecars := new(types.BlobSidecars)
+	if err = sidecars.UnmarshalSSZ(bz); err != nil {
+		return err
+	}
+	if sidecars.Len() != entry.Sidecars {
+		return errors.Wrapf(
+			ErrManifestMismatch, "sidecars: %d, manifest: %d",
+			sidecars.Len(), entry.Sidecars,
+		)
+	}
+	for _, sidecar := range sidecars.Sidecars {
+		if sidecar == nil {
+			return types.ErrAttemptedToVerifyNilSidecar
+		}
+		if sidecar.BeaconBlockHeader.GetSlot().Unwrap() != entry.Slot ||
+			sidecar.BeaconBlockHeader.HashTreeRoot() != entry.BlockRoot {
+			return errors.Wrapf(
+				ErrManifestMismatch, "sidecar %d", sidecar.Index,
+			)
+		}
+	}
+
+	// The proofs tie the blobs to the header the sidecars carry, which must
+	// be the one of the block of the slot.
+	slot, err := a.blocks.GetSlotByBlockRoot(entry.BlockRoot)
+	if err != nil {
+		return errors.Wrapf(ErrUnknownBlock, "%s: %v", entry.BlockRoot, err)
+	}
+	if slot.Unwrap() != entry.Slot {
+		return errors.Wrapf(
+			ErrUnknownBlock, "%s is at slot %d", entry.BlockRoot, slot,
+		)
+	}
+	if err = a.verifier.VerifySidecars(sidecars); err != nil {
+		return err
+	}
+	return a.store.Persist(math.Slot(entry.Slot), sidecars)
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transfer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	kzgnoop "github.com/berachain/beacon-kit/mod/da/pkg/kzg/noop"
+	"github.com/berachain/beacon-kit/mod/da/pkg/transfer"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSpec is the chain spec of the tests, with Deneb active at every slot.
+type mockSpec struct {
+	chainID uint64
+}
+
+func (m mockSpec) DepositEth1ChainID() uint64 {
+	return m.chainID
+}
+
+func (mockSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return version.Deneb
+}
+
+// noopSink is a TelemetrySink discarding the metrics.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string)        {}
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// memStore is an in-memory availability store.
+type memStore map[math.Slot]*types.BlobSidecars
+
+func (s memStore) GetBlobsFromStore(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	if sidecars, ok := s[slot]; ok {
+		return sidecars, nil
+	}
+	return &types.BlobSidecars{}, nil
+}
+
+func (s memStore) Persist(slot math.Slot, sidecars *types.BlobSidecars) error {
+	s[slot] = sidecars
+	return nil
+}
+
+// memBlocks is an in-memory block store.
+type memBlocks map[common.Root]math.Slot
+
+func (b memBlocks) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
+	if slot, ok := b[root]; ok {
+		return slot, nil
+	}
+	return 0, errors.New("block not found")
+}
+
+// blocksOf returns the block store of the blocks of the given sidecars.
+func blocksOf(store memStore) memBlocks {
+	blocks := memBlocks{}
+	for slot, sidecars := range store {
+		blocks[sidecars.Sidecars[0].BeaconBlockHeader.HashTreeRoot()] = slot
+	}
+	return blocks
+}
+
+// buildSidecars builds the blob sidecars of a block of the given slot.
+func buildSidecars(t *testing.T, slot math.Slot, n int) *types.BlobSidecars {
+	t.Helper()
+	body := (&ctypes.BeaconBlockBody{}).Empty(version.Deneb)
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{}
+	for i := range n {
+		commitment := eip4844.KZGCommitment{byte(slot), byte(i + 1)}
+		body.BlobKzgCommitments = append(body.BlobKzgCommitments, commitment)
+		bundle.Commitments = append(bundle.Commitments, commitment)
+		bundle.Proofs = append(bundle.Proofs, eip4844.KZGProof{})
+		bundle.Blobs = append(bundle.Blobs, &eip4844.Blob{})
+	}
+	sidecars, err := blob.NewSidecarFactory[
+		*ctypes.BeaconBlock,
+		*ctypes.BeaconBlockBody,
+		*ctypes.BeaconBlockHeader,
+	](mockSpec{}, kzgnoop.NewVerifier(), noopSink{}).BuildSidecars(
+		&ctypes.BeaconBlock{Slot: slot, Body: body}, bundle,
+	)
+	require.NoError(t, err)
+	return sidecars
+}
+
+func newArchiver(
+	store memStore,
+	blocks memBlocks,
+	chainID uint64,
+) *transfer.Archiver {
+	return transfer.New(
+		store,
+		blocks,
+		blob.NewVerifier[
+			*ctypes.BeaconBlockHeader, *types.BlobSidecar, *types.BlobSidecars,
+		](kzgnoop.NewVerifier(), mockSpec{}, noopSink{}),
+		mockSpec{chainID: chainID},
+		noop.NewLogger[any](),
+	)
+}
+
+func TestExportImport(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		dir    = t.TempDir()
+		source = memStore{3: buildSidecars(t, 3, 2), 5: buildSidecars(t, 5, 1)}
+		blocks = blocksOf(source)
+	)
+
+	m, err := newArchiver(source, blocks, 80087).ExportBlobs(ctx, dir, 2, 6)
+	require.NoError(t, err)
+	require.Len(t, m.Entries, 2)
+	require.Equal(t, 3, m.NumSidecars())
+	_, err = newArchiver(source, blocks, 80087).ExportBlobs(ctx, dir, 2, 6)
+	require.ErrorIs(t, err, transfer.ErrArchiveExists)
+
+	// The sidecars are imported as exported.
+	target := memStore{}
+	_, err = newArchiver(target, blocks, 80087).ImportBlobs(ctx, dir)
+	require.NoError(t, err)
+	require.Equal(t, source, target)
+
+	// Sidecars of blocks unknown to the block store are rejected, as well
+	// as those of a block of another slot.
+	_, err = newArchiver(memStore{}, memBlocks{}, 80087).ImportBlobs(ctx, dir)
+	require.ErrorIs(t, err, transfer.ErrUnknownBlock)
+	_, err = newArchiver(memStore{}, memBlocks{
+		m.Entries[0].BlockRoot: 5,
+	}, 80087).ImportBlobs(ctx, dir)
+	require.ErrorIs(t, err, transfer.ErrUnknownBlock)
+
+	// Archives of another chain are rejected.
+	_, err = newArchiver(memStore{}, blocks, 1).ImportBlobs(ctx, dir)
+	require.ErrorIs(t, err, transfer.ErrChainIDMismatch)
+
+	// Files altered after the export are rejected.
+	path := filepath.Join(dir, m.Entries[1].File)
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 1
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	_, err = newArchiver(memStore{}, blocks, 80087).ImportBlobs(ctx, dir)
+	require.ErrorIs(t, err, transfer.ErrChecksumMismatch)
+
+	// Sidecars failing their proofs are rejected, even with a consistent
+	// manifest.
+	source[3].Sidecars[1].KzgCommitment = eip4844.KZGCommitment{9}
+	dir = t.TempDir()
+	_, err = newArchiver(source, blocks, 80087).ExportBlobs(ctx, dir, 3, 3)
+	require.NoError(t, err)
+	_, err = newArchiver(memStore{}, blocks, 80087).ImportBlobs(ctx, dir)
+	require.ErrorIs(t, err, types.ErrInvalidInclusionProof)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transfer
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidSlotRange is returned when the first slot to export is past
+	// the last one.
+	ErrInvalidSlotRange = errors.New("invalid slot range")
+
+	// ErrArchiveExists is returned when exporting into a directory that
+	// already holds an archive.
+	ErrArchiveExists = errors.New("blob sidecar archive already exists")
+
+	// ErrUnsupportedVersion is returned when the manifest of an archive has
+	// an unknown version.
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+
+	// ErrChainIDMismatch is returned when importing an archive exported from
+	// another chain.
+	ErrChainIDMismatch = errors.New("archive exported from another chain")
+
+	// ErrChecksumMismatch is returned when a file of an archive does not
+	// match the checksum of its manifest.
+	ErrChecksumMismatch = errors.New("archive file checksum mismatch")
+
+	// ErrManifestMismatch is returned when the blob sidecars of a file of an
+	// archive do not match its manifest entry.
+	ErrManifestMismatch = errors.New(
+		"blob sidecars do not match the archive manifest",
+	)
+
+	// ErrUnknownBlock is returned when the blob sidecars of a slot of an
+	// archive do not belong to the block of the slot in the block store.
+	ErrUnknownBlock = errors.New(
+		"blob sidecars of a block unknown to the block store",
+	)
+)
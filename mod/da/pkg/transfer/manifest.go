@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+const (
+	// ManifestName is the name of the manifest file of an archive.
+	ManifestName = "manifest.json"
+	// ManifestVersion is the version of the archives written by this
+	// package.
+	ManifestVersion uint32 = 1
+)
+
+// Manifest describes the blob sidecars of an archive, one SSZ encoded file
+// of blob sidecars per slot holding any.
+type Manifest struct {
+	// Version is the version of the archive.
+	Version uint32 `json:"version"`
+	// ChainID is the chain ID of the execution chain the sidecars are from.
+	ChainID uint64 `json:"chain_id"`
+	// FromSlot is the first slot of the exported range.
+	FromSlot uint64 `json:"from_slot"`
+	// ToSlot is the last slot of the exported range.
+	ToSlot uint64 `json:"to_slot"`
+	// Entries are the slots of the range holding blob sidecars, in
+	// ascending order.
+	Entries []Entry `json:"entries"`
+}
+
+// Entry describes the blob sidecars of a slot of an archive.
+type Entry struct {
+	// Slot is the slot of the blob sidecars.
+	Slot uint64 `json:"slot"`
+	// BlockRoot is the root of the beacon block header of the sidecars.
+	BlockRoot common.Root `json:"block_root"`
+	// Sidecars is the number of blob sidecars of the slot.
+	Sidecars int `json:"sidecars"`
+	// File is the name of the file of the blob sidecars in the archive.
+	File string `json:"file"`
+	// SHA256 is the hex encoded SHA-256 checksum of the file.
+	SHA256 string `json:"sha256"`
+}
+
+// NumSidecars returns the number of blob sidecars of the archive.
+func (m *Manifest) NumSidecars() int {
+	var n int
+	for _, e := range m.Entries {
+		n += e.Sidecars
+	}
+	return n
+}
+
+// sidecarsFileName returns the name of the file of the blob sidecars of a
+// slot.
+func sidecarsFileName(slot uint64) string {
+	return fmt.Sprintf("%d.ssz", slot)
+}
+
+// readManifest reads the manifest of the archive in the given directory.
+func readManifest(dir string) (*Manifest, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, ManifestName))
+	if err != nil {
+		return nil, err
+	}
+	m := new(Manifest)
+	if err = json.Unmarshal(bz, m); err != nil {
+		return nil, err
+	}
+	if m.Version != ManifestVersion {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "%d", m.Version)
+	}
+	return m, nil
+}
+
+// writeManifest writes the manifest of the archive in the given directory,
+// through a temporary file so that a partial export has no manifest.
+func writeManifest(dir string, m *Manifest) error {
+	bz, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, ManifestName+".tmp")
+	//#nosec:G306 // blob sidecars are public.
+	if err = os.WriteFile(tmp, bz, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, ManifestName))
+}
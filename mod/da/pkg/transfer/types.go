@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transfer
+
+import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// AvailabilityStore is the store the blob sidecars are exported from and
+// imported into.
+type AvailabilityStore interface {
+	// GetBlobsFromStore returns the blob sidecars of a slot.
+	GetBlobsFromStore(slot math.Slot) (*types.BlobSidecars, error)
+	// Persist stores the blob sidecars of a slot.
+	Persist(slot math.Slot, sidecars *types.BlobSidecars) error
+}
+
+// BlockStore is the store of the blocks known to the node, the imported blob
+// sidecars must belong to.
+type BlockStore interface {
+	// GetSlotByBlockRoot returns the slot of the block of the given root.
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+}
+
+// ChainSpec represents a chain spec.
+type ChainSpec interface {
+	// DepositEth1ChainID returns the chain ID of the execution chain.
+	DepositEth1ChainID() uint64
+}
+
+// Verifier verifies the inclusion and KZG proofs of the blob sidecars.
+type Verifier interface {
+	// VerifySidecars verifies the blob sidecars of a block.
+	VerifySidecars(sidecars *types.BlobSidecars) error
+}
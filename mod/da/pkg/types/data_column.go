@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/karalabe/ssz"
+)
+
+// KZGCommitmentsInclusionProofDepth is the depth of the merkle proof of the
+// list of KZG commitments in the beacon block body, as encoded in SSZ.
+const KZGCommitmentsInclusionProofDepth = 3
+
+// DataColumnSidecar as per the EIP-7594 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/_features/eip7594/das-core.md#datacolumnsidecar
+//
+//nolint:lll
+type DataColumnSidecar struct {
+	// Index is the index of the column in the extended blob matrix.
+	Index uint64
+	// Column holds the cell of the column of each blob of the block.
+	Column []*eip4844.Cell
+	// KzgCommitments are the KZG commitments of the blobs of the block.
+	KzgCommitments []eip4844.KZGCommitment
+	// KzgProofs are the KZG proofs of the cells of the column.
+	KzgProofs []eip4844.KZGProof
+	// BeaconBlockHeader represents the beacon block header for which this
+	// column is being included.
+	BeaconBlockHeader *types.BeaconBlockHeader
+	// InclusionProof is the inclusion proof of the list of KZG commitments
+	// in the beacon block body.
+	InclusionProof []common.Root
+}
+
+// BuildDataColumnSidecar creates a data column sidecar from the given cells
+// and beacon block header.
+func BuildDataColumnSidecar[
+	BeaconBlockHeaderT any,
+](
+	index uint64,
+	header BeaconBlockHeaderT,
+	column []*eip4844.Cell,
+	commitments []eip4844.KZGCommitment,
+	proofs []eip4844.KZGProof,
+	inclusionProof []common.Root,
+) *DataColumnSidecar {
+	return &DataColumnSidecar{
+		Index:             index,
+		Column:            column,
+		KzgCommitments:    commitments,
+		KzgProofs:         proofs,
+		BeaconBlockHeader: any(header).(*types.BeaconBlockHeader),
+		InclusionProof:    inclusionProof,
+	}
+}
+
+// Validate checks that the index of the column is in range, and that it has
+// a cell and a proof for each commitment.
+func (d *DataColumnSidecar) Validate() error {
+	if d.Index >= eip4844.CellsPerExtBlob {
+		return errors.Wrapf(ErrInvalidColumnIndex, "%d", d.Index)
+	}
+	if len(d.Column) != len(d.KzgCommitments) ||
+		len(d.KzgProofs) != len(d.KzgCommitments) {
+		return errors.Wrapf(
+			ErrInconsistentDataColumn,
+			"cells: %d, commitments: %d, proofs: %d",
+			len(d.Column), len(d.KzgCommitments), len(d.KzgProofs),
+		)
+	}
+	return nil
+}
+
+// HasValidInclusionProof verifies the inclusion proof of the list of KZG
+// commitments in the beacon body, laid out as described by the given KZG
+// inclusion.
+func (d *DataColumnSidecar) HasValidInclusionProof(
+	inclusion types.KZGInclusion,
+) bool {
+	tree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+		eip4844.KZGCommitments[common.ExecutionHash](
+			d.KzgCommitments,
+		).Leafify(),
+		inclusion.MaxBlobCommitments,
+	)
+	if err != nil {
+		return false
+	}
+	return merkle.IsValidMerkleBranch(
+		tree.HashTreeRoot(),
+		d.InclusionProof,
+		inclusion.BodyDepth(),
+		inclusion.GIndex(),
+		d.BeaconBlockHeader.BodyRoot,
+	)
+}
+
+func (d *DataColumnSidecar) GetIndex() uint64 {
+	return d.Index
+}
+
+func (d *DataColumnSidecar) GetColumn() []*eip4844.Cell {
+	return d.Column
+}
+
+func (d *DataColumnSidecar) GetKzgCommitments() []eip4844.KZGCommitment {
+	return d.KzgCommitments
+}
+
+func (d *DataColumnSidecar) GetKzgProofs() []eip4844.KZGProof {
+	return d.KzgProofs
+}
+
+func (d *DataColumnSidecar) GetBeaconBlockHeader() *types.BeaconBlockHeader {
+	return d.BeaconBlockHeader
+}
+
+func (d *DataColumnSidecar) GetInclusionProof() []common.Root {
+	return d.InclusionProof
+}
+
+// DefineSSZ defines the SSZ encoding for the DataColumnSidecar object.
+func (d *DataColumnSidecar) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &d.Index)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &d.Column, types.MaxBlobCommitmentsDeneb,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &d.KzgCommitments, types.MaxBlobCommitmentsDeneb,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &d.KzgProofs, types.MaxBlobCommitmentsDeneb,
+	)
+	ssz.DefineStaticObject(codec, &d.BeaconBlockHeader)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec, &d.InclusionProof, KZGCommitmentsInclusionProofDepth,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &d.Column, types.MaxBlobCommitmentsDeneb,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &d.KzgCommitments, types.MaxBlobCommitmentsDeneb,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &d.KzgProofs, types.MaxBlobCommitmentsDeneb,
+	)
+}
+
+// SizeSSZ returns the size of the DataColumnSidecar object in SSZ encoding.
+func (d *DataColumnSidecar) SizeSSZ(fixed bool) uint32 {
+	var size = uint32(
+		8 + // Index
+			4 + // Column offset
+			4 + // KzgCommitments offset
+			4 + // KzgProofs offset
+			112 + // BeaconBlockHeader
+			KZGCommitmentsInclusionProofDepth*32, // InclusionProof
+	)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(d.Column)
+	size += ssz.SizeSliceOfStaticBytes(d.KzgCommitments)
+	size += ssz.SizeSliceOfStaticBytes(d.KzgProofs)
+	return size
+}
+
+// MarshalSSZ marshals the DataColumnSidecar object to SSZ format.
+func (d *DataColumnSidecar) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the DataColumnSidecar object from SSZ format.
+func (d *DataColumnSidecar) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the DataColumnSidecar
+// object.
+func (d *DataColumnSidecar) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
@@ -38,4 +38,12 @@ var (
 	// inclusion.
 	ErrInvalidInclusionProof = errors.New(
 		"invalid KZG commitment inclusion proof")
+
+	// ErrInvalidColumnIndex is returned when the index of a data column is
+	// out of range.
+	ErrInvalidColumnIndex = errors.New("invalid data column index")
+
+	// ErrInconsistentDataColumn is returned when the cells, commitments and
+	// proofs of a data column have different lengths.
+	ErrInconsistentDataColumn = errors.New("inconsistent data column")
 )
@@ -73,19 +73,16 @@ func BuildBlobSidecar[
 }
 
 // HasValidInclusionProof verifies the inclusion proof of the
-// blob in the beacon body.
+// blob in the beacon body, laid out as described by the given KZG inclusion.
 func (b *BlobSidecar) HasValidInclusionProof(
-	kzgOffset uint64,
+	inclusion types.KZGInclusion,
 ) bool {
 	// Verify the inclusion proof.
 	return merkle.IsValidMerkleBranch(
 		b.KzgCommitment.HashTreeRoot(),
 		b.InclusionProof,
-		//#nosec:G701 // safe.
-		uint8(
-			len(b.InclusionProof),
-		), // TODO: use KZG_INCLUSION_PROOF_DEPTH calculation.
-		kzgOffset+b.Index,
+		inclusion.ProofDepth(),
+		inclusion.CommitmentGIndex(b.Index),
 		b.BeaconBlockHeader.BodyRoot,
 	)
 }
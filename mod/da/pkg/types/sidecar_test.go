@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,7 +80,6 @@ func TestHasValidInclusionProof(t *testing.T) {
 	tests := []struct {
 		name           string
 		sidecar        func(t *testing.T) *types.BlobSidecar
-		kzgOffset      uint64
 		expectedResult bool
 	}{
 		{
@@ -104,7 +104,6 @@ func TestHasValidInclusionProof(t *testing.T) {
 					inclusionProof,
 				)
 			},
-			kzgOffset:      0,
 			expectedResult: false,
 		},
 		{
@@ -119,7 +118,6 @@ func TestHasValidInclusionProof(t *testing.T) {
 					[]common.Root{},
 				)
 			},
-			kzgOffset:      0,
 			expectedResult: false,
 		},
 	}
@@ -127,7 +125,9 @@ func TestHasValidInclusionProof(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			sidecar := tt.sidecar(t)
-			result := sidecar.HasValidInclusionProof(tt.kzgOffset)
+			inclusion, err := ctypes.KZGInclusionForVersion(version.Deneb)
+			require.NoError(t, err)
+			result := sidecar.HasValidInclusionProof(inclusion)
 			require.Equal(t, tt.expectedResult, result,
 				"Result should match expected value")
 		})
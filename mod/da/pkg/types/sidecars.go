@@ -22,6 +22,7 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/karalabe/ssz"
 	"github.com/sourcegraph/conc/iter"
@@ -73,7 +74,7 @@ func (bs *BlobSidecars) ValidateBlockRoots() error {
 
 // VerifyInclusionProofs verifies the inclusion proofs for all sidecars.
 func (bs *BlobSidecars) VerifyInclusionProofs(
-	kzgOffset uint64,
+	inclusion types.KZGInclusion,
 ) error {
 	return errors.Join(iter.Map(
 		bs.Sidecars,
@@ -84,7 +85,7 @@ func (bs *BlobSidecars) VerifyInclusionProofs(
 			}
 
 			// Verify the KZG inclusion proof.
-			if !sc.HasValidInclusionProof(kzgOffset) {
+			if !sc.HasValidInclusionProof(inclusion) {
 				return ErrInvalidInclusionProof
 			}
 			return nil
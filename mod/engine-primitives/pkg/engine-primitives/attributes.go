@@ -21,6 +21,9 @@
 package engineprimitives
 
 import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -38,7 +41,7 @@ type PayloadAttributer interface {
 
 // PayloadAttributes is the attributes of a block payload.
 //
-
+//nolint:lll // struct tags.
 type PayloadAttributes[
 	WithdrawalT any,
 ] struct {
@@ -61,6 +64,12 @@ type PayloadAttributes[
 	// to the block currently being processed. This field was added for
 	// EIP-4788.
 	ParentBeaconBlockRoot common.Root `json:"parentBeaconBlockRoot"`
+	// InclusionListTransactions are the transactions of the inclusion lists
+	// of the validators, which the payload must include while they are
+	// valid. It is not part of the engine API, but of its inclusion list
+	// extension, and is only set for execution clients advertising the
+	// engine_getInclusionListV1 capability.
+	InclusionListTransactions []bytes.Bytes `json:"inclusionListTransactions,omitempty"`
 }
 
 // New empty PayloadAttributes.
@@ -93,6 +102,46 @@ func (p *PayloadAttributes[WithdrawalT]) IsNil() bool {
 	return p == nil
 }
 
+// GetTimestamp returns the timestamp the payload is built for.
+func (p *PayloadAttributes[WithdrawalT]) GetTimestamp() math.U64 {
+	return p.Timestamp
+}
+
+// WithTimestamp returns a copy of the PayloadAttributes for building the
+// payload at the given timestamp.
+func (p *PayloadAttributes[WithdrawalT]) WithTimestamp(
+	timestamp uint64,
+) *PayloadAttributes[WithdrawalT] {
+	attrs := *p
+	attrs.Timestamp = math.U64(timestamp)
+	return &attrs
+}
+
+// GetInclusionListTransactions returns the transactions of the inclusion
+// lists the payload is built with.
+func (
+	p *PayloadAttributes[WithdrawalT],
+) GetInclusionListTransactions() [][]byte {
+	txs := make([][]byte, len(p.InclusionListTransactions))
+	for i, tx := range p.InclusionListTransactions {
+		txs[i] = tx
+	}
+	return txs
+}
+
+// WithInclusionListTransactions returns a copy of the PayloadAttributes for
+// building the payload with the given inclusion list transactions.
+func (p *PayloadAttributes[WithdrawalT]) WithInclusionListTransactions(
+	txs [][]byte,
+) *PayloadAttributes[WithdrawalT] {
+	attrs := *p
+	attrs.InclusionListTransactions = make([]bytes.Bytes, len(txs))
+	for i, tx := range txs {
+		attrs.InclusionListTransactions[i] = slices.Clone(tx)
+	}
+	return &attrs
+}
+
 // GetSuggestedFeeRecipient returns the suggested fee recipient.
 func (
 	p *PayloadAttributes[WithdrawalT],
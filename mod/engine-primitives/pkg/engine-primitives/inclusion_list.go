@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// MaxTransactionsPerInclusionList is the maximum number of transactions
+	// in an inclusion list.
+	MaxTransactionsPerInclusionList = 16
+	// MaxBytesPerInclusionList is the maximum total size of the transactions
+	// in an inclusion list.
+	MaxBytesPerInclusionList = 8192
+)
+
+// ErrInclusionListTooLarge is returned when an inclusion list exceeds the
+// maximum number of transactions or bytes.
+var ErrInclusionListTooLarge = errors.New("inclusion list too large")
+
+// InclusionList is the list of transactions a validator requires the next
+// execution payload to include, as long as they remain valid.
+type InclusionList struct {
+	// Transactions are the raw transactions of the inclusion list.
+	Transactions [][]byte
+}
+
+// SizeSSZ returns the SSZ encoded size in bytes for the InclusionList.
+func (l *InclusionList) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // offset of the transactions.
+	var size uint32 = 4
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeSliceOfDynamicBytes(l.Transactions)
+}
+
+// DefineSSZ defines the SSZ encoding for the InclusionList object.
+func (l *InclusionList) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfDynamicBytesOffset(
+		codec,
+		&l.Transactions,
+		MaxTransactionsPerInclusionList,
+		MaxBytesPerInclusionList,
+	)
+	ssz.DefineSliceOfDynamicBytesContent(
+		codec,
+		&l.Transactions,
+		MaxTransactionsPerInclusionList,
+		MaxBytesPerInclusionList,
+	)
+}
+
+// MarshalSSZ marshals the InclusionList object to SSZ format.
+func (l *InclusionList) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, l.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, l)
+}
+
+// UnmarshalSSZ unmarshals the InclusionList object from SSZ format.
+func (l *InclusionList) UnmarshalSSZ(buf []byte) error {
+	if err := ssz.DecodeFromBytes(buf, l); err != nil {
+		return err
+	}
+	return l.Validate()
+}
+
+// Validate returns an error if the InclusionList exceeds the maximum number
+// of transactions or bytes.
+func (l *InclusionList) Validate() error {
+	if len(l.Transactions) > MaxTransactionsPerInclusionList {
+		return ErrInclusionListTooLarge
+	}
+	var size int
+	for _, tx := range l.Transactions {
+		size += len(tx)
+	}
+	if size > MaxBytesPerInclusionList {
+		return ErrInclusionListTooLarge
+	}
+	return nil
+}
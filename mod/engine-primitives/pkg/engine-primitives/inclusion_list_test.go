@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInclusionList_MarshalUnmarshalSSZ(t *testing.T) {
+	list := &engineprimitives.InclusionList{
+		Transactions: [][]byte{
+			[]byte("transaction1"),
+			[]byte("transaction2"),
+		},
+	}
+	bz, err := list.MarshalSSZ()
+	require.NoError(t, err)
+
+	decoded := new(engineprimitives.InclusionList)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, list, decoded)
+}
+
+func TestInclusionList_Validate(t *testing.T) {
+	list := &engineprimitives.InclusionList{
+		Transactions: make(
+			[][]byte, engineprimitives.MaxTransactionsPerInclusionList+1,
+		),
+	}
+	require.ErrorIs(
+		t, list.Validate(), engineprimitives.ErrInclusionListTooLarge,
+	)
+
+	list = &engineprimitives.InclusionList{
+		Transactions: [][]byte{
+			make([]byte, engineprimitives.MaxBytesPerInclusionList/2),
+			make([]byte, engineprimitives.MaxBytesPerInclusionList/2+1),
+		},
+	}
+	require.ErrorIs(
+		t, list.Validate(), engineprimitives.ErrInclusionListTooLarge,
+	)
+}
@@ -38,6 +38,18 @@ type BuiltExecutionPayloadEnv[ExecutionPayloadT any] interface {
 	ShouldOverrideBuilder() bool
 }
 
+// BuilderBid is an interface for a bid made by an external block builder for
+// the execution payload of a block.
+type BuilderBid[ExecutionPayloadHeaderT any] interface {
+	// GetHeader returns the header of the execution payload being bid on.
+	GetHeader() ExecutionPayloadHeaderT
+	// GetBlobKZGCommitments returns the commitments of the blobs included in
+	// the execution payload.
+	GetBlobKZGCommitments() []eip4844.KZGCommitment
+	// GetValue returns the Wei value paid to the proposer.
+	GetValue() *math.U256
+}
+
 // BlobsBundle is an interface for the blobs bundle.
 type BlobsBundle interface {
 	// GetCommitments returns the commitments in the blobs bundle.
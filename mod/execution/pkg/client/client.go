@@ -24,12 +24,15 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
@@ -45,12 +48,23 @@ type EngineClient[
 	cfg *Config
 	// logger is the logger for the engine client.
 	logger log.Logger
+	// chainSpec is the chain spec.
+	chainSpec common.ChainSpec
+	// jwtSecret is the JWT secret the client was started with. It is nil if
+	// the connection is not authenticated.
+	jwtSecret *jwt.Secret
 	// eth1ChainID is the chain ID of the execution client.
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
+	// capabilities is the set of capabilities that the execution client
+	// has. It is replaced as a whole when the execution client reconnects.
+	capabilities atomic.Pointer[map[string]struct{}]
+	// clientVersion is the version reported by the execution client.
+	clientVersion atomic.Pointer[engineprimitives.ClientVersionV1]
+	// errCh receives the error failing the engine client once it was
+	// started.
+	errCh chan error
 }
 
 // New creates a new engine client EngineClient.
@@ -64,13 +78,16 @@ func New[
 	logger log.Logger,
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
-	eth1ChainID *big.Int,
+	chainSpec common.ChainSpec,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	metrics := newClientMetrics(telemetrySink, logger)
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
-		cfg:    cfg,
-		logger: logger,
+		cfg:       cfg,
+		logger:    logger,
+		chainSpec: chainSpec,
+		jwtSecret: jwtSecret,
 		Client: ethclient.New[ExecutionPayloadT](
 			ethclientrpc.NewClient(
 				cfg.RPCDialURL.String(),
@@ -78,10 +95,14 @@ func New[
 				ethclientrpc.WithJWTRefreshInterval(
 					cfg.RPCJWTRefreshInterval,
 				),
+				ethclientrpc.WithJWTRotationWindow(cfg.JWTRotationWindow),
+				ethclientrpc.WithAuthFailureHandler(metrics.markAuthFailure),
 			)),
-		capabilities: make(map[string]struct{}),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
+		eth1ChainID: new(big.Int).SetUint64(
+			chainSpec.DepositEth1ChainID(),
+		),
+		metrics: metrics,
+		errCh:   make(chan error, 1),
 	}
 }
 
@@ -92,6 +113,14 @@ func (s *EngineClient[
 	return "engine-client"
 }
 
+// Err returns a channel receiving the error failing the engine client once
+// it was started, when it reconnects to an incompatible execution client.
+func (s *EngineClient[
+	_, _,
+]) Err() <-chan error {
+	return s.errCh
+}
+
 // Start the engine client.
 func (s *EngineClient[
 	_, _,
@@ -101,6 +130,11 @@ func (s *EngineClient[
 	// Start the Client.
 	go s.Client.Start(ctx)
 
+	// Pick up rotations of the JWT secret without a restart.
+	if s.jwtSecret != nil && s.cfg.JWTSecretPath != "" {
+		go s.watchJWTSecret(ctx)
+	}
+
 	s.logger.Info(
 		"Initializing connection to the execution client...",
 		"dial_url", s.cfg.RPCDialURL.String(),
@@ -108,8 +142,12 @@ func (s *EngineClient[
 
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
-	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+	err := s.verifyChainIDAndConnection(ctx)
+	if err == nil {
+		go s.monitorConnection(ctx)
 		return nil
+	} else if isFatal(err) {
+		return err
 	}
 
 	// Attempt to initialize the connection to the execution client.
@@ -124,17 +162,80 @@ func (s *EngineClient[
 				"Waiting for execution client to start... 🍺🕔",
 				"dial_url", s.cfg.RPCDialURL,
 			)
-			if err := s.verifyChainIDAndConnection(ctx); err != nil {
+			if err = s.verifyChainIDAndConnection(ctx); err != nil {
+				if isFatal(err) {
+					return err
+				}
 				if errors.Is(err, ErrMismatchedEth1ChainID) {
 					s.logger.Error(err.Error())
 				}
 				continue
 			}
+			go s.monitorConnection(ctx)
 			return nil
 		}
 	}
 }
 
+// monitorConnection periodically checks the connection to the execution
+// client, and verifies the execution client again once a lost connection is
+// restored, since it may have been restarted with a different version.
+func (s *EngineClient[
+	_, _,
+]) monitorConnection(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
+
+	connected := true
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Client.ChainID(ctx); err != nil {
+				if connected {
+					s.logger.Warn(
+						"Lost connection to execution client",
+						"dial_url", s.cfg.RPCDialURL.String(),
+						"err", err,
+					)
+				}
+				connected = false
+				continue
+			}
+			if connected {
+				continue
+			}
+			if err := s.verifyChainIDAndConnection(ctx); err != nil {
+				// An incompatible execution client fails the node as it
+				// does at startup, since consensus can no longer progress
+				// safely.
+				if isFatal(err) {
+					s.logger.Error(
+						"Reconnected execution client is incompatible",
+						"err", err,
+					)
+					s.errCh <- err
+					return
+				}
+				s.logger.Error(
+					"Reconnected execution client failed verification",
+					"err", err,
+				)
+				continue
+			}
+			connected = true
+		}
+	}
+}
+
+// isFatal returns true if the error cannot be resolved by retrying to
+// connect to the same execution client.
+func isFatal(err error) bool {
+	return errors.Is(err, ErrIncompatibleExecutionClient) ||
+		errors.Is(err, ErrMissingCapabilities)
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
@@ -188,10 +289,20 @@ func (s *EngineClient[
 		s.eth1ChainID,
 	)
 
+	// Identify the execution client.
+	if err = s.verifyClientVersion(ctx); err != nil {
+		s.logger.Error("failed to verify client version", "err", err)
+		return err
+	}
+
 	// Exchange capabilities with the execution client.
 	if _, err = s.ExchangeCapabilities(ctx); err != nil {
 		s.logger.Error("failed to exchange capabilities", "err", err)
 		return err
 	}
+	if err = s.verifyCapabilities(); err != nil {
+		s.logger.Error("failed to verify capabilities", "err", err)
+		return err
+	}
 	return nil
 }
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/mock"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/stretchr/testify/require"
+)
+
+const eth1ChainID = 80087
+
+type payload struct{}
+
+func (*payload) Empty(uint32) *payload         { return &payload{} }
+func (*payload) Version() uint32               { return 0 }
+func (p *payload) IsNil() bool                 { return p == nil }
+func (*payload) MarshalJSON() ([]byte, error)  { return json.Marshal(nil) }
+func (*payload) UnmarshalJSON(bz []byte) error { return nil }
+
+type payloadAttributes struct{}
+
+func (payloadAttributes) IsNil() bool { return false }
+
+func (payloadAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+type sink struct{}
+
+func (sink) IncrementCounter(string, ...string)        {}
+func (sink) SetGauge(string, int64, ...string)         {}
+func (sink) MeasureSince(string, time.Time, ...string) {}
+
+// chainSpec only schedules Deneb, with the given incompatible clients.
+type chainSpec struct {
+	common.ChainSpec
+	incompatible []string
+}
+
+func (chainSpec) DepositEth1ChainID() uint64 { return eth1ChainID }
+
+func (cs chainSpec) IncompatibleExecutionClients() []string {
+	return cs.incompatible
+}
+
+func (chainSpec) DenebPlusForkEpoch() math.Epoch {
+	return math.Epoch(constants.FarFutureEpoch)
+}
+
+func (chainSpec) ElectraForkEpoch() math.Epoch {
+	return math.Epoch(constants.FarFutureEpoch)
+}
+
+// executionClient serves the mock execution client it is switched to, and
+// fails every request while switched off.
+type executionClient struct {
+	mu     sync.Mutex
+	secret *jwt.Secret
+	server *mock.Server
+}
+
+func (ec *executionClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ec.mu.Lock()
+	server := ec.server
+	ec.mu.Unlock()
+	if server == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	server.ServeHTTP(w, r)
+}
+
+// restart switches to a mock execution client reporting the given version,
+// or switches off if version is nil.
+func (ec *executionClient) restart(
+	t *testing.T, version *engine.ClientVersionV1,
+) {
+	t.Helper()
+	var server *mock.Server
+	if version != nil {
+		var err error
+		server, err = mock.New(
+			mock.WithChainID(eth1ChainID),
+			mock.WithJWTSecret(ec.secret),
+			mock.WithClientVersion(*version),
+		)
+		require.NoError(t, err)
+		t.Cleanup(server.Close)
+	}
+	ec.mu.Lock()
+	ec.server = server
+	ec.mu.Unlock()
+}
+
+func geth(version string) *engine.ClientVersionV1 {
+	return &engine.ClientVersionV1{
+		Code: "GE", Name: "Geth", Version: version, Commit: "01234567",
+	}
+}
+
+// newEngineClient returns an engine client of the execution client reporting
+// the given version, which is incompatible with the chain if it matches an
+// entry of incompatible.
+func newEngineClient(
+	t *testing.T, version *engine.ClientVersionV1, incompatible ...string,
+) (*client.EngineClient[*payload, payloadAttributes], *executionClient) {
+	t.Helper()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	ec := &executionClient{secret: secret}
+	ec.restart(t, version)
+	httpServer := httptest.NewServer(ec)
+	t.Cleanup(httpServer.Close)
+
+	dialURL, err := url.NewFromRaw(httpServer.URL)
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURL
+	cfg.RPCStartupCheckInterval = 10 * time.Millisecond
+	cfg.RPCHealthCheckInterval = 10 * time.Millisecond
+	cfg.JWTSecretPath = ""
+	return client.New[*payload, payloadAttributes](
+		&cfg, noop.NewLogger[any](), secret, sink{},
+		chainSpec{incompatible: incompatible},
+	), ec
+}
+
+func TestEngineClientVerifiesClientVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      string
+		incompatible []string
+		wantErr      bool
+	}{
+		{"no incompatible clients", "1.14.0", nil, false},
+		{"incompatible client", "1.14.0", []string{"ge"}, true},
+		{"incompatible version", "v1.14.0", []string{"GE/1.14.0"}, true},
+		{"other version", "1.14.1", []string{"GE/v1.14.0"}, false},
+		{"other client", "1.14.0", []string{"NM", "RH/1.14.0"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			ec, _ := newEngineClient(t, geth(tt.version), tt.incompatible...)
+
+			err := ec.Start(ctx)
+			require.Equal(t, geth(tt.version).Version, ec.ClientVersion().Version)
+			if tt.wantErr {
+				require.ErrorIs(t, err, client.ErrIncompatibleExecutionClient)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEngineClientAcceptsClientsNotReportingTheirVersion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ec, el := newEngineClient(t, geth("1.14.0"), "GE")
+	el.server.InjectFault(
+		"engine_getClientVersionV1",
+		mock.RPCErrorFault(-32601, "method not found"),
+	)
+
+	require.NoError(t, ec.Start(ctx))
+	require.Nil(t, ec.ClientVersion())
+}
+
+func TestMonitorConnectionVerifiesReconnectedClient(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ec, el := newEngineClient(t, geth("1.14.0"), "GE/1.14.2")
+	require.NoError(t, ec.Start(ctx))
+
+	// The execution client is restarted with a compatible version.
+	el.restart(t, nil)
+	time.Sleep(50 * time.Millisecond)
+	el.restart(t, geth("1.14.1"))
+	require.Eventually(t, func() bool {
+		return ec.ClientVersion().Version == "1.14.1"
+	}, time.Second, 10*time.Millisecond)
+	select {
+	case err := <-ec.Err():
+		require.FailNow(t, "unexpected engine client failure", err)
+	default:
+	}
+
+	// The execution client is restarted with an incompatible version.
+	el.restart(t, nil)
+	time.Sleep(50 * time.Millisecond)
+	el.restart(t, geth("1.14.2"))
+	select {
+	case err := <-ec.Err():
+		require.ErrorIs(t, err, client.ErrIncompatibleExecutionClient)
+	case <-time.After(time.Second):
+		require.FailNow(t, "incompatible execution client not reported")
+	}
+}
@@ -32,6 +32,8 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
+	defaultJWTRotationWindow       = time.Minute
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -46,7 +48,9 @@ func DefaultConfig() Config {
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		JWTRotationWindow:       defaultJWTRotationWindow,
 	}
 }
 
@@ -65,6 +69,13 @@ type Config struct {
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the interval at which the connection to the
+	// execution client is checked after startup. The execution client is
+	// verified again once the connection is restored.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// JWTRotationWindow is how long the previous JWT secret is still tried
+	// after the secret at JWTSecretPath changes.
+	JWTRotationWindow time.Duration `mapstructure:"jwt-rotation-window"`
 }
@@ -182,6 +182,51 @@ func (s *EngineClient[
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                              GetInclusionList                              */
+/* -------------------------------------------------------------------------- */
+
+// GetInclusionList calls the engine_getInclusionListV1 method via JSON-RPC
+// for the given block. It returns the transactions of the mempool the
+// execution client requires the payload built on top of the block to
+// include.
+func (s *EngineClient[
+	_, _,
+]) GetInclusionList(
+	ctx context.Context,
+	parentHash common.ExecutionHash,
+) ([][]byte, error) {
+	if !s.hasCapability(ethclient.GetInclusionListV1) {
+		return nil, ErrInclusionListsUnsupported
+	}
+
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := s.Client.GetInclusionListV1(cctx, parentHash)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
+
+	txs := make([][]byte, len(result))
+	for i, tx := range result {
+		txs[i] = tx
+	}
+	return txs, nil
+}
+
+// SupportsInclusionLists returns true if the execution client implements
+// the inclusion list extension of the engine API, which it advertises
+// through the engine_getInclusionListV1 capability. Execution clients
+// implementing it also build the payloads of engine_forkchoiceUpdated with
+// the inclusionListTransactions attribute, which stock execution clients
+// do not know.
+func (s *EngineClient[
+	_, _,
+]) SupportsInclusionLists() bool {
+	return s.hasCapability(ethclient.GetInclusionListV1)
+}
+
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
 // JSON-RPC.
 func (s *EngineClient[
@@ -196,15 +241,18 @@ func (s *EngineClient[
 		return nil, err
 	}
 
-	// Capture and log the capabilities that the execution client has.
+	// Capture and log the capabilities that the execution client has,
+	// replacing the ones of a previously connected execution client.
+	capabilities := make(map[string]struct{}, len(result))
 	for _, capability := range result {
 		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		capabilities[capability] = struct{}{}
 	}
+	s.capabilities.Store(&capabilities)
 
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+		if _, exists := capabilities[capability]; !exists {
 			s.logger.Warn(
 				"Your execution client may require an update 🚸",
 				"unsupported_capability", capability,
@@ -214,3 +262,16 @@ func (s *EngineClient[
 
 	return result, nil
 }
+
+// hasCapability returns true if the execution client has the given
+// capability.
+func (s *EngineClient[
+	_, _,
+]) hasCapability(capability string) bool {
+	capabilities := s.capabilities.Load()
+	if capabilities == nil {
+		return false
+	}
+	_, ok := (*capabilities)[capability]
+	return ok
+}
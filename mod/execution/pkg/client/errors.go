@@ -53,6 +53,24 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrIncompatibleExecutionClient is returned when the execution client
+	// is known to be incompatible with the chain.
+	ErrIncompatibleExecutionClient = errors.New(
+		"incompatible execution client",
+	)
+
+	// ErrInclusionListsUnsupported is returned when the execution client does
+	// not support building inclusion lists.
+	ErrInclusionListsUnsupported = errors.New(
+		"execution client does not support inclusion lists",
+	)
+
+	// ErrMissingCapabilities is returned when the execution client does not
+	// support an engine API method required by the chain.
+	ErrMissingCapabilities = errors.New(
+		"execution client is missing required capabilities",
+	)
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -20,6 +20,8 @@
 
 package ethclient
 
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+
 // BeaconKitSupportedCapabilities returns the full list of capabilities
 // of the beacon kit client.
 func BeaconKitSupportedCapabilities() []string {
@@ -31,6 +33,21 @@ func BeaconKitSupportedCapabilities() []string {
 	}
 }
 
+// RequiredCapabilities returns the engine API methods the beacon kit client
+// calls under the given fork version.
+func RequiredCapabilities(forkVersion uint32) []string {
+	switch forkVersion {
+	case version.Deneb, version.DenebPlus, version.Electra:
+		return []string{
+			NewPayloadMethodV3,
+			ForkchoiceUpdatedMethodV3,
+			GetPayloadMethodV3,
+		}
+	default:
+		return nil
+	}
+}
+
 // Constants for JSON-RPC method names.
 const (
 	// NewPayloadMethodV3 for creating a new payload in Deneb.
@@ -45,6 +62,12 @@ const (
 	BlockByNumberMethod = "eth_getBlockByNumber"
 	// ExchangeCapabilities for exchanging capabilities with the peer.
 	ExchangeCapabilities = "engine_exchangeCapabilities"
-	// GetClientVersionV1 for retrieving the capabilities of the peer.
+	// GetClientVersionV1 for retrieving the version of the peer.
 	GetClientVersionV1 = "engine_getClientVersionV1"
+	// GetInclusionListV1 for retrieving an inclusion list from the mempool.
+	GetInclusionListV1 = "engine_getInclusionListV1"
+	// TransactionCountMethod for retrieving the nonce of an account.
+	TransactionCountMethod = "eth_getTransactionCount"
+	// BalanceMethod for retrieving the balance of an account.
+	BalanceMethod = "eth_getBalance"
 )
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -187,3 +188,17 @@ func (s *Client[ExecutionPayloadT]) GetClientVersionV1(
 	}
 	return result, nil
 }
+
+// GetInclusionListV1 calls the engine_getInclusionListV1 method via JSON-RPC.
+func (s *Client[ExecutionPayloadT]) GetInclusionListV1(
+	ctx context.Context,
+	parentHash common.ExecutionHash,
+) ([]bytes.Bytes, error) {
+	result := make([]bytes.Bytes, 0)
+	if err := s.Call(
+		ctx, &result, GetInclusionListV1, parentHash,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -44,6 +45,56 @@ func (ec *Client[ExecutionPayloadT]) ChainID(
 	return result, nil
 }
 
+// HeaderByNumber returns a block header from the current canonical chain.
+// If number is nil, the latest known header is returned. Negative numbers
+// resolve to the block tags defined by rpc.BlockNumber, e.g. finalized.
+func (ec *Client[ExecutionPayloadT]) HeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*types.Header, error) {
+	var header *types.Header
+	if err := ec.Call(
+		ctx, &header, BlockByNumberMethod, toBlockNumArg(number), false,
+	); err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+// NonceAt returns the nonce of the account in the state of the given block.
+func (ec *Client[ExecutionPayloadT]) NonceAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+	blockHash common.ExecutionHash,
+) (math.U64, error) {
+	var result math.U64
+	if err := ec.Call(
+		ctx, &result, TransactionCountMethod, account, blockHash,
+	); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+// BalanceAt returns the balance of the account in the state of the given
+// block.
+func (ec *Client[ExecutionPayloadT]) BalanceAt(
+	ctx context.Context,
+	account common.ExecutionAddress,
+	blockHash common.ExecutionHash,
+) (*big.Int, error) {
+	var result hexutil.Big
+	if err := ec.Call(
+		ctx, &result, BalanceMethod, account, blockHash,
+	); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&result), nil
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
@@ -23,9 +23,12 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
@@ -41,15 +44,26 @@ type Client struct {
 	client *http.Client
 	// reqPool is a sync.Pool for reusing RPC request objects.
 	reqPool *sync.Pool
-	// jwtSecret is the JWT secret used for authentication.
-	jwtSecret *jwt.Secret
 	// jwtRefershInterval is the interval at which the JWT token should be
 	// refreshed.
 	jwtRefreshInterval time.Duration
-
-	// mu protects header for concurrent access.
+	// jwtRotationWindow is how long the previous JWT secret is still tried
+	// after the secret is rotated.
+	jwtRotationWindow time.Duration
+	// onAuthFailure is called whenever a call is rejected as unauthorized.
+	onAuthFailure func(error)
+	// authorized is false if the last call was rejected as unauthorized.
+	authorized atomic.Bool
+
+	// mu protects the secrets and header for concurrent access.
 	mu sync.RWMutex
 
+	// jwtSecret is the JWT secret used for authentication.
+	jwtSecret *jwt.Secret
+	// prevJWTSecret is the JWT secret used before the last rotation.
+	prevJWTSecret *jwt.Secret
+	// rotatedAt is the time of the last rotation of the JWT secret.
+	rotatedAt time.Time
 	// header is the HTTP header used for RPC requests.
 	header http.Header
 }
@@ -70,6 +84,7 @@ func NewClient(url string, options ...func(rpc *Client)) *Client {
 		header: http.Header{"Content-Type": {"application/json"}},
 	}
 
+	rpc.authorized.Store(true)
 	for _, option := range options {
 		option(rpc)
 	}
@@ -98,12 +113,71 @@ func (rpc *Client) Start(ctx context.Context) {
 	}
 }
 
+// SetJWTSecret rotates the JWT secret used for authentication. The previous
+// secret is still tried for the rotation window, in case the execution client
+// has not picked up the new secret yet.
+func (rpc *Client) SetJWTSecret(secret *jwt.Secret) error {
+	rpc.mu.Lock()
+	if rpc.jwtSecret != nil && *rpc.jwtSecret == *secret {
+		rpc.mu.Unlock()
+		return nil
+	}
+	rpc.prevJWTSecret, rpc.jwtSecret = rpc.jwtSecret, secret
+	rpc.rotatedAt = time.Now()
+	rpc.mu.Unlock()
+
+	return rpc.updateHeader()
+}
+
+// Authorized returns false if the last call to the execution client was
+// rejected as unauthorized.
+func (rpc *Client) Authorized() bool {
+	return rpc.authorized.Load()
+}
+
 // Close closes the RPC client.
 func (rpc *Client) Close() error {
 	rpc.client.CloseIdleConnections()
 	return nil
 }
 
+// post sends the request body with the given header and returns the body of
+// the response.
+func (rpc *Client) post(
+	ctx context.Context, body []byte, header http.Header,
+) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		rpc.url,
+		bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = header
+
+	response, err := rpc.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, ErrNilResponse
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf(
+			"%w: %s", ErrUnauthorized, bytes.TrimSpace(data),
+		)
+	}
+	return data, nil
+}
+
 // Call calls the given method with the given parameters.
 func (rpc *Client) Call(
 	ctx context.Context, target any, method string, params ...any,
@@ -139,33 +213,28 @@ func (rpc *Client) CallRaw(
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodPost,
-		rpc.url,
-		bytes.NewBuffer(body),
-	)
-	if err != nil {
-		return nil, err
-	}
-
 	rpc.mu.RLock()
-	req.Header = rpc.header.Clone()
+	header := rpc.header.Clone()
 	rpc.mu.RUnlock()
 
-	response, err := rpc.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	if response == nil {
-		return nil, ErrNilResponse
+	data, err := rpc.post(ctx, body, header)
+	if errors.Is(err, ErrUnauthorized) {
+		// The execution client may not have picked up a rotated secret yet,
+		// so retry with the previous one.
+		if header = rpc.previousHeader(); header != nil {
+			data, err = rpc.post(ctx, body, header)
+		}
 	}
-	defer response.Body.Close()
-
-	data, err := io.ReadAll(response.Body)
-	if err != nil {
+	if errors.Is(err, ErrUnauthorized) {
+		rpc.authorized.Store(false)
+		if rpc.onAuthFailure != nil {
+			rpc.onAuthFailure(err)
+		}
+		return nil, err
+	} else if err != nil {
 		return nil, err
 	}
+	rpc.authorized.Store(true)
 
 	resp := new(Response)
 	if err = json.Unmarshal(data, resp); err != nil {
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	gjwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// newServer returns a server which only accepts tokens signed by the secret
+// it currently holds.
+func newServer(
+	t *testing.T, secret *atomic.Pointer[jwt.Secret],
+) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			token := strings.TrimPrefix(
+				r.Header.Get("Authorization"), "Bearer ",
+			)
+			if _, err := gjwt.Parse(token, func(*gjwt.Token) (any, error) {
+				return secret.Load().Bytes(), nil
+			}); err != nil {
+				http.Error(w, "signature is invalid", http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		},
+	))
+}
+
+func TestClientJWTRotation(t *testing.T) {
+	oldSecret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	newSecret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	var serverSecret atomic.Pointer[jwt.Secret]
+	serverSecret.Store(oldSecret)
+	server := newServer(t, &serverSecret)
+	defer server.Close()
+
+	client := rpc.NewClient(
+		server.URL,
+		rpc.WithJWTSecret(oldSecret),
+		rpc.WithJWTRefreshInterval(time.Minute),
+		rpc.WithJWTRotationWindow(time.Minute),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.Start(ctx)
+	require.Eventually(t, func() bool {
+		return client.Call(ctx, nil, "eth_chainId") == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// The node rotates first, so calls fall back to the previous secret.
+	require.NoError(t, client.SetJWTSecret(newSecret))
+	require.NoError(t, client.Call(ctx, nil, "eth_chainId"))
+	require.True(t, client.Authorized())
+
+	// Once the execution client rotates too, the new secret is used.
+	serverSecret.Store(newSecret)
+	require.NoError(t, client.Call(ctx, nil, "eth_chainId"))
+
+	// A secret unknown to the execution client is reported as unauthorized.
+	unknown, err := jwt.NewRandom()
+	require.NoError(t, err)
+	require.NoError(t, client.SetJWTSecret(unknown))
+	serverSecret.Store(oldSecret)
+	err = client.Call(ctx, nil, "eth_chainId")
+	require.ErrorIs(t, err, rpc.ErrUnauthorized)
+	require.False(t, client.Authorized())
+}
@@ -22,4 +22,10 @@ package rpc
 
 import "errors"
 
-var ErrNilResponse = errors.New("nil response")
+var (
+	ErrNilResponse = errors.New("nil response")
+
+	// ErrUnauthorized is returned when the execution client rejects the JWT
+	// token of a call.
+	ErrUnauthorized = errors.New("401 Unauthorized")
+)
@@ -20,18 +20,46 @@
 
 package rpc
 
+import (
+	"net/http"
+	"time"
+)
+
 // updateHeader builds an http.Header that has the JWT token
 // attached for authorization.
 func (rpc *Client) updateHeader() error {
+	// Access the secret and header safely.
+	rpc.mu.Lock()
+	defer rpc.mu.Unlock()
+
 	// Build the JWT token.
 	token, err := rpc.jwtSecret.BuildSignedToken()
 	if err != nil {
 		return err
 	}
 
-	// Add the JWT token to the headers. Access header safely.
-	rpc.mu.Lock()
-	defer rpc.mu.Unlock()
+	// Add the JWT token to the headers.
 	rpc.header.Set("Authorization", "Bearer "+token)
 	return nil
 }
+
+// previousHeader builds an http.Header authorized by the JWT secret used
+// before the last rotation. It returns nil if there is no such secret or the
+// rotation window has passed.
+func (rpc *Client) previousHeader() http.Header {
+	rpc.mu.RLock()
+	defer rpc.mu.RUnlock()
+
+	if rpc.prevJWTSecret == nil ||
+		time.Since(rpc.rotatedAt) > rpc.jwtRotationWindow {
+		return nil
+	}
+
+	token, err := rpc.prevJWTSecret.BuildSignedToken()
+	if err != nil {
+		return nil
+	}
+	header := rpc.header.Clone()
+	header.Set("Authorization", "Bearer "+token)
+	return header
+}
@@ -39,3 +39,19 @@ func WithJWTRefreshInterval(interval time.Duration) func(rpc *Client) {
 		rpc.jwtRefreshInterval = interval
 	}
 }
+
+// WithJWTRotationWindow sets how long the previous JWT secret is still tried
+// after the secret is rotated.
+func WithJWTRotationWindow(window time.Duration) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.jwtRotationWindow = window
+	}
+}
+
+// WithAuthFailureHandler sets a function which is called whenever a call is
+// rejected as unauthorized.
+func WithAuthFailureHandler(handler func(error)) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.onAuthFailure = handler
+	}
+}
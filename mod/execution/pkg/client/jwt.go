@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/filewatch"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/http"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+)
+
+// Health returns an error if the execution client rejects the JWT secret of
+// the node.
+func (s *EngineClient[
+	_, _,
+]) Health() error {
+	if !s.Client.Authorized() {
+		return http.ErrUnauthorized
+	}
+	return nil
+}
+
+// watchJWTSecret rotates the JWT secret whenever the file at JWTSecretPath
+// changes.
+func (s *EngineClient[
+	_, _,
+]) watchJWTSecret(ctx context.Context) {
+	if err := filewatch.Watch(
+		ctx, s.cfg.JWTSecretPath, readJWTSecret, s.rotateJWTSecret,
+		func(err error) {
+			s.logger.Warn("Ignoring JWT secret change", "err", err)
+		},
+	); err != nil {
+		s.logger.Error(
+			"Failed to watch JWT secret",
+			"path", s.cfg.JWTSecretPath, "err", err,
+		)
+	}
+}
+
+// rotateJWTSecret rotates the secret of the client to the given secret.
+func (s *EngineClient[
+	_, _,
+]) rotateJWTSecret(secret *jwt.Secret) {
+	if err := s.Client.SetJWTSecret(secret); err != nil {
+		s.logger.Error("Failed to rotate JWT secret", "err", err)
+		return
+	}
+	s.logger.Info("Reloaded JWT secret 🔑", "secret", secret.String())
+	s.metrics.markJWTSecretReloaded()
+}
+
+// readJWTSecret reads the hex encoded JWT secret at the given path.
+func readJWTSecret(path string) (*jwt.Secret, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.NewFromHex(strings.TrimSpace(string(data)))
+}
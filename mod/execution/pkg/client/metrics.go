@@ -23,6 +23,7 @@ package client
 import (
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -45,6 +46,34 @@ func newClientMetrics(
 	}
 }
 
+// setClientVersion sets the gauge identifying the version of the execution
+// client.
+func (cm *clientMetrics) setClientVersion(
+	v engineprimitives.ClientVersionV1,
+) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.version",
+		1,
+		"code", v.Code,
+		"name", v.Name,
+		"version", v.Version,
+		"commit", v.Commit,
+	)
+}
+
+// markAuthFailure increments the counter for calls rejected by the execution
+// client as unauthorized.
+func (cm *clientMetrics) markAuthFailure(error) {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.auth_failure")
+}
+
+// markJWTSecretReloaded increments the counter for reloads of the JWT secret.
+func (cm *clientMetrics) markJWTSecretReloaded() {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.jwt_secret_reloaded",
+	)
+}
+
 // measureForkchoiceUpdateDuration measures the duration of the forkchoice
 // update.
 func (cm *clientMetrics) measureForkchoiceUpdateDuration(startTime time.Time) {
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// methodNotFoundCode is the JSON-RPC error code returned for unknown methods.
+const methodNotFoundCode = -32601
+
+// ClientVersion returns the version of the execution client, or nil if it is
+// not known yet or the execution client does not report it.
+func (s *EngineClient[
+	_, _,
+]) ClientVersion() *engineprimitives.ClientVersionV1 {
+	return s.clientVersion.Load()
+}
+
+// verifyClientVersion retrieves the version of the execution client and
+// ensures it is not known to be incompatible with the chain.
+func (s *EngineClient[
+	_, _,
+]) verifyClientVersion(ctx context.Context) error {
+	versions, err := s.Client.GetClientVersionV1(ctx)
+	if err != nil {
+		// The method is optional in the engine API, so clients which do not
+		// implement it are still accepted.
+		var rpcErr ethclientrpc.Error
+		if errors.As(err, &rpcErr) && rpcErr.Code == methodNotFoundCode {
+			s.logger.Warn(
+				"Execution client does not report its version",
+				"method", ethclient.GetClientVersionV1,
+			)
+			return nil
+		}
+		return err
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+
+	v := versions[0]
+	s.clientVersion.Store(&v)
+	s.metrics.setClientVersion(v)
+	s.logger.Info(
+		"Identified execution client 🪪",
+		"name", v.Name,
+		"version", v.Version,
+		"commit", v.Commit,
+	)
+
+	if isIncompatible(v, s.chainSpec.IncompatibleExecutionClients()) {
+		return errors.Wrapf(
+			ErrIncompatibleExecutionClient,
+			"%s %s (%s) is known to be incompatible with this chain, "+
+				"please switch to a different version",
+			v.Name, v.Version, v.Code,
+		)
+	}
+	return nil
+}
+
+// verifyCapabilities ensures the execution client supports every engine API
+// method called under the active and scheduled forks of the chain.
+func (s *EngineClient[
+	_, _,
+]) verifyCapabilities() error {
+	var missing []string
+	for _, fork := range s.scheduledForkVersions() {
+		for _, method := range ethclient.RequiredCapabilities(fork) {
+			if s.hasCapability(method) ||
+				slices.Contains(missing, method) {
+				continue
+			}
+			missing = append(missing, method)
+		}
+	}
+
+	if len(missing) > 0 {
+		return errors.Wrapf(
+			ErrMissingCapabilities,
+			"execution client does not support %s, "+
+				"please update your execution client",
+			strings.Join(missing, ", "),
+		)
+	}
+	return nil
+}
+
+// scheduledForkVersions returns the fork versions of the chain which are
+// either active or scheduled to activate.
+func (s *EngineClient[
+	_, _,
+]) scheduledForkVersions() []uint32 {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	forks := []uint32{version.Deneb}
+	if s.chainSpec.DenebPlusForkEpoch() != farFutureEpoch {
+		forks = append(forks, version.DenebPlus)
+	}
+	if s.chainSpec.ElectraForkEpoch() != farFutureEpoch {
+		forks = append(forks, version.Electra)
+	}
+	return forks
+}
+
+// isIncompatible returns true if the client version matches an entry of the
+// incompatible list. Entries either match a client code or a client code and
+// version separated by a slash.
+func isIncompatible(
+	v engineprimitives.ClientVersionV1,
+	incompatible []string,
+) bool {
+	for _, entry := range incompatible {
+		code, ver, versioned := strings.Cut(entry, "/")
+		if !strings.EqualFold(code, v.Code) {
+			continue
+		}
+		if !versioned || strings.TrimPrefix(ver, "v") ==
+			strings.TrimPrefix(v.Version, "v") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+)
+
+const (
+	// FinalizedBlockTag syncs deposits up to the finalized execution block.
+	FinalizedBlockTag = "finalized"
+	// SafeBlockTag syncs deposits up to the safe execution block.
+	SafeBlockTag = "safe"
+
+	defaultMaxBlockRange = 1000
+	defaultSyncInterval  = 12 * time.Second
+)
+
+// DefaultConfig returns the default configuration for the deposit service.
+func DefaultConfig() Config {
+	return Config{
+		BlockTag:      FinalizedBlockTag,
+		MaxBlockRange: defaultMaxBlockRange,
+		SyncInterval:  defaultSyncInterval,
+	}
+}
+
+// Config is the configuration for the deposit service.
+type Config struct {
+	// BlockTag is the execution block tag up to which deposits are synced,
+	// either "finalized" or "safe".
+	BlockTag string `mapstructure:"block-tag"`
+	// MaxBlockRange is the maximum number of execution blocks queried for
+	// deposit logs in a single request.
+	MaxBlockRange uint64 `mapstructure:"max-block-range"`
+	// SyncInterval is the interval at which deposits are synced in the
+	// absence of finalized beacon blocks.
+	SyncInterval time.Duration `mapstructure:"sync-interval"`
+	// SubscriptionURL is the websocket or IPC endpoint of the execution
+	// client used to stream deposits as they are emitted. Deposits are only
+	// polled if it is empty, or while the subscription is down.
+	SubscriptionURL string `mapstructure:"subscription-url"`
+	// ConfirmationDepth is the number of execution blocks which must be
+	// built on top of a streamed deposit before it is enqueued, in addition
+	// to its block being at or below the configured block tag.
+	ConfirmationDepth uint64 `mapstructure:"confirmation-depth"`
+}
+
+// blockNumber returns the block number to query the execution client with
+// for the configured block tag.
+func (c Config) blockNumber() (*big.Int, error) {
+	switch c.BlockTag {
+	case FinalizedBlockTag:
+		return big.NewInt(rpc.FinalizedBlockNumber.Int64()), nil
+	case SafeBlockTag:
+		return big.NewInt(rpc.SafeBlockNumber.Int64()), nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidBlockTag, "%q", c.BlockTag)
+	}
+}
@@ -71,19 +71,20 @@ func NewWrappedBeaconDepositContract[
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads deposits from the deposit contract in the inclusive
+// block range [from, to].
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ]) ReadDeposits(
 	ctx context.Context,
-	blkNum math.U64,
+	from, to math.U64,
 ) ([]DepositT, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   blkNum.Unwrap(),
-			End:     (*uint64)(&blkNum),
+			Start:   from.Unwrap(),
+			End:     (*uint64)(&to),
 		},
 	)
 	if err != nil {
@@ -92,32 +93,41 @@ func (dc *WrappedBeaconDepositContract[
 
 	deposits := make([]DepositT, 0)
 	for logs.Next() {
-		var (
-			cred   bytes.B32
-			pubKey bytes.B48
-			d      DepositT
-			sign   bytes.B96
-		)
-		pubKey, err = bytes.ToBytes48(logs.Event.Pubkey)
-		if err != nil {
-			return nil, fmt.Errorf("failed reading pub key: %w", err)
+		var d DepositT
+		if d, err = dc.toDeposit(logs.Event); err != nil {
+			return nil, err
 		}
-		cred, err = bytes.ToBytes32(logs.Event.Credentials)
-		if err != nil {
-			return nil, fmt.Errorf("failed reading credentials: %w", err)
-		}
-		sign, err = bytes.ToBytes96(logs.Event.Signature)
-		if err != nil {
-			return nil, fmt.Errorf("failed reading signature: %w", err)
-		}
-		deposits = append(deposits, d.New(
-			pubKey,
-			WithdrawalCredentialsT(cred),
-			math.U64(logs.Event.Amount),
-			sign,
-			logs.Event.Index,
-		))
+		deposits = append(deposits, d)
 	}
 
 	return deposits, nil
 }
+
+// toDeposit converts a Deposit event of the deposit contract into a deposit.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) toDeposit(
+	event *deposit.BeaconDepositContractDeposit,
+) (DepositT, error) {
+	var d DepositT
+	pubKey, err := bytes.ToBytes48(event.Pubkey)
+	if err != nil {
+		return d, fmt.Errorf("failed reading pub key: %w", err)
+	}
+	cred, err := bytes.ToBytes32(event.Credentials)
+	if err != nil {
+		return d, fmt.Errorf("failed reading credentials: %w", err)
+	}
+	sign, err := bytes.ToBytes96(event.Signature)
+	if err != nil {
+		return d, fmt.Errorf("failed reading signature: %w", err)
+	}
+	return d.New(
+		pubKey,
+		WithdrawalCredentialsT(cred),
+		math.U64(event.Amount),
+		sign,
+		event.Index,
+	), nil
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidBlockTag is returned when the configured block tag is
+	// neither finalized nor safe.
+	ErrInvalidBlockTag = errors.New("invalid block tag")
+
+	// ErrInvalidMaxBlockRange is returned when the configured maximum block
+	// range is zero.
+	ErrInvalidMaxBlockRange = errors.New("max block range must be positive")
+
+	// ErrDepositGap is returned when the deposits read from the execution
+	// client skip a deposit index.
+	ErrDepositGap = errors.New("gap in deposit indices")
+
+	// ErrDepositGapUnresolved is returned when the deposits read from the
+	// execution client keep skipping the same deposit index.
+	ErrDepositGapUnresolved = errors.New("unresolved gap in deposit indices")
+)
@@ -47,3 +47,44 @@ func (m *metrics) markFailedToGetBlockLogs(blockNum math.U64) {
 		strconv.FormatUint(blockNum.Unwrap(), 10),
 	)
 }
+
+// markFailedToGetHeader increments the counter for failed to get the header
+// of the configured block tag.
+func (m *metrics) markFailedToGetHeader() {
+	m.sink.IncrementCounter(
+		"beacon_kit.execution.deposit.failed_to_get_header",
+	)
+}
+
+// markDepositGap increments the counter for gaps in deposit indices.
+func (m *metrics) markDepositGap() {
+	m.sink.IncrementCounter("beacon_kit.execution.deposit.gap")
+}
+
+// markDepositGapUnresolved increments the counter for gaps in deposit
+// indices which persisted over maxGapRetries attempts.
+func (m *metrics) markDepositGapUnresolved() {
+	m.sink.IncrementCounter("beacon_kit.execution.deposit.gap_unresolved")
+}
+
+// markSubscriptionFailed increments the counter for failed or dropped
+// deposit subscriptions.
+func (m *metrics) markSubscriptionFailed() {
+	m.sink.IncrementCounter(
+		"beacon_kit.execution.deposit.subscription_failed",
+	)
+}
+
+// markStreamMismatch increments the counter for ranges whose streamed
+// deposits differ from the ones read from the deposit contract.
+func (m *metrics) markStreamMismatch() {
+	m.sink.IncrementCounter("beacon_kit.execution.deposit.stream_mismatch")
+}
+
+// setLastSyncedBlock sets the gauge for the last synced execution block.
+func (m *metrics) setLastSyncedBlock(blockNum math.U64) {
+	m.sink.SetGauge(
+		"beacon_kit.execution.deposit.last_synced_block",
+		int64(blockNum.Unwrap()),
+	)
+}
@@ -22,9 +22,8 @@ package deposit
 
 import (
 	"context"
-	"maps"
-	"slices"
-	"sync"
+	"math/big"
+	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -36,16 +35,30 @@ import (
 type Service[
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconStateT BeaconState,
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 	ExecutionPayloadT ExecutionPayload,
 	WithdrawalCredentialsT any,
 ] struct {
+	// cfg is the configuration for the deposit service.
+	cfg *Config
 	// logger is used for logging information and errors.
 	logger log.Logger
-	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
-	eth1FollowDistance math.U64
+	// cs is the chain spec, holding the block the deposit contract was
+	// deployed at.
+	cs ChainSpec
+	// sb is the storage backend the beacon state is read from.
+	sb StorageBackend[BeaconStateT]
+	// el is the execution client used to resolve the block tag.
+	el ExecutionClient
 	// dc is the contract interface for interacting with the deposit contract.
 	dc Contract[DepositT]
+	// sc streams deposits from the execution client. It is nil if deposits
+	// are only polled.
+	sc SubscriptionClient[DepositT]
+	// sub is the active subscription of sc, or nil while deposits are
+	// polled.
+	sub *subscription[DepositT]
 	// ds is the deposit store that stores deposits.
 	ds Store[DepositT]
 	// dispatcher is the dispatcher for the service.
@@ -55,40 +68,55 @@ type Service[
 	subFinalizedBlockEvents chan async.Event[BeaconBlockT]
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
-	// mu protects failedBlocks for concurrent access.
-	mu sync.RWMutex
-	// failedBlocks is a map of blocks that failed to be processed
-	// and should be retried.
-	failedBlocks map[math.U64]struct{}
+	// blockTag is the block number resolving to the configured block tag.
+	blockTag *big.Int
+	// nextBlock is the next execution block to read deposits from.
+	nextBlock math.U64
+	// nextIndex is the index of the next expected deposit.
+	nextIndex math.U64
+	// seeded is whether the sync cursor was restored from the deposit store
+	// or seeded from the beacon state. Deposits are not synced until it is.
+	seeded bool
+	// gapRetries is the number of times in a row the deposits read skipped
+	// an index.
+	gapRetries int
 }
 
 // NewService creates a new instance of the Service struct.
 func NewService[
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
+	BeaconStateT BeaconState,
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 	ExecutionPayloadT ExecutionPayload,
 	WithdrawalCredentialsT any,
 ](
+	cfg *Config,
 	logger log.Logger,
-	eth1FollowDistance math.U64,
 	telemetrySink TelemetrySink,
+	cs ChainSpec,
+	sb StorageBackend[BeaconStateT],
+	el ExecutionClient,
 	ds Store[DepositT],
 	dc Contract[DepositT],
+	sc SubscriptionClient[DepositT],
 	dispatcher asynctypes.EventDispatcher,
 ) *Service[
-	BeaconBlockT, BeaconBlockBodyT, DepositT,
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT,
 	ExecutionPayloadT, WithdrawalCredentialsT,
 ] {
 	return &Service[
-		BeaconBlockT, BeaconBlockBodyT, DepositT,
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT,
 		ExecutionPayloadT, WithdrawalCredentialsT,
 	]{
+		cfg:                     cfg,
+		cs:                      cs,
+		sb:                      sb,
 		dc:                      dc,
+		sc:                      sc,
 		dispatcher:              dispatcher,
 		ds:                      ds,
-		eth1FollowDistance:      eth1FollowDistance,
-		failedBlocks:            make(map[math.Slot]struct{}),
+		el:                      el,
 		subFinalizedBlockEvents: make(chan async.Event[BeaconBlockT]),
 		logger:                  logger,
 		metrics:                 newMetrics(telemetrySink),
@@ -98,9 +126,20 @@ func NewService[
 // Start subscribes the Deposit service to BeaconBlockFinalized events and
 // begins the main event loop to handle them accordingly.
 func (s *Service[
-	_, _, _, _, _,
+	_, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
-	if err := s.dispatcher.Subscribe(
+	var err error
+	if s.blockTag, err = s.cfg.blockNumber(); err != nil {
+		return err
+	}
+	if s.cfg.MaxBlockRange == 0 {
+		return ErrInvalidMaxBlockRange
+	}
+	if err = s.loadSyncCursor(); err != nil {
+		return err
+	}
+
+	if err = s.dispatcher.Subscribe(
 		async.BeaconBlockFinalized, s.subFinalizedBlockEvents,
 	); err != nil {
 		s.logger.Error("failed to subscribe to event", "event",
@@ -108,56 +147,114 @@ func (s *Service[
 		return err
 	}
 
-	// Listen for finalized block events and fetch deposits for the block.
+	// Sync deposits on finalized blocks and periodically in between.
 	go s.eventLoop(ctx)
-
-	// Catchup deposits for failed blocks.
-	go s.depositCatchupFetcher(ctx)
 	return nil
 }
 
-// eventLoop starts the main event loop to listen and handle
-// BeaconBlockFinalized events.
+// eventLoop starts the main event loop. While subscribed, deposits are
+// read and enqueued as execution heads confirm their blocks. Otherwise, they are polled on
+// every BeaconBlockFinalized event and on every tick of the sync interval,
+// which also retries the subscription. Without a persisted sync cursor,
+// nothing is synced until the first BeaconBlockFinalized event seeds it.
 func (s *Service[
-	_, _, _, _, _,
+	_, _, _, _, _, _,
 ]) eventLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.SyncInterval)
+	defer ticker.Stop()
+	defer s.unsubscribe()
+
+	if s.seeded && s.sc != nil {
+		s.subscribe(ctx)
+	}
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case event := <-s.subFinalizedBlockEvents:
-			s.depositFetcher(ctx, event)
+			if !s.seeded {
+				s.seedSyncCursor(event.Context())
+			}
+			if s.seeded && s.sub == nil {
+				s.syncDeposits(ctx)
+			}
+		case <-ticker.C:
+			if !s.seeded {
+				continue
+			}
+			if s.sub == nil {
+				s.syncDeposits(ctx)
+			}
+			if s.sub == nil && s.sc != nil {
+				s.subscribe(ctx)
+			}
+		case streamed := <-s.sub.depositsC():
+			s.sub.add(streamed)
+		case head := <-s.sub.headsC():
+			s.onHead(ctx, head)
+		case err := <-s.sub.depositsErr():
+			s.onSubscriptionError(err)
+		case err := <-s.sub.headsErr():
+			s.onSubscriptionError(err)
 		}
 	}
 }
 
-// Name returns the name of the service.
+// onSubscriptionError falls back to polling after a stream ends.
 func (s *Service[
-	_, _, _, _, _,
-]) Name() string {
-	return "deposit-handler"
+	_, _, _, _, _, _,
+]) onSubscriptionError(err error) {
+	s.logger.Warn(
+		"Deposit subscription dropped, falling back to polling",
+		"error", err,
+	)
+	s.metrics.markSubscriptionFailed()
+	s.unsubscribe()
 }
 
+// Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _,
-]) markFailedBlock(blockNum math.U64) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.failedBlocks[blockNum] = struct{}{}
+	_, _, _, _, _, _,
+]) Name() string {
+	return "deposit-handler"
 }
 
+// loadSyncCursor restores the sync cursor from the deposit store.
 func (s *Service[
-	_, _, _, _, _,
-]) clearFailedBlock(blockNum math.U64) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	delete(s.failedBlocks, blockNum)
+	_, _, _, _, _, _,
+]) loadSyncCursor() error {
+	lastSyncedBlock, nextIndex, ok, err := s.ds.GetSyncCursor()
+	if err != nil || !ok {
+		return err
+	}
+	s.nextBlock = math.U64(lastSyncedBlock + 1)
+	s.nextIndex = math.U64(nextIndex)
+	s.seeded = true
+	s.logger.Info(
+		"Resuming deposit sync",
+		"next_block", s.nextBlock, "next_index", s.nextIndex,
+	)
+	return nil
 }
 
+// seedSyncCursor seeds the sync cursor of a node which never persisted one,
+// e.g. on the first start after an upgrade, from the index of the next
+// deposit of the beacon state of the given context and the block the deposit
+// contract was deployed at. The deposits already processed, and possibly
+// pruned, are thus not enqueued again.
 func (s *Service[
-	_, _, _, _, _,
-]) getFailedBlocks() []math.U64 {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return slices.Collect(maps.Keys(s.failedBlocks))
+	_, _, _, _, _, _,
+]) seedSyncCursor(ctx context.Context) {
+	nextIndex, err := s.sb.StateFromContext(ctx).GetEth1DepositIndex()
+	if err != nil {
+		s.logger.Error("Failed to seed deposit sync", "error", err)
+		return
+	}
+	s.nextBlock = math.U64(s.cs.DepositContractDeployBlock())
+	s.nextIndex = math.U64(nextIndex)
+	s.seeded = true
+	s.logger.Info(
+		"Seeded deposit sync",
+		"next_block", s.nextBlock, "next_index", s.nextIndex,
+	)
 }
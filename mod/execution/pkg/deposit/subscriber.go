@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Log is a deposit streamed from the deposit contract along with its
+// position in the execution chain.
+type Log[DepositT any] struct {
+	// Deposit is the deposit emitted by the log.
+	Deposit DepositT
+	// BlockNumber is the number of the block including the log.
+	BlockNumber math.U64
+	// BlockHash is the hash of the block including the log.
+	BlockHash common.ExecutionHash
+	// Index is the index of the log in the block.
+	Index uint
+	// Removed is true if the log was reverted by a chain reorganization.
+	Removed bool
+}
+
+// Subscriber streams the deposits of the deposit contract and the heads of
+// the execution chain over a websocket or IPC connection to the execution
+// client.
+type Subscriber[
+	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	WithdrawalCredentialsT ~[32]byte,
+] struct {
+	// url is the websocket or IPC endpoint of the execution client.
+	url string
+	// address is the address of the deposit contract.
+	address common.ExecutionAddress
+	// client is the connection to the execution client, dialed on the
+	// first subscription. The connection is re-established by the
+	// underlying client on the next subscription after it drops.
+	client *ethclient.Client
+	// contract is the deposit contract bound to the client.
+	contract *WrappedBeaconDepositContract[DepositT, WithdrawalCredentialsT]
+}
+
+// NewSubscriber creates a new Subscriber for the deposit contract at the
+// given address.
+func NewSubscriber[
+	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	WithdrawalCredentialsT ~[32]byte,
+](
+	url string,
+	address common.ExecutionAddress,
+) *Subscriber[DepositT, WithdrawalCredentialsT] {
+	return &Subscriber[DepositT, WithdrawalCredentialsT]{
+		url:     url,
+		address: address,
+	}
+}
+
+// SubscribeDeposits streams the deposits emitted by the deposit contract,
+// including the ones reverted by chain reorganizations.
+func (s *Subscriber[DepositT, _]) SubscribeDeposits(
+	ctx context.Context,
+	sink chan<- *Log[DepositT],
+) (Subscription, error) {
+	if err := s.connect(ctx); err != nil {
+		return nil, err
+	}
+
+	events := make(chan *deposit.BeaconDepositContractDeposit)
+	sub, err := s.contract.WatchDeposit(
+		&bind.WatchOpts{Context: ctx}, events,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-events:
+				d, err := s.contract.toDeposit(ev)
+				if err != nil {
+					return err
+				}
+				select {
+				case sink <- &Log[DepositT]{
+					Deposit:     d,
+					BlockNumber: math.U64(ev.Raw.BlockNumber),
+					BlockHash:   common.ExecutionHash(ev.Raw.BlockHash),
+					Index:       ev.Raw.Index,
+					Removed:     ev.Raw.Removed,
+				}:
+				case <-quit:
+					return nil
+				}
+			case err = <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// SubscribeNewHead streams the heads of the execution chain.
+func (s *Subscriber[_, _]) SubscribeNewHead(
+	ctx context.Context,
+	sink chan<- *gethprimitives.Header,
+) (Subscription, error) {
+	if err := s.connect(ctx); err != nil {
+		return nil, err
+	}
+	return s.client.SubscribeNewHead(ctx, sink)
+}
+
+// Close closes the connection to the execution client.
+func (s *Subscriber[_, _]) Close() {
+	if s.client != nil {
+		s.client.Close()
+	}
+}
+
+// connect dials the execution client if it was not dialed yet.
+func (s *Subscriber[
+	DepositT, WithdrawalCredentialsT,
+]) connect(ctx context.Context) error {
+	if s.client != nil {
+		return nil
+	}
+
+	client, err := ethclient.Dial(ctx, s.url)
+	if err != nil {
+		return err
+	}
+	contract, err := NewWrappedBeaconDepositContract[
+		DepositT, WithdrawalCredentialsT,
+	](s.address, client)
+	if err != nil {
+		client.Close()
+		return err
+	}
+	s.client, s.contract = client, contract
+	return nil
+}
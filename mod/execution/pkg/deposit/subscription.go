@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"slices"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// subscription holds the streams of deposits and execution heads, and the
+// streamed deposits which are not checked against the deposit contract yet.
+type subscription[DepositT any] struct {
+	// deposits receives the deposits streamed from the deposit contract.
+	deposits chan *Log[DepositT]
+	// heads receives the heads of the execution chain.
+	heads chan *gethprimitives.Header
+	// depositsSub is the stream of deposits.
+	depositsSub Subscription
+	// headsSub is the stream of execution heads.
+	headsSub Subscription
+	// coveredFrom is the first execution block whose deposits are streamed.
+	// Deposits are streamed before heads, so every block from the first
+	// streamed head onward is covered. It is zero until that head arrives.
+	coveredFrom math.U64
+	// pending holds the streamed deposits by block number until the
+	// deposits of their block are read from the deposit contract.
+	pending map[math.U64][]*Log[DepositT]
+}
+
+// depositsC returns the channel of streamed deposits, or nil if sub is nil.
+func (sub *subscription[DepositT]) depositsC() <-chan *Log[DepositT] {
+	if sub == nil {
+		return nil
+	}
+	return sub.deposits
+}
+
+// headsC returns the channel of streamed heads, or nil if sub is nil.
+func (sub *subscription[_]) headsC() <-chan *gethprimitives.Header {
+	if sub == nil {
+		return nil
+	}
+	return sub.heads
+}
+
+// depositsErr returns the error channel of the deposit stream, or nil if sub
+// is nil.
+func (sub *subscription[_]) depositsErr() <-chan error {
+	if sub == nil {
+		return nil
+	}
+	return sub.depositsSub.Err()
+}
+
+// headsErr returns the error channel of the head stream, or nil if sub is
+// nil.
+func (sub *subscription[_]) headsErr() <-chan error {
+	if sub == nil {
+		return nil
+	}
+	return sub.headsSub.Err()
+}
+
+// add buffers a streamed deposit, or drops the buffered deposit it reverts.
+func (sub *subscription[DepositT]) add(log *Log[DepositT]) {
+	if !log.Removed {
+		sub.pending[log.BlockNumber] = append(
+			sub.pending[log.BlockNumber], log,
+		)
+		return
+	}
+	sub.pending[log.BlockNumber] = slices.DeleteFunc(
+		sub.pending[log.BlockNumber],
+		func(l *Log[DepositT]) bool {
+			return l.BlockHash == log.BlockHash && l.Index == log.Index
+		},
+	)
+}
+
+// confirmed returns the buffered deposits of blocks up to the given one.
+func (sub *subscription[DepositT]) confirmed(to math.U64) []DepositT {
+	deposits := make([]DepositT, 0)
+	for number, logs := range sub.pending {
+		if number > to {
+			continue
+		}
+		for _, log := range logs {
+			deposits = append(deposits, log.Deposit)
+		}
+	}
+	return deposits
+}
+
+// prune drops the buffered deposits of blocks up to the given one.
+func (sub *subscription[_]) prune(to math.U64) {
+	for number := range sub.pending {
+		if number <= to {
+			delete(sub.pending, number)
+		}
+	}
+}
+
+// subscribe streams deposits and execution heads from the execution client.
+// The deposit stream is established first, so that no deposit of a block
+// after the first streamed head is missed.
+func (s *Service[
+	_, _, _, DepositT, _, _,
+]) subscribe(ctx context.Context) {
+	sub := &subscription[DepositT]{
+		deposits: make(chan *Log[DepositT]),
+		heads:    make(chan *gethprimitives.Header),
+		pending:  make(map[math.U64][]*Log[DepositT]),
+	}
+
+	var err error
+	if sub.depositsSub, err = s.sc.SubscribeDeposits(
+		ctx, sub.deposits,
+	); err != nil {
+		s.logger.Warn("Failed to subscribe to deposits", "error", err)
+		s.metrics.markSubscriptionFailed()
+		return
+	}
+	if sub.headsSub, err = s.sc.SubscribeNewHead(
+		ctx, sub.heads,
+	); err != nil {
+		sub.depositsSub.Unsubscribe()
+		s.logger.Warn("Failed to subscribe to execution heads", "error", err)
+		s.metrics.markSubscriptionFailed()
+		return
+	}
+
+	s.logger.Info("Subscribed to deposits", "url", s.cfg.SubscriptionURL)
+	s.sub = sub
+}
+
+// unsubscribe ends the streams of deposits and execution heads, after which
+// deposits are polled until the next subscription.
+func (s *Service[
+	_, _, _, _, _, _,
+]) unsubscribe() {
+	if s.sub == nil {
+		return
+	}
+	s.sub.depositsSub.Unsubscribe()
+	s.sub.headsSub.Unsubscribe()
+	s.sub = nil
+}
+
+// onHead enqueues the deposits of every block up to the configured block
+// tag, so that deposits are only persisted once they cannot be reorganized
+// away, and at least ConfirmationDepth blocks below the given head. The
+// deposits of every range are read from the deposit contract before they are
+// enqueued, the streamed ones are only checked against them.
+func (s *Service[
+	_, _, _, _, _, _,
+]) onHead(ctx context.Context, head *gethprimitives.Header) {
+	number := math.U64(head.Number.Uint64())
+	if s.sub.coveredFrom == 0 {
+		s.sub.coveredFrom = number
+	}
+
+	depth := math.U64(s.cfg.ConfirmationDepth)
+	if number < depth {
+		return
+	}
+	header, err := s.el.HeaderByNumber(ctx, s.blockTag)
+	if err != nil {
+		s.logger.Error(
+			"Failed to get execution block header",
+			"tag", s.cfg.BlockTag, "error", err,
+		)
+		s.metrics.markFailedToGetHeader()
+		return
+	}
+	target := min(number-depth, math.U64(header.Number.Uint64()))
+
+	for s.nextBlock <= target {
+		from := s.nextBlock
+		to := min(from+math.U64(s.cfg.MaxBlockRange)-1, target)
+
+		// Heads and deposits are streamed separately, so the deposits of a
+		// block may arrive after a head above it. The deposits of the range
+		// are thus read again before the cursor moves past it.
+		deposits, err := s.dc.ReadDeposits(ctx, from, to)
+		if err != nil {
+			s.metrics.markFailedToGetBlockLogs(from)
+			s.logger.Error(
+				"Failed to sync deposits",
+				"from", from, "to", to, "error", err,
+			)
+			return
+		}
+		if from >= s.sub.coveredFrom {
+			s.checkStreamed(from, to, deposits)
+		}
+
+		if err = s.enqueueRange(from, to, deposits); err != nil {
+			s.logger.Error(
+				"Failed to sync deposits",
+				"from", from, "to", to, "error", err,
+			)
+			return
+		}
+		s.sub.prune(to)
+	}
+}
+
+// checkStreamed reports the streamed deposits of the inclusive block range
+// [from, to] which differ from the ones read from the deposit contract.
+func (s *Service[
+	_, _, _, DepositT, _, _,
+]) checkStreamed(from, to math.U64, deposits []DepositT) {
+	streamed := s.sub.confirmed(to)
+	if len(streamed) == len(deposits) {
+		return
+	}
+	s.logger.Warn(
+		"Streamed deposits differ from the deposit contract",
+		"from", from, "to", to,
+		"streamed", len(streamed), "read", len(deposits),
+	)
+	s.metrics.markStreamMismatch()
+}
@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type testDeposit struct {
+	index math.U64
+}
+
+func (d *testDeposit) New(
+	crypto.BLSPubkey, any, math.U64, crypto.BLSSignature, uint64,
+) *testDeposit {
+	return &testDeposit{}
+}
+
+func (d *testDeposit) GetIndex() math.U64 { return d.index }
+
+type testPayload struct{}
+
+func (testPayload) GetNumber() math.U64 { return 0 }
+
+type testBody struct{}
+
+func (testBody) GetDeposits() []*testDeposit { return nil }
+
+func (testBody) GetExecutionPayload() testPayload { return testPayload{} }
+
+type testBlock struct{}
+
+func (testBlock) GetSlot() math.U64 { return 0 }
+
+func (testBlock) GetBody() testBody { return testBody{} }
+
+type testState struct {
+	nextIndex uint64
+}
+
+func (st testState) GetEth1DepositIndex() (uint64, error) {
+	return st.nextIndex, nil
+}
+
+type testStore struct {
+	deposits        []*testDeposit
+	synced          bool
+	lastSyncedBlock uint64
+	nextIndex       uint64
+}
+
+func (s *testStore) Prune(uint64, uint64) error { return nil }
+
+func (s *testStore) EnqueueDeposits(deposits []*testDeposit) error {
+	s.deposits = append(s.deposits, deposits...)
+	return nil
+}
+
+func (s *testStore) GetSyncCursor() (uint64, uint64, bool, error) {
+	return s.lastSyncedBlock, s.nextIndex, s.synced, nil
+}
+
+func (s *testStore) SetSyncCursor(lastSyncedBlock, nextIndex uint64) error {
+	s.lastSyncedBlock, s.nextIndex = lastSyncedBlock, nextIndex
+	s.synced = true
+	return nil
+}
+
+type testSink struct{}
+
+func (testSink) IncrementCounter(string, ...string) {}
+
+func (testSink) SetGauge(string, int64, ...string) {}
+
+// newSubscribedService returns a service subscribed from the given block,
+// reading the deposits of the contract,
+// whose deposits are enqueued once confirmed by depth blocks and at or below
+// the block tag, which resolves to the given block.
+func newSubscribedService(
+	ds *testStore,
+	dc *testContract,
+	depth, tag uint64,
+	from math.U64,
+) *Service[
+	testBlock, testBody, testState, *testDeposit, testPayload, any,
+] {
+	s := NewService[
+		testBlock, testBody, testState, *testDeposit, testPayload, any,
+	](
+		&Config{
+			BlockTag:          FinalizedBlockTag,
+			MaxBlockRange:     100,
+			ConfirmationDepth: depth,
+		},
+		noop.NewLogger[any](), testSink{},
+		nil, nil, testExecutionClient{tag: tag}, ds, dc, nil, nil,
+	)
+	s.nextBlock = from
+	s.seeded = true
+	s.sub = &subscription[*testDeposit]{
+		pending: make(map[math.U64][]*Log[*testDeposit]),
+	}
+	return s
+}
+
+func streamedDeposit(
+	index, block math.U64, hash byte, removed bool,
+) *Log[*testDeposit] {
+	return &Log[*testDeposit]{
+		Deposit:     &testDeposit{index: index},
+		BlockNumber: block,
+		BlockHash:   common.ExecutionHash{hash},
+		Removed:     removed,
+	}
+}
+
+func head(number uint64) *gethprimitives.Header {
+	return &gethprimitives.Header{Number: new(big.Int).SetUint64(number)}
+}
+
+func TestStreamedDepositsAreEnqueuedAtConfirmationDepth(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{
+		deposits: map[math.U64][]*testDeposit{10: deposits(0)},
+	}
+	s := newSubscribedService(ds, dc, 2, 100, 10)
+	ctx := context.Background()
+
+	s.onHead(ctx, head(10))
+	s.sub.add(streamedDeposit(0, 10, 1, false))
+
+	// The block of the deposit is only one block below the head.
+	s.onHead(ctx, head(11))
+	require.Empty(t, ds.deposits)
+	require.Equal(t, math.U64(10), s.nextBlock)
+
+	s.onHead(ctx, head(12))
+	require.Len(t, ds.deposits, 1)
+	require.Equal(t, math.U64(0), ds.deposits[0].GetIndex())
+	require.Equal(t, uint64(10), ds.lastSyncedBlock)
+	require.Equal(t, uint64(1), ds.nextIndex)
+	require.Empty(t, s.sub.pending)
+}
+
+func TestStreamedDepositsWithoutConfirmationDepth(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{
+		deposits: map[math.U64][]*testDeposit{10: deposits(0)},
+	}
+	s := newSubscribedService(ds, dc, 0, 100, 10)
+
+	s.sub.add(streamedDeposit(0, 10, 1, false))
+	s.onHead(context.Background(), head(10))
+	require.Len(t, ds.deposits, 1)
+	require.Equal(t, uint64(10), ds.lastSyncedBlock)
+}
+
+func TestStreamedDepositsBelowConfirmationDepthFollowReorgs(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{
+		deposits: map[math.U64][]*testDeposit{11: deposits(0)},
+	}
+	s := newSubscribedService(ds, dc, 2, 100, 10)
+	ctx := context.Background()
+
+	s.onHead(ctx, head(10))
+	s.sub.add(streamedDeposit(0, 10, 1, false))
+	s.onHead(ctx, head(11))
+
+	// The block of the deposit is reorganized away and the deposit is
+	// included again in the next block of the new chain.
+	s.sub.add(streamedDeposit(0, 10, 1, true))
+	s.sub.add(streamedDeposit(0, 11, 2, false))
+
+	s.onHead(ctx, head(12))
+	require.Empty(t, ds.deposits)
+	require.Equal(t, uint64(10), ds.lastSyncedBlock)
+
+	s.onHead(ctx, head(13))
+	require.Len(t, ds.deposits, 1)
+	require.Equal(t, math.U64(0), ds.deposits[0].GetIndex())
+	require.Equal(t, uint64(11), ds.lastSyncedBlock)
+	require.Equal(t, uint64(1), ds.nextIndex)
+}
+
+func TestStreamedDepositsRevertedBelowConfirmationDepthAreDropped(
+	t *testing.T,
+) {
+	ds := &testStore{}
+	s := newSubscribedService(
+		ds, &testContract{}, 2, 100, 10)
+	ctx := context.Background()
+
+	s.onHead(ctx, head(10))
+	s.sub.add(streamedDeposit(0, 10, 1, false))
+	s.sub.add(streamedDeposit(0, 10, 1, true))
+
+	s.onHead(ctx, head(14))
+	require.Empty(t, ds.deposits)
+	require.Equal(t, uint64(12), ds.lastSyncedBlock)
+	require.Equal(t, uint64(0), ds.nextIndex)
+}
+
+func TestStreamedDepositsAreOnlyEnqueuedUpToBlockTag(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{
+		deposits: map[math.U64][]*testDeposit{11: deposits(0)},
+	}
+	s := newSubscribedService(ds, dc, 0, 10, 10)
+	ctx := context.Background()
+
+	s.onHead(ctx, head(10))
+	s.sub.add(streamedDeposit(0, 11, 1, false))
+
+	// The block of the deposit is above the finalized block, however deep
+	// it is below the head.
+	s.onHead(ctx, head(20))
+	require.Empty(t, ds.deposits)
+	require.Equal(t, uint64(10), ds.lastSyncedBlock)
+	require.Equal(t, math.U64(11), s.nextBlock)
+
+	s.el = testExecutionClient{tag: 12}
+	s.onHead(ctx, head(21))
+	require.Len(t, ds.deposits, 1)
+	require.Equal(t, uint64(12), ds.lastSyncedBlock)
+	require.Equal(t, uint64(1), ds.nextIndex)
+}
+
+func TestDepositsStreamedAfterTheirHeadAreNotSkipped(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{
+		deposits: map[math.U64][]*testDeposit{10: deposits(0, 1)},
+	}
+	s := newSubscribedService(ds, dc, 0, 100, 10)
+	ctx := context.Background()
+
+	// Only the first deposit of the block is streamed before its head.
+	s.sub.add(streamedDeposit(0, 10, 1, false))
+	s.onHead(ctx, head(10))
+	require.Equal(t, []math.U64{0, 1}, indices(ds.deposits))
+	require.Equal(t, uint64(10), ds.lastSyncedBlock)
+	require.Equal(t, uint64(2), ds.nextIndex)
+	require.Equal(t, [][2]math.U64{{10, 10}}, dc.ranges)
+
+	// The second deposit arrives late and is dropped with its block.
+	s.sub.add(streamedDeposit(1, 10, 1, false))
+	s.onHead(ctx, head(11))
+	require.Len(t, ds.deposits, 2)
+	require.Empty(t, s.sub.pending)
+}
@@ -21,78 +21,149 @@
 package deposit
 
 import (
+	"cmp"
 	"context"
-	"time"
+	"slices"
 
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+// maxGapRetries is the number of times in a row the deposits of a range may
+// skip an index before the gap is reported as unresolved.
+const maxGapRetries = 5
 
-// depositFetcher returns a function that retrieves the block number from the
-// event and fetches and stores the deposits for that block.
+// syncDeposits reads the deposits of every execution block from the cursor
+// up to the configured block tag, in ranges of at most MaxBlockRange blocks.
+// The cursor only advances once the deposits of a range are enqueued, so a
+// failed range is retried from the same block on the next sync.
 func (s *Service[
-	BeaconBlockT, _, _, _, _,
-]) depositFetcher(ctx context.Context, event async.Event[BeaconBlockT]) {
-	blockNum := event.Data().GetBody().GetExecutionPayload().GetNumber()
-	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
-}
+	_, _, _, _, _, _,
+]) syncDeposits(ctx context.Context) {
+	header, err := s.el.HeaderByNumber(ctx, s.blockTag)
+	if err != nil {
+		s.logger.Error(
+			"Failed to get execution block header",
+			"tag", s.cfg.BlockTag, "error", err,
+		)
+		s.metrics.markFailedToGetHeader()
+		return
+	}
+	target := math.U64(header.Number.Uint64())
 
-// depositCatchupFetcher fetches deposits for blocks that failed to be
-// processed.
-func (s *Service[
-	_, _, _, _, _,
-]) depositCatchupFetcher(ctx context.Context) {
-	ticker := time.NewTicker(defaultRetryInterval)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			failedBlks := s.getFailedBlocks()
-			if len(failedBlks) == 0 {
-				continue
-			}
-			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				failedBlks,
+	for s.nextBlock <= target {
+		from := s.nextBlock
+		to := min(from+math.U64(s.cfg.MaxBlockRange)-1, target)
+		if err = s.syncRange(ctx, from, to); err != nil {
+			s.logger.Error(
+				"Failed to sync deposits",
+				"from", from, "to", to, "error", err,
 			)
-
-			// Fetch deposits for blocks that failed to be processed.
-			for _, blockNum := range failedBlks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
-			}
+			return
 		}
 	}
 }
 
+// syncRange reads and enqueues the deposits of the inclusive block range
+// [from, to] and advances the cursor past it.
 func (s *Service[
-	_, _, _, _, _,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+	_, _, _, _, _, _,
+]) syncRange(ctx context.Context, from, to math.U64) error {
+	deposits, err := s.dc.ReadDeposits(ctx, from, to)
 	if err != nil {
-		s.logger.Error("Failed to read deposits", "error", err)
-		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.markFailedBlock(blockNum)
-		return
+		s.metrics.markFailedToGetBlockLogs(from)
+		return err
 	}
+	return s.enqueueRange(from, to, deposits)
+}
 
+// enqueueRange enqueues the deposits of the inclusive block range [from, to]
+// and advances the cursor past it. If the deposits skip an index, only the
+// contiguous ones are enqueued and the cursor stays at the start of the
+// range, so that the range is read again from the first missing deposit.
+func (s *Service[
+	_, _, _, DepositT, _, _,
+]) enqueueRange(from, to math.U64, deposits []DepositT) error {
+	deposits, gapErr := s.orderDeposits(deposits)
 	if len(deposits) > 0 {
 		s.logger.Info(
 			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
+			"from", from, "to", to, "deposits", len(deposits),
 		)
+		if err := s.ds.EnqueueDeposits(deposits); err != nil {
+			return err
+		}
+		s.nextIndex = deposits[len(deposits)-1].GetIndex() + 1
+		s.gapRetries = 0
 	}
 
-	if err = s.ds.EnqueueDeposits(deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
-		s.markFailedBlock(blockNum)
-		return
+	if gapErr != nil {
+		return s.onDepositGap(gapErr)
+	}
+	s.gapRetries = 0
+
+	if err := s.ds.SetSyncCursor(
+		to.Unwrap(), s.nextIndex.Unwrap(),
+	); err != nil {
+		return err
+	}
+	s.nextBlock = to + 1
+	s.metrics.setLastSyncedBlock(to)
+	return nil
+}
+
+// onDepositGap persists the index of the first missing deposit, so that the
+// range is read again from it, and fails with ErrDepositGapUnresolved once
+// the same deposit was found missing maxGapRetries times in a row.
+func (s *Service[
+	_, _, _, _, _, _,
+]) onDepositGap(gapErr error) error {
+	s.metrics.markDepositGap()
+	if s.nextBlock > 0 {
+		if err := s.ds.SetSyncCursor(
+			(s.nextBlock - 1).Unwrap(), s.nextIndex.Unwrap(),
+		); err != nil {
+			return err
+		}
 	}
 
-	s.clearFailedBlock(blockNum)
+	s.gapRetries++
+	if s.gapRetries < maxGapRetries {
+		return gapErr
+	}
+	s.metrics.markDepositGapUnresolved()
+	return errors.Wrapf(
+		ErrDepositGapUnresolved,
+		"deposit %d still missing after %d attempts: %v",
+		s.nextIndex, s.gapRetries, gapErr,
+	)
+}
+
+// orderDeposits sorts the deposits by index and drops the ones which were
+// already enqueued. It returns the deposits continuing the sequence of
+// deposit indices, which starts at zero, and an error if the others skip an
+// index.
+func (s *Service[
+	_, _, _, DepositT, _, _,
+]) orderDeposits(deposits []DepositT) ([]DepositT, error) {
+	slices.SortFunc(deposits, func(a, b DepositT) int {
+		return cmp.Compare(a.GetIndex(), b.GetIndex())
+	})
+
+	// Deposits below the next index were enqueued before the cursor was
+	// last persisted.
+	deposits = slices.DeleteFunc(deposits, func(d DepositT) bool {
+		return d.GetIndex() < s.nextIndex
+	})
+
+	for i, deposit := range deposits {
+		if expected := s.nextIndex + math.U64(i); deposit.GetIndex() != expected {
+			return deposits[:i], errors.Wrapf(
+				ErrDepositGap,
+				"expected deposit index %d, got %d",
+				expected, deposit.GetIndex(),
+			)
+		}
+	}
+	return deposits, nil
 }
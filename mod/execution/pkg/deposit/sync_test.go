@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errReadDeposits = errors.New("failed to read deposits")
+
+// testExecutionClient resolves the block tag to the given block.
+type testExecutionClient struct {
+	tag uint64
+}
+
+func (el testExecutionClient) HeaderByNumber(
+	context.Context, *big.Int,
+) (*gethprimitives.Header, error) {
+	return head(el.tag), nil
+}
+
+// testContract serves the deposits of every block, failing the ranges
+// starting at the blocks of fail once.
+type testContract struct {
+	deposits map[math.U64][]*testDeposit
+	fail     map[math.U64]bool
+	ranges   [][2]math.U64
+}
+
+func (c *testContract) ReadDeposits(
+	_ context.Context, from, to math.U64,
+) ([]*testDeposit, error) {
+	c.ranges = append(c.ranges, [2]math.U64{from, to})
+	if c.fail[from] {
+		delete(c.fail, from)
+		return nil, errReadDeposits
+	}
+
+	var deposits []*testDeposit
+	for block := from; block <= to; block++ {
+		deposits = append(deposits, c.deposits[block]...)
+	}
+	return deposits, nil
+}
+
+type testChainSpec struct {
+	deployBlock uint64
+}
+
+func (cs testChainSpec) DepositContractDeployBlock() uint64 {
+	return cs.deployBlock
+}
+
+type testStorageBackend struct {
+	st testState
+}
+
+func (sb testStorageBackend) StateFromContext(context.Context) testState {
+	return sb.st
+}
+
+// newPollingService returns a service polling the deposits of the contract
+// up to the given block tag, in ranges of at most 10 blocks.
+func newPollingService(
+	ds *testStore, dc *testContract, tag uint64,
+) *Service[
+	testBlock, testBody, testState, *testDeposit, testPayload, any,
+] {
+	return NewService[
+		testBlock, testBody, testState, *testDeposit, testPayload, any,
+	](
+		&Config{BlockTag: FinalizedBlockTag, MaxBlockRange: 10},
+		noop.NewLogger[any](), testSink{},
+		testChainSpec{deployBlock: 1},
+		testStorageBackend{st: testState{nextIndex: 0}},
+		testExecutionClient{tag: tag}, ds, dc, nil, nil,
+	)
+}
+
+func deposits(indices ...math.U64) []*testDeposit {
+	ds := make([]*testDeposit, 0, len(indices))
+	for _, index := range indices {
+		ds = append(ds, &testDeposit{index: index})
+	}
+	return ds
+}
+
+func indices(ds []*testDeposit) []math.U64 {
+	is := make([]math.U64, 0, len(ds))
+	for _, d := range ds {
+		is = append(is, d.GetIndex())
+	}
+	return is
+}
+
+func TestSyncCursorIsLoadedOrSeeded(t *testing.T) {
+	ds := &testStore{}
+	s := newPollingService(ds, &testContract{}, 0)
+	s.sb = testStorageBackend{st: testState{nextIndex: 7}}
+	s.cs = testChainSpec{deployBlock: 100}
+
+	// Without a persisted cursor, it is seeded from the beacon state.
+	require.NoError(t, s.loadSyncCursor())
+	require.False(t, s.seeded)
+	s.seedSyncCursor(context.Background())
+	require.True(t, s.seeded)
+	require.Equal(t, math.U64(100), s.nextBlock)
+	require.Equal(t, math.U64(7), s.nextIndex)
+
+	require.NoError(t, ds.SetSyncCursor(41, 3))
+	s = newPollingService(ds, &testContract{}, 0)
+	require.NoError(t, s.loadSyncCursor())
+	require.True(t, s.seeded)
+	require.Equal(t, math.U64(42), s.nextBlock)
+	require.Equal(t, math.U64(3), s.nextIndex)
+}
+
+func TestSyncDepositsUpToTheBlockTagInRanges(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{deposits: map[math.U64][]*testDeposit{
+		3: deposits(0), 15: deposits(2, 1), 24: deposits(3), 26: deposits(4),
+	}}
+	s := newPollingService(ds, dc, 25)
+	s.nextBlock = 1
+
+	s.syncDeposits(context.Background())
+	require.Equal(t, [][2]math.U64{{1, 10}, {11, 20}, {21, 25}}, dc.ranges)
+	require.Equal(t, []math.U64{0, 1, 2, 3}, indices(ds.deposits))
+	require.Equal(t, uint64(25), ds.lastSyncedBlock)
+	require.Equal(t, uint64(4), ds.nextIndex)
+	require.Equal(t, math.U64(26), s.nextBlock)
+}
+
+func TestSyncDepositsRetriesAFailedRange(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{
+		deposits: map[math.U64][]*testDeposit{
+			3: deposits(0), 15: deposits(1),
+		},
+		fail: map[math.U64]bool{11: true},
+	}
+	s := newPollingService(ds, dc, 20)
+	s.nextBlock = 1
+
+	s.syncDeposits(context.Background())
+	require.Equal(t, []math.U64{0}, indices(ds.deposits))
+	require.Equal(t, uint64(10), ds.lastSyncedBlock)
+	require.Equal(t, math.U64(11), s.nextBlock)
+
+	s.syncDeposits(context.Background())
+	require.Equal(t, [][2]math.U64{{1, 10}, {11, 20}, {11, 20}}, dc.ranges)
+	require.Equal(t, []math.U64{0, 1}, indices(ds.deposits))
+	require.Equal(t, uint64(20), ds.lastSyncedBlock)
+	require.Equal(t, uint64(2), ds.nextIndex)
+}
+
+func TestSyncDepositsSkipsEnqueuedDeposits(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{deposits: map[math.U64][]*testDeposit{
+		3: deposits(0, 1), 5: deposits(2),
+	}}
+	s := newPollingService(ds, dc, 10)
+	s.nextBlock, s.nextIndex = 1, 1
+
+	s.syncDeposits(context.Background())
+	require.Equal(t, []math.U64{1, 2}, indices(ds.deposits))
+	require.Equal(t, uint64(3), ds.nextIndex)
+}
+
+func TestSyncRangeResumesFromDepositGap(t *testing.T) {
+	ds := &testStore{}
+	dc := &testContract{deposits: map[math.U64][]*testDeposit{
+		3: deposits(0), 5: deposits(2),
+	}}
+	s := newPollingService(ds, dc, 10)
+	s.nextBlock = 1
+	ctx := context.Background()
+
+	// The deposits before the gap are enqueued, and the range is read again
+	// from the first missing deposit.
+	err := s.syncRange(ctx, 1, 10)
+	require.ErrorIs(t, err, ErrDepositGap)
+	require.Equal(t, []math.U64{0}, indices(ds.deposits))
+	require.Equal(t, uint64(0), ds.lastSyncedBlock)
+	require.Equal(t, uint64(1), ds.nextIndex)
+	require.Equal(t, math.U64(1), s.nextBlock)
+
+	for range maxGapRetries - 2 {
+		require.ErrorIs(t, s.syncRange(ctx, 1, 10), ErrDepositGap)
+	}
+	err = s.syncRange(ctx, 1, 10)
+	require.ErrorIs(t, err, ErrDepositGapUnresolved)
+
+	// The gap is resolved once the missing deposit is read.
+	dc.deposits[4] = deposits(1)
+	require.NoError(t, s.syncRange(ctx, 1, 10))
+	require.Equal(t, []math.U64{0, 1, 2}, indices(ds.deposits))
+	require.Equal(t, uint64(10), ds.lastSyncedBlock)
+	require.Equal(t, uint64(3), ds.nextIndex)
+	require.Zero(t, s.gapRetries)
+}
@@ -22,6 +22,9 @@ package deposit
 
 import (
 	"context"
+	"math/big"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -36,6 +39,25 @@ type BeaconBlockBody[
 	GetExecutionPayload() ExecutionPayloadT
 }
 
+// BeaconState is the beacon state the sync cursor is seeded from.
+type BeaconState interface {
+	// GetEth1DepositIndex returns the index of the next deposit to process.
+	GetEth1DepositIndex() (uint64, error)
+}
+
+// StorageBackend is the interface for the storage backend.
+type StorageBackend[BeaconStateT any] interface {
+	// StateFromContext retrieves the beacon state from the context.
+	StateFromContext(context.Context) BeaconStateT
+}
+
+// ChainSpec is the chain spec of the deposit service.
+type ChainSpec interface {
+	// DepositContractDeployBlock returns the execution block the deposit
+	// contract was deployed at.
+	DepositContractDeployBlock() uint64
+}
+
 // BeaconBlock is an interface for beacon blocks.
 type BeaconBlock[BeaconBlockBodyT any] interface {
 	GetSlot() math.U64
@@ -62,13 +84,47 @@ type ExecutionPayload interface {
 
 // Contract is the ABI for the deposit contract.
 type Contract[DepositT any] interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads deposits from the deposit contract in the inclusive
+	// block range [from, to].
 	ReadDeposits(
 		ctx context.Context,
-		blockNumber math.U64,
+		from, to math.U64,
 	) ([]DepositT, error)
 }
 
+// ExecutionClient is the interface for reading execution block headers.
+type ExecutionClient interface {
+	// HeaderByNumber returns the header of the given block number or tag.
+	HeaderByNumber(
+		ctx context.Context,
+		number *big.Int,
+	) (*gethprimitives.Header, error)
+}
+
+// SubscriptionClient is the interface for streaming deposits and execution
+// heads from the execution client.
+type SubscriptionClient[DepositT any] interface {
+	// SubscribeDeposits streams the deposits emitted by the deposit
+	// contract.
+	SubscribeDeposits(
+		ctx context.Context,
+		sink chan<- *Log[DepositT],
+	) (Subscription, error)
+	// SubscribeNewHead streams the heads of the execution chain.
+	SubscribeNewHead(
+		ctx context.Context,
+		sink chan<- *gethprimitives.Header,
+	) (Subscription, error)
+}
+
+// Subscription is a stream of events from the execution client.
+type Subscription interface {
+	// Err returns a channel which receives the error ending the stream.
+	Err() <-chan error
+	// Unsubscribe ends the stream.
+	Unsubscribe()
+}
+
 // Deposit is an interface for deposits.
 type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 	// New creates a new deposit.
@@ -89,6 +145,12 @@ type Store[DepositT any] interface {
 	Prune(index uint64, numPrune uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetSyncCursor returns the last execution block whose deposits were
+	// enqueued and the index of the next expected deposit. It returns false
+	// if deposits were never synced.
+	GetSyncCursor() (uint64, uint64, bool, error)
+	// SetSyncCursor persists the sync cursor.
+	SetSyncCursor(lastSyncedBlock, nextIndex uint64) error
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -96,4 +158,7 @@ type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
 	// keys.
 	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 }
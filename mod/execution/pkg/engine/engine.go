@@ -49,6 +49,8 @@ type Engine[
 	ec *client.EngineClient[ExecutionPayloadT, PayloadAttributesT]
 	// logger is the logger for the engine.
 	logger log.Logger
+	// syncObserver observes the sync status of the execution client.
+	syncObserver SyncObserver
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
 }
@@ -65,6 +67,7 @@ func New[
 ](
 	engineClient *client.EngineClient[ExecutionPayloadT, PayloadAttributesT],
 	logger log.Logger,
+	syncObserver SyncObserver,
 	telemtrySink TelemetrySink,
 ) *Engine[
 	ExecutionPayloadT, PayloadAttributesT,
@@ -74,9 +77,10 @@ func New[
 		ExecutionPayloadT, PayloadAttributesT, PayloadIDT,
 		WithdrawalsT,
 	]{
-		ec:      engineClient,
-		logger:  logger,
-		metrics: newEngineMetrics(telemtrySink, logger),
+		ec:           engineClient,
+		logger:       logger,
+		syncObserver: syncObserver,
+		metrics:      newEngineMetrics(telemtrySink, logger),
 	}
 }
 
@@ -124,6 +128,7 @@ func (ee *Engine[
 		req.PayloadAttributes,
 		req.ForkVersion,
 	)
+	ee.syncObserver.ObserveForkchoiceUpdate(err)
 
 	switch {
 	// We do not bubble the error up, since we want to handle it
@@ -211,6 +216,7 @@ func (ee *Engine[
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
 	)
+	ee.syncObserver.ObserveNewPayload(err)
 
 	// We abstract away some of the complexity and categorize status codes
 	// to make it easier to reason about.
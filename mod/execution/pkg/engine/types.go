@@ -50,6 +50,14 @@ type ExecutionPayload[ExecutionPayloadT, WithdrawalsT any] interface {
 	GetTransactions() engineprimitives.Transactions
 }
 
+// SyncObserver observes the sync status the execution client reports.
+type SyncObserver interface {
+	// ObserveForkchoiceUpdate observes the outcome of a forkchoice update.
+	ObserveForkchoiceUpdate(err error)
+	// ObserveNewPayload observes the outcome of a new payload.
+	ObserveNewPayload(err error)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
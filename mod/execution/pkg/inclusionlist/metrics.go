@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package inclusionlist
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// metrics is a struct that contains metrics for the inclusion lists.
+type metrics struct {
+	// sink is the telemetry sink.
+	sink TelemetrySink
+}
+
+// newMetrics creates a new instance of the metrics struct.
+func newMetrics(sink TelemetrySink) *metrics {
+	return &metrics{
+		sink: sink,
+	}
+}
+
+// markInvalidExtension increments the counter for vote extensions rejected
+// for not holding a valid inclusion list.
+func (m *metrics) markInvalidExtension() {
+	m.sink.IncrementCounter(
+		"beacon_kit.execution.inclusion_list.invalid_extension",
+	)
+}
+
+// markUnsupported increments the counter for inclusion lists that could not
+// be built since the execution client does not support them.
+func (m *metrics) markUnsupported() {
+	m.sink.IncrementCounter(
+		"beacon_kit.execution.inclusion_list.unsupported",
+	)
+}
+
+// markUnenforceable increments the counter for inclusion list transactions
+// not enforced since the execution client failed to return the state of
+// their sender.
+func (m *metrics) markUnenforceable() {
+	m.sink.IncrementCounter(
+		"beacon_kit.execution.inclusion_list.unenforceable",
+	)
+}
+
+// markUnsatisfied increments the counter for payloads rejected for omitting
+// inclusion list transactions.
+func (m *metrics) markUnsatisfied(slot math.Slot) {
+	m.sink.IncrementCounter(
+		"beacon_kit.execution.inclusion_list.unsatisfied",
+		"slot", slot.Base10(),
+	)
+}
@@ -0,0 +1,348 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package inclusionlist
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// retainedHeights is the number of heights the inclusion lists are kept for.
+const retainedHeights = 2
+
+// commit holds the inclusion lists of the validators whose precommits are
+// part of the commit of a height.
+type commit struct {
+	// lists are the inclusion lists by validator address.
+	lists map[string]*engineprimitives.InclusionList
+	// powers are the voting powers by validator address.
+	powers map[string]int64
+	// totalPower is the voting power of the validator set of the height.
+	totalPower int64
+}
+
+// Store keeps the inclusion lists of the commits carried by blocks, and
+// checks the execution payloads of the next height against them. The
+// inclusion lists only depend on the block, so that all nodes agree on
+// whether its payload satisfies them.
+type Store[ExecutionPayloadT ExecutionPayload] struct {
+	// cfg is the configuration of the inclusion lists.
+	cfg *Config
+	// logger is the logger of the store.
+	logger log.Logger
+	// client is the execution client.
+	client ExecutionClient
+	// decode returns the execution payload of the block being precommitted.
+	decode PayloadDecoder[ExecutionPayloadT]
+	// signer recovers the senders of the transactions of the chain.
+	signer types.Signer
+	// metrics is the metrics of the store.
+	metrics *metrics
+	// mu protects commits.
+	mu sync.RWMutex
+	// commits are the commits by height.
+	commits map[int64]*commit
+}
+
+// NewStore creates a new inclusion list store for the execution chain of the
+// given chain ID.
+func NewStore[ExecutionPayloadT ExecutionPayload](
+	cfg *Config,
+	logger log.Logger,
+	client ExecutionClient,
+	decode PayloadDecoder[ExecutionPayloadT],
+	eth1ChainID uint64,
+	telemetrySink TelemetrySink,
+) *Store[ExecutionPayloadT] {
+	return &Store[ExecutionPayloadT]{
+		cfg:    cfg,
+		logger: logger,
+		client: client,
+		decode: decode,
+		signer: types.LatestSignerForChainID(
+			new(big.Int).SetUint64(eth1ChainID),
+		),
+		metrics: newMetrics(telemetrySink),
+		commits: make(map[int64]*commit),
+	}
+}
+
+// ExtendVote returns the vote extension of the precommit of the given
+// validator for the block of the given height carrying the given
+// transactions, which holds the inclusion list built by the execution client
+// on top of the payload of that block. The precommit is not extended if the
+// payload cannot be decoded or the execution client fails to build an
+// inclusion list.
+func (s *Store[_]) ExtendVote(
+	ctx context.Context,
+	height int64,
+	blockTxs [][]byte,
+	_ []byte,
+) []byte {
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	//#nosec:G115 // heights are positive.
+	payload, err := s.decode(math.Slot(height), blockTxs)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to decode payload to build inclusion list on, "+
+				"not extending vote",
+			"height", height,
+			"error", err,
+		)
+		return nil
+	}
+
+	txs, err := s.client.GetInclusionList(ctx, payload.GetBlockHash())
+	if err != nil {
+		s.logger.Warn(
+			"Failed to build inclusion list, not extending vote",
+			"height", height,
+			"error", err,
+		)
+		if errors.Is(err, client.ErrInclusionListsUnsupported) {
+			s.metrics.markUnsupported()
+		}
+		return nil
+	}
+
+	list := &engineprimitives.InclusionList{Transactions: trim(txs)}
+	bz, err := list.MarshalSSZ()
+	if err != nil {
+		s.logger.Error(
+			"Failed to marshal inclusion list", "error", err,
+		)
+		return nil
+	}
+	return bz
+}
+
+// VerifyVoteExtension returns an error if the vote extension of a precommit
+// does not hold a valid inclusion list.
+func (s *Store[_]) VerifyVoteExtension(
+	_ int64,
+	_ []byte,
+	extension []byte,
+) error {
+	if len(extension) == 0 {
+		return nil
+	}
+
+	if err := new(engineprimitives.InclusionList).
+		UnmarshalSSZ(extension); err != nil {
+		s.metrics.markInvalidExtension()
+		return err
+	}
+	return nil
+}
+
+// SetCommit records the validators whose precommits are part of the commit
+// of the given height, along with their voting powers and vote extensions,
+// and the voting power of the validator set of the height. The inclusion
+// lists of these validators apply to the payload of the next height.
+func (s *Store[_]) SetCommit(
+	height int64,
+	validatorAddresses [][]byte,
+	powers []int64,
+	extensions [][]byte,
+	totalPower int64,
+) {
+	c := &commit{
+		lists:      make(map[string]*engineprimitives.InclusionList),
+		powers:     make(map[string]int64, len(validatorAddresses)),
+		totalPower: totalPower,
+	}
+	for i, validatorAddress := range validatorAddresses {
+		if i < len(powers) {
+			c.powers[string(validatorAddress)] = powers[i]
+		}
+		if i >= len(extensions) || len(extensions[i]) == 0 {
+			continue
+		}
+		list := new(engineprimitives.InclusionList)
+		if err := list.UnmarshalSSZ(extensions[i]); err != nil {
+			continue
+		}
+		c.lists[string(validatorAddress)] = list
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prune(height)
+	s.commits[height] = c
+}
+
+// Transactions returns the transactions of the inclusion lists that apply
+// to the payload of the given slot, without duplicates and in the order of
+// the validator addresses. Only the transactions listed by validators
+// holding more than a third of the voting power apply, so that validators
+// not holding it cannot require transactions that no longer are valid.
+func (s *Store[_]) Transactions(slot math.Slot) [][]byte {
+	if slot == 0 {
+		return nil
+	}
+
+	//#nosec:G701 // slots are heights.
+	height := int64(slot.Unwrap()) - 1
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, found := s.commits[height]
+	if !found {
+		return nil
+	}
+	validators := make([]string, 0, len(c.lists))
+	for validator := range c.lists {
+		validators = append(validators, validator)
+	}
+	slices.Sort(validators)
+
+	var (
+		txs    [][]byte
+		listed = make(map[string]int64)
+	)
+	for _, validator := range validators {
+		seen := make(map[string]struct{})
+		for _, tx := range c.lists[validator].Transactions {
+			if _, ok := seen[string(tx)]; ok {
+				continue
+			}
+			seen[string(tx)] = struct{}{}
+			if _, ok := listed[string(tx)]; !ok {
+				txs = append(txs, tx)
+			}
+			listed[string(tx)] += c.powers[validator]
+		}
+	}
+
+	//nolint:mnd // a third of the voting power.
+	return slices.DeleteFunc(txs, func(tx []byte) bool {
+		return listed[string(tx)]*3 <= c.totalPower
+	})
+}
+
+// Supported returns true if the execution client builds payloads with the
+// inclusion list transactions they must include.
+func (s *Store[_]) Supported() bool {
+	return s.client.SupportsInclusionLists()
+}
+
+// VerifyPayload returns an error if the payload of the given slot omits a
+// transaction of the inclusion lists that apply to it, while it could be
+// included in the payload. Payloads without transactions are exempt.
+func (s *Store[ExecutionPayloadT]) VerifyPayload(
+	ctx context.Context,
+	slot math.Slot,
+	payload ExecutionPayloadT,
+) error {
+	err := s.verifyPayload(ctx, slot, payload)
+	if errors.Is(err, ErrInclusionListUnsatisfied) {
+		s.metrics.markUnsatisfied(slot)
+	}
+	return err
+}
+
+// verifyPayload returns an error if the payload of the given slot omits a
+// transaction of the inclusion lists that apply to it, while it could be
+// included in the payload. Payloads without transactions are exempt: they
+// are what proposers fall back to when their execution client fails to
+// build a payload in time, and, forgoing every fee, they cannot omit
+// transactions selectively.
+func (s *Store[ExecutionPayloadT]) verifyPayload(
+	ctx context.Context,
+	slot math.Slot,
+	payload ExecutionPayloadT,
+) error {
+	if len(payload.GetTransactions()) == 0 {
+		return nil
+	}
+	required := s.Transactions(slot)
+	if len(required) == 0 {
+		return nil
+	}
+
+	included := make(map[string]struct{}, len(payload.GetTransactions()))
+	senders := make(map[[20]byte]struct{})
+	for _, raw := range payload.GetTransactions() {
+		included[string(raw)] = struct{}{}
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			continue
+		}
+		if sender, err := types.Sender(s.signer, tx); err == nil {
+			senders[sender] = struct{}{}
+		}
+	}
+
+	for _, raw := range required {
+		if _, ok := included[string(raw)]; ok {
+			continue
+		}
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			continue
+		}
+		if s.isIncludable(ctx, payload, tx, senders) {
+			return errors.Wrapf(
+				ErrInclusionListUnsatisfied,
+				"payload omits transaction %s", tx.Hash(),
+			)
+		}
+	}
+	return nil
+}
+
+// prune drops the commits of the heights no longer needed once the commit
+// of the given height is known.
+func (s *Store[_]) prune(height int64) {
+	for h := range s.commits {
+		if h <= height-retainedHeights {
+			delete(s.commits, h)
+		}
+	}
+}
+
+// trim drops the transactions exceeding the limits of an inclusion list.
+func trim(txs [][]byte) [][]byte {
+	var (
+		trimmed = make([][]byte, 0, len(txs))
+		size    int
+	)
+	for _, tx := range txs {
+		if len(trimmed) == engineprimitives.MaxTransactionsPerInclusionList ||
+			size+len(tx) > engineprimitives.MaxBytesPerInclusionList {
+			break
+		}
+		trimmed = append(trimmed, tx)
+		size += len(tx)
+	}
+	return trimmed
+}
@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package inclusionlist_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/inclusionlist"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chainID = 80087
+	// txCost is the cost of the transfers of the tests.
+	txCost = 21_000 * 2
+)
+
+type payload struct {
+	hash common.ExecutionHash
+	txs  engineprimitives.Transactions
+}
+
+func (p payload) GetBlockHash() common.ExecutionHash { return p.hash }
+
+func (p payload) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (p payload) GetTransactions() engineprimitives.Transactions {
+	return p.txs
+}
+
+func (p payload) GetGasLimit() math.U64 { return 30_000_000 }
+
+func (p payload) GetGasUsed() math.U64 { return 0 }
+
+func (p payload) GetBaseFeePerGas() *math.U256 { return math.NewU256(1) }
+
+// executionClient is an execution client whose accounts have a nonce of
+// zero and the given balance, failing to return them if err is set.
+type executionClient struct {
+	balance    int64
+	err        error
+	parentHash common.ExecutionHash
+	list       [][]byte
+}
+
+func (c *executionClient) GetInclusionList(
+	_ context.Context, parentHash common.ExecutionHash,
+) ([][]byte, error) {
+	c.parentHash = parentHash
+	return c.list, nil
+}
+
+func (c *executionClient) SupportsInclusionLists() bool { return true }
+
+func (c *executionClient) NonceAt(
+	context.Context, common.ExecutionAddress, common.ExecutionHash,
+) (math.U64, error) {
+	return 0, c.err
+}
+
+func (c *executionClient) BalanceAt(
+	context.Context, common.ExecutionAddress, common.ExecutionHash,
+) (*big.Int, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return big.NewInt(c.balance), nil
+}
+
+// decodePayload decodes the payload of a block from its first transaction,
+// holding its block hash.
+func decodePayload(_ math.Slot, txs [][]byte) (payload, error) {
+	if len(txs) == 0 {
+		return payload{}, errors.New("missing block")
+	}
+	return payload{hash: common.ExecutionHash(txs[0])}, nil
+}
+
+type telemetrySink struct{}
+
+func (telemetrySink) IncrementCounter(string, ...string) {}
+
+// newTx returns a transfer signed by a new account.
+func newTx(t *testing.T) []byte {
+	t.Helper()
+	return newTxWithNonce(t, 0)
+}
+
+// newTxWithNonce returns a transfer of the given nonce signed by a new
+// account.
+func newTxWithNonce(t *testing.T, nonce uint64) []byte {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx, err := types.SignNewTx(
+		key,
+		types.LatestSignerForChainID(big.NewInt(chainID)),
+		&types.DynamicFeeTx{
+			ChainID:   big.NewInt(chainID),
+			Nonce:     nonce,
+			To:        &gethcommon.Address{},
+			Gas:       21_000,
+			GasFeeCap: big.NewInt(2),
+			GasTipCap: big.NewInt(1),
+		},
+	)
+	require.NoError(t, err)
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return bz
+}
+
+func newExtension(t *testing.T, txs ...[]byte) []byte {
+	t.Helper()
+	bz, err := (&engineprimitives.InclusionList{Transactions: txs}).
+		MarshalSSZ()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestStoreRequiresTransactionsOfAThirdOfThePower(t *testing.T) {
+	store := inclusionlist.NewStore[payload](
+		&inclusionlist.Config{}, noop.NewLogger[any](),
+		&executionClient{balance: txCost}, decodePayload, chainID,
+		telemetrySink{},
+	)
+	minority, majority := newTx(t), newTx(t)
+	store.SetCommit(
+		1,
+		[][]byte{{0x01}, {0x02}, {0x03}},
+		[]int64{10, 10, 10},
+		[][]byte{
+			newExtension(t, minority),
+			newExtension(t, majority),
+			newExtension(t, majority),
+		},
+		30,
+	)
+
+	require.Equal(t, [][]byte{majority}, store.Transactions(2))
+
+	err := store.VerifyPayload(context.Background(), 2, payload{
+		txs: engineprimitives.Transactions{minority},
+	})
+	require.ErrorIs(t, err, inclusionlist.ErrInclusionListUnsatisfied)
+	require.NoError(t, store.VerifyPayload(
+		context.Background(), 2, payload{
+			txs: engineprimitives.Transactions{majority},
+		},
+	))
+}
+
+func TestStoreExtendsVoteOnPrecommittedBlock(t *testing.T) {
+	tx := newTx(t)
+	client := &executionClient{list: [][]byte{tx}}
+	store := inclusionlist.NewStore[payload](
+		&inclusionlist.Config{Enabled: true}, noop.NewLogger[any](),
+		client, decodePayload, chainID, telemetrySink{},
+	)
+
+	hash := common.ExecutionHash{0x01}
+	require.Equal(
+		t,
+		newExtension(t, tx),
+		store.ExtendVote(
+			context.Background(), 1, [][]byte{hash[:]}, []byte{0x01},
+		),
+	)
+	require.Equal(t, hash, client.parentHash)
+
+	require.Nil(t, store.ExtendVote(
+		context.Background(), 1, nil, []byte{0x01},
+	))
+}
+
+func TestStoreOnlyRequiresTransactionsValidInParentState(t *testing.T) {
+	tests := []struct {
+		name     string
+		nonce    uint64
+		balance  int64
+		required bool
+	}{
+		{name: "next nonce and funded", balance: txCost, required: true},
+		{name: "future nonce", nonce: 1, balance: txCost},
+		{name: "insufficient balance", balance: txCost - 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := inclusionlist.NewStore[payload](
+				&inclusionlist.Config{}, noop.NewLogger[any](),
+				&executionClient{balance: tt.balance}, decodePayload,
+				chainID, telemetrySink{},
+			)
+			tx := newTxWithNonce(t, tt.nonce)
+			store.SetCommit(
+				1, [][]byte{{0x01}}, []int64{10},
+				[][]byte{newExtension(t, tx)}, 10,
+			)
+
+			err := store.VerifyPayload(context.Background(), 2, payload{
+				txs: engineprimitives.Transactions{newTx(t)},
+			})
+			if tt.required {
+				require.ErrorIs(
+					t, err, inclusionlist.ErrInclusionListUnsatisfied,
+				)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStoreDoesNotEnforceTransactionsWithoutSenderState(t *testing.T) {
+	client := &executionClient{balance: txCost}
+	store := inclusionlist.NewStore[payload](
+		&inclusionlist.Config{}, noop.NewLogger[any](),
+		client, decodePayload, chainID, telemetrySink{},
+	)
+	store.SetCommit(
+		1, [][]byte{{0x01}}, []int64{10},
+		[][]byte{newExtension(t, newTx(t))}, 10,
+	)
+	filled := payload{txs: engineprimitives.Transactions{newTx(t)}}
+
+	// A payload is never rejected because the execution client failed to
+	// return the state of a sender.
+	client.err = errors.New("execution client unavailable")
+	require.NoError(t, store.VerifyPayload(
+		context.Background(), 2, filled,
+	))
+
+	client.err = nil
+	require.ErrorIs(
+		t,
+		store.VerifyPayload(context.Background(), 2, filled),
+		inclusionlist.ErrInclusionListUnsatisfied,
+	)
+}
+
+func TestStoreExemptsEmptyPayloads(t *testing.T) {
+	store := inclusionlist.NewStore[payload](
+		&inclusionlist.Config{}, noop.NewLogger[any](),
+		&executionClient{balance: txCost}, decodePayload, chainID,
+		telemetrySink{},
+	)
+	store.SetCommit(
+		1, [][]byte{{0x01}}, []int64{10},
+		[][]byte{newExtension(t, newTx(t))}, 10,
+	)
+
+	// The empty payload proposers fall back to satisfies the inclusion
+	// lists, while a filled payload omitting their transactions does not.
+	require.NoError(t, store.VerifyPayload(
+		context.Background(), 2, payload{},
+	))
+	require.ErrorIs(
+		t,
+		store.VerifyPayload(context.Background(), 2, payload{
+			txs: engineprimitives.Transactions{newTx(t)},
+		}),
+		inclusionlist.ErrInclusionListUnsatisfied,
+	)
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package inclusionlist
+
+import (
+	"context"
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ExecutionClient is the execution client inclusion lists are built with.
+type ExecutionClient interface {
+	// GetInclusionList returns the transactions of the mempool the execution
+	// client requires the payload built on top of the given block to
+	// include.
+	GetInclusionList(
+		ctx context.Context, parentHash common.ExecutionHash,
+	) ([][]byte, error)
+	// SupportsInclusionLists returns true if the execution client builds
+	// inclusion lists and payloads satisfying them.
+	SupportsInclusionLists() bool
+	// NonceAt returns the nonce of the account in the state of the given
+	// block.
+	NonceAt(
+		ctx context.Context,
+		account common.ExecutionAddress,
+		blockHash common.ExecutionHash,
+	) (math.U64, error)
+	// BalanceAt returns the balance of the account in the state of the given
+	// block.
+	BalanceAt(
+		ctx context.Context,
+		account common.ExecutionAddress,
+		blockHash common.ExecutionHash,
+	) (*big.Int, error)
+}
+
+// PayloadDecoder returns the execution payload of the block of the given
+// slot carried by the transactions of a CometBFT block.
+type PayloadDecoder[ExecutionPayloadT any] func(
+	slot math.Slot, txs [][]byte,
+) (ExecutionPayloadT, error)
+
+// ExecutionPayload is the execution payload checked against the inclusion
+// lists.
+type ExecutionPayload interface {
+	// GetBlockHash returns the block hash.
+	GetBlockHash() common.ExecutionHash
+	// GetParentHash returns the hash of the parent block.
+	GetParentHash() common.ExecutionHash
+	// GetTransactions returns the transactions.
+	GetTransactions() engineprimitives.Transactions
+	// GetGasLimit returns the gas limit.
+	GetGasLimit() math.U64
+	// GetGasUsed returns the gas used.
+	GetGasUsed() math.U64
+	// GetBaseFeePerGas returns the base fee per gas.
+	GetBaseFeePerGas() *math.U256
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+}
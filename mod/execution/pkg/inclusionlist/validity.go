@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package inclusionlist
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// isIncludable returns true if the transaction could have been included in
+// the payload. It only depends on the transaction, the payload and the state
+// of the parent of the payload, so that all nodes come to the same result.
+// Transactions of accounts with transactions in the payload are never
+// required, since the payload may have changed their nonce or balance.
+// Transactions whose sender state cannot be read from the execution client
+// are not required either, so that a failing execution client never makes
+// a node reject a block its peers accept.
+func (s *Store[ExecutionPayloadT]) isIncludable(
+	ctx context.Context,
+	payload ExecutionPayloadT,
+	tx *types.Transaction,
+	senders map[[20]byte]struct{},
+) bool {
+	// Blob transactions depend on their sidecars, which inclusion lists do
+	// not carry.
+	if tx.Type() == types.BlobTxType {
+		return false
+	}
+
+	// The payload must have room for the transaction.
+	if payload.GetGasUsed().Unwrap()+tx.Gas() >
+		payload.GetGasLimit().Unwrap() {
+		return false
+	}
+
+	// The transaction must pay the base fee of the payload.
+	if baseFee := payload.GetBaseFeePerGas(); baseFee != nil &&
+		tx.GasFeeCap().Cmp(baseFee.ToBig()) < 0 {
+		return false
+	}
+	if tx.GasFeeCap().Cmp(tx.GasTipCap()) < 0 {
+		return false
+	}
+
+	// The transaction must pay for its intrinsic gas.
+	intrinsicGas, err := core.IntrinsicGas(
+		tx.Data(), tx.AccessList(), tx.To() == nil, true, true, true,
+	)
+	if err != nil || tx.Gas() < intrinsicGas {
+		return false
+	}
+
+	// The transaction must be signed for the chain, by an account without
+	// transactions in the payload.
+	sender, err := types.Sender(s.signer, tx)
+	if err != nil {
+		return false
+	}
+	if _, ok := senders[sender]; ok {
+		return false
+	}
+
+	// The transaction must be the next one of the sender, and the sender
+	// must afford it, in the state of the parent of the payload.
+	nonce, err := s.client.NonceAt(
+		ctx, common.ExecutionAddress(sender), payload.GetParentHash(),
+	)
+	if err != nil {
+		s.onUnenforceable(tx, err)
+		return false
+	}
+	if tx.Nonce() != nonce.Unwrap() {
+		return false
+	}
+	balance, err := s.client.BalanceAt(
+		ctx, common.ExecutionAddress(sender), payload.GetParentHash(),
+	)
+	if err != nil {
+		s.onUnenforceable(tx, err)
+		return false
+	}
+	return balance.Cmp(tx.Cost()) >= 0
+}
+
+// onUnenforceable reports a transaction of the inclusion lists whose
+// sender state could not be read from the execution client.
+func (s *Store[_]) onUnenforceable(tx *types.Transaction, err error) {
+	s.logger.Warn(
+		"Failed to read sender state, not enforcing inclusion list "+
+			"transaction",
+		"tx", tx.Hash(),
+		"error", err,
+	)
+	s.metrics.markUnenforceable()
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// builtPayload is a payload built by the server, along with the logs of its
+// block.
+type builtPayload struct {
+	// block is the block of the payload.
+	block *types.Block
+	// logs are the logs emitted by the block.
+	logs []*types.Log
+}
+
+// buildPayload builds a payload on top of the given parent. The state root
+// only commits to the parent state and the withdrawals, which keeps the
+// payload deterministic. The caller must hold the lock.
+func (s *Server) buildPayload(
+	parent *types.Block,
+	attrs *engine.PayloadAttributes,
+) (engine.PayloadID, *builtPayload) {
+	withdrawals := attrs.Withdrawals
+	if withdrawals == nil {
+		withdrawals = make([]*types.Withdrawal, 0)
+	}
+	withdrawalsHash := types.DeriveSha(
+		types.Withdrawals(withdrawals), trie.NewStackTrie(nil),
+	)
+
+	// Deposits are not backed by transactions, so their logs are committed
+	// to through a single synthetic receipt.
+	var (
+		receipts      []*types.Receipt
+		logs          = s.copyPendingDeposits()
+		blobGasUsed   uint64
+		excessBlobGas uint64
+	)
+	if len(logs) > 0 {
+		receipt := &types.Receipt{
+			Status: types.ReceiptStatusSuccessful,
+			Logs:   logs,
+		}
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+		receipts = append(receipts, receipt)
+	}
+
+	block := types.NewBlock(
+		&types.Header{
+			ParentHash: parent.Hash(),
+			Coinbase:   attrs.SuggestedFeeRecipient,
+			Root: crypto.Keccak256Hash(
+				parent.Root().Bytes(), withdrawalsHash.Bytes(),
+			),
+			Difficulty:       new(big.Int),
+			Number:           new(big.Int).Add(parent.Number(), big.NewInt(1)),
+			GasLimit:         s.gasLimit,
+			Time:             attrs.Timestamp,
+			MixDigest:        attrs.Random,
+			BaseFee:          new(big.Int).Set(s.baseFee),
+			BlobGasUsed:      &blobGasUsed,
+			ExcessBlobGas:    &excessBlobGas,
+			ParentBeaconRoot: attrs.BeaconRoot,
+		},
+		&types.Body{Withdrawals: withdrawals},
+		receipts,
+		trie.NewStackTrie(nil),
+	)
+	for i, log := range logs {
+		log.BlockNumber = block.NumberU64()
+		log.BlockHash = block.Hash()
+		log.Index = uint(i)
+	}
+
+	return payloadID(block.Hash()), &builtPayload{block: block, logs: logs}
+}
+
+// insertBlock adds the given block and its logs to the chain, crediting its
+// withdrawals and dropping the deposits it includes from the pending ones.
+// The caller must hold the lock.
+func (s *Server) insertBlock(block *types.Block, logs []*types.Log) {
+	s.blocks[block.Hash()] = block
+	s.logs[block.Hash()] = logs
+
+	for _, withdrawal := range block.Withdrawals() {
+		balance, ok := s.balances[withdrawal.Address]
+		if !ok {
+			balance = new(big.Int)
+			s.balances[withdrawal.Address] = balance
+		}
+		balance.Add(balance, new(big.Int).Mul(
+			new(big.Int).SetUint64(withdrawal.Amount),
+			big.NewInt(params.GWei),
+		))
+	}
+
+	for _, log := range logs {
+		if len(s.pendingDeposits) == 0 ||
+			!bytes.Equal(s.pendingDeposits[0].Data, log.Data) {
+			break
+		}
+		s.pendingDeposits = s.pendingDeposits[1:]
+	}
+}
+
+// setHead makes the given block the head of the canonical chain. The caller
+// must hold the lock.
+func (s *Server) setHead(head *types.Block) {
+	for number := head.NumberU64() + 1; ; number++ {
+		if _, ok := s.canonical[number]; !ok {
+			break
+		}
+		delete(s.canonical, number)
+	}
+
+	for block := head; block != nil; block = s.blocks[block.ParentHash()] {
+		if s.canonical[block.NumberU64()] == block.Hash() {
+			break
+		}
+		s.canonical[block.NumberU64()] = block.Hash()
+	}
+	s.head = head.Hash()
+}
+
+// blockByNumber resolves the given block number or tag against the
+// canonical chain. The caller must hold the lock.
+func (s *Server) blockByNumber(number rpc.BlockNumber) (*types.Block, bool) {
+	var hash gethcommon.Hash
+	switch number {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		hash = s.head
+	case rpc.SafeBlockNumber:
+		hash = s.safe
+	case rpc.FinalizedBlockNumber:
+		hash = s.finalized
+	case rpc.EarliestBlockNumber:
+		hash = s.canonical[0]
+	default:
+		var ok bool
+		if hash, ok = s.canonical[uint64(number.Int64())]; !ok {
+			return nil, false
+		}
+	}
+	block, ok := s.blocks[hash]
+	return block, ok
+}
+
+// payloadID derives the ID of a payload from its block hash.
+func payloadID(hash gethcommon.Hash) engine.PayloadID {
+	var id engine.PayloadID
+	copy(id[:], hash[:len(id)])
+	id[0] = byte(engine.PayloadV3)
+	return id
+}
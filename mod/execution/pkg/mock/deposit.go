@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// depositEventName is the name of the deposit contract event.
+const depositEventName = "Deposit"
+
+// EmitDeposit queues a Deposit event of the deposit contract, which is
+// included in the next payload built by the server. It returns the index
+// assigned to the deposit.
+func (s *Server) EmitDeposit(
+	pubkey crypto.BLSPubkey,
+	credentials [32]byte,
+	amount math.Gwei,
+	signature crypto.BLSSignature,
+) (uint64, error) {
+	abi, err := deposit.BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return 0, err
+	}
+	event := abi.Events[depositEventName]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	index := s.depositCount
+	data, err := event.Inputs.Pack(
+		pubkey[:], credentials[:], amount.Unwrap(), signature[:], index,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	s.pendingDeposits = append(s.pendingDeposits, &types.Log{
+		Address: s.depositContract,
+		Topics:  []gethcommon.Hash{event.ID},
+		Data:    data,
+	})
+	s.depositCount++
+	return index, nil
+}
+
+// copyPendingDeposits returns a copy of the pending deposit logs, so that
+// each built payload can fill in its own block fields. The caller must hold
+// the lock.
+func (s *Server) copyPendingDeposits() []*types.Log {
+	logs := make([]*types.Log, len(s.pendingDeposits))
+	for i, pending := range s.pendingDeposits {
+		log := *pending
+		log.Topics = slices.Clone(pending.Topics)
+		logs[i] = &log
+	}
+	return logs
+}
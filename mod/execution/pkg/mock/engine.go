@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// engineAPI implements the engine namespace of the mock server.
+type engineAPI struct {
+	s *Server
+}
+
+// ExchangeCapabilities implements engine_exchangeCapabilities.
+func (api *engineAPI) ExchangeCapabilities(
+	ctx context.Context,
+	_ []string,
+) ([]string, error) {
+	if _, err := api.s.applyFault(
+		ctx, ethclient.ExchangeCapabilities,
+	); err != nil {
+		return nil, err
+	}
+	return ethclient.BeaconKitSupportedCapabilities(), nil
+}
+
+// GetClientVersionV1 implements engine_getClientVersionV1.
+func (api *engineAPI) GetClientVersionV1(
+	ctx context.Context,
+	_ *engine.ClientVersionV1,
+) ([]engine.ClientVersionV1, error) {
+	if _, err := api.s.applyFault(
+		ctx, ethclient.GetClientVersionV1,
+	); err != nil {
+		return nil, err
+	}
+	return []engine.ClientVersionV1{api.s.clientVersion}, nil
+}
+
+// NewPayloadV3 implements engine_newPayloadV3.
+func (api *engineAPI) NewPayloadV3(
+	ctx context.Context,
+	payload engine.ExecutableData,
+	versionedHashes []gethcommon.Hash,
+	beaconRoot *gethcommon.Hash,
+) (engine.PayloadStatusV1, error) {
+	fault, err := api.s.applyFault(ctx, ethclient.NewPayloadMethodV3)
+	if err != nil {
+		return engine.PayloadStatusV1{}, err
+	}
+	if fault != nil && fault.Status != "" {
+		return faultStatus(fault, &payload.ParentHash), nil
+	}
+
+	api.s.mu.Lock()
+	defer api.s.mu.Unlock()
+
+	if _, ok := api.s.blocks[payload.BlockHash]; ok {
+		return validStatus(payload.BlockHash), nil
+	}
+	if _, ok := api.s.blocks[payload.ParentHash]; !ok {
+		return engine.PayloadStatusV1{Status: engine.SYNCING}, nil
+	}
+
+	block, err := engine.ExecutableDataToBlock(
+		payload, versionedHashes, beaconRoot,
+	)
+	if err != nil {
+		return invalidStatus(payload.ParentHash, err.Error()), nil
+	}
+
+	var logs []*types.Log
+	if built, ok := api.s.built[block.Hash()]; ok {
+		logs = built.logs
+	}
+	api.s.insertBlock(block, logs)
+	return validStatus(block.Hash()), nil
+}
+
+// ForkchoiceUpdatedV3 implements engine_forkchoiceUpdatedV3.
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	ctx context.Context,
+	state engine.ForkchoiceStateV1,
+	attrs *engine.PayloadAttributes,
+) (engine.ForkChoiceResponse, error) {
+	fault, err := api.s.applyFault(ctx, ethclient.ForkchoiceUpdatedMethodV3)
+	if err != nil {
+		return engine.ForkChoiceResponse{}, err
+	}
+	if fault != nil && fault.Status != "" {
+		return engine.ForkChoiceResponse{
+			PayloadStatus: faultStatus(fault, nil),
+		}, nil
+	}
+
+	api.s.mu.Lock()
+	defer api.s.mu.Unlock()
+
+	head, ok := api.s.blocks[state.HeadBlockHash]
+	if !ok {
+		return engine.ForkChoiceResponse{
+			PayloadStatus: engine.PayloadStatusV1{Status: engine.SYNCING},
+		}, nil
+	}
+	for _, hash := range []gethcommon.Hash{
+		state.SafeBlockHash, state.FinalizedBlockHash,
+	} {
+		if _, ok = api.s.blocks[hash]; !ok && hash != (gethcommon.Hash{}) {
+			return engine.ForkChoiceResponse{}, &rpcError{
+				code:    invalidForkchoiceStateCode,
+				message: "unknown safe or finalized block",
+			}
+		}
+	}
+
+	api.s.setHead(head)
+	if state.SafeBlockHash != (gethcommon.Hash{}) {
+		api.s.safe = state.SafeBlockHash
+	}
+	if state.FinalizedBlockHash != (gethcommon.Hash{}) {
+		api.s.finalized = state.FinalizedBlockHash
+	}
+
+	response := engine.ForkChoiceResponse{
+		PayloadStatus: validStatus(head.Hash()),
+	}
+	if attrs == nil {
+		return response, nil
+	}
+	if attrs.Timestamp <= head.Time() {
+		return engine.ForkChoiceResponse{}, &rpcError{
+			code:    invalidPayloadAttributesCode,
+			message: "payload timestamp must be greater than parent",
+		}
+	}
+
+	id, built := api.s.buildPayload(head, attrs)
+	api.s.payloads[id] = built.block.Hash()
+	api.s.built[built.block.Hash()] = built
+	response.PayloadID = &id
+	return response, nil
+}
+
+// GetPayloadV3 implements engine_getPayloadV3.
+func (api *engineAPI) GetPayloadV3(
+	ctx context.Context,
+	id engine.PayloadID,
+) (*engine.ExecutionPayloadEnvelope, error) {
+	if _, err := api.s.applyFault(ctx, ethclient.GetPayloadMethodV3); err != nil {
+		return nil, err
+	}
+
+	api.s.mu.RLock()
+	defer api.s.mu.RUnlock()
+
+	hash, ok := api.s.payloads[id]
+	if !ok {
+		return nil, &rpcError{
+			code:    unknownPayloadCode,
+			message: "unknown payload",
+		}
+	}
+	return engine.BlockToExecutableData(
+		api.s.built[hash].block, new(big.Int), nil,
+	), nil
+}
+
+// validStatus returns a VALID payload status for the given hash.
+func validStatus(hash gethcommon.Hash) engine.PayloadStatusV1 {
+	return engine.PayloadStatusV1{
+		Status:          engine.VALID,
+		LatestValidHash: &hash,
+	}
+}
+
+// invalidStatus returns an INVALID payload status with the given latest
+// valid hash and validation error.
+func invalidStatus(
+	latestValidHash gethcommon.Hash,
+	reason string,
+) engine.PayloadStatusV1 {
+	return engine.PayloadStatusV1{
+		Status:          engine.INVALID,
+		LatestValidHash: &latestValidHash,
+		ValidationError: &reason,
+	}
+}
+
+// faultStatus returns the payload status injected by the given fault.
+func faultStatus(
+	fault *Fault,
+	latestValidHash *gethcommon.Hash,
+) engine.PayloadStatusV1 {
+	status := engine.PayloadStatusV1{Status: fault.Status}
+	if fault.Status == engine.INVALID {
+		status.LatestValidHash = latestValidHash
+		status.ValidationError = &fault.ValidationError
+	}
+	return status
+}
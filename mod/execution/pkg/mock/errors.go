@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+// Engine API error codes as defined by the execution-apis specification.
+const (
+	unknownPayloadCode           = -38001
+	invalidForkchoiceStateCode   = -38002
+	invalidPayloadAttributesCode = -38003
+)
+
+var (
+	// ErrMissingToken is returned when a request has no bearer token.
+	ErrMissingToken = errors.New("missing token")
+
+	// ErrInvalidToken is returned when the bearer token of a request does
+	// not verify against the configured JWT secret.
+	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrMissingIssuedAt is returned when the bearer token of a request has
+	// no issued-at claim.
+	ErrMissingIssuedAt = errors.New("missing issued-at claim")
+
+	// ErrStaleToken is returned when the issued-at claim of the bearer token
+	// is too far from the local clock.
+	ErrStaleToken = errors.New("stale token")
+)
+
+// rpcError is a JSON-RPC error carrying an error code.
+type rpcError struct {
+	// code is the JSON-RPC error code.
+	code int
+	// message is the JSON-RPC error message.
+	message string
+}
+
+// Error implements the error interface.
+func (e *rpcError) Error() string {
+	return e.message
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *rpcError) ErrorCode() int {
+	return e.code
+}
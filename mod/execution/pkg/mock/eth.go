@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"context"
+	"slices"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Eth JSON-RPC method names served by the mock server.
+const (
+	chainIDMethod          = "eth_chainId"
+	blockNumberMethod      = "eth_blockNumber"
+	getBlockByNumberMethod = "eth_getBlockByNumber"
+	getLogsMethod          = "eth_getLogs"
+	getBalanceMethod       = "eth_getBalance"
+)
+
+// ethAPI implements the eth namespace of the mock server.
+type ethAPI struct {
+	s *Server
+}
+
+// ChainId implements eth_chainId.
+//
+//nolint:revive,stylecheck // must match the JSON-RPC method name.
+func (api *ethAPI) ChainId(ctx context.Context) (hexutil.Uint64, error) {
+	if _, err := api.s.applyFault(ctx, chainIDMethod); err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(api.s.chainID), nil
+}
+
+// BlockNumber implements eth_blockNumber.
+func (api *ethAPI) BlockNumber(ctx context.Context) (hexutil.Uint64, error) {
+	if _, err := api.s.applyFault(ctx, blockNumberMethod); err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(api.s.HeadBlock().NumberU64()), nil
+}
+
+// GetBlockByNumber implements eth_getBlockByNumber. Only headers are
+// returned, since the server does not execute transactions.
+func (api *ethAPI) GetBlockByNumber(
+	ctx context.Context,
+	number rpc.BlockNumber,
+	_ bool,
+) (*types.Header, error) {
+	if _, err := api.s.applyFault(ctx, getBlockByNumberMethod); err != nil {
+		return nil, err
+	}
+
+	api.s.mu.RLock()
+	defer api.s.mu.RUnlock()
+	block, ok := api.s.blockByNumber(number)
+	if !ok {
+		return nil, nil
+	}
+	return block.Header(), nil
+}
+
+// GetLogs implements eth_getLogs.
+func (api *ethAPI) GetLogs(
+	ctx context.Context,
+	filter logFilter,
+) ([]*types.Log, error) {
+	if _, err := api.s.applyFault(ctx, getLogsMethod); err != nil {
+		return nil, err
+	}
+
+	api.s.mu.RLock()
+	defer api.s.mu.RUnlock()
+
+	var blocks []gethcommon.Hash
+	if filter.BlockHash != nil {
+		blocks = append(blocks, *filter.BlockHash)
+	} else {
+		from, ok := api.s.blockByNumber(filter.FromBlock)
+		if !ok {
+			return []*types.Log{}, nil
+		}
+		to, ok := api.s.blockByNumber(filter.ToBlock)
+		if !ok {
+			to = api.s.blocks[api.s.head]
+		}
+		for n := from.NumberU64(); n <= to.NumberU64(); n++ {
+			if hash, found := api.s.canonical[n]; found {
+				blocks = append(blocks, hash)
+			}
+		}
+	}
+
+	logs := make([]*types.Log, 0)
+	for _, hash := range blocks {
+		for _, log := range api.s.logs[hash] {
+			if filter.matches(log) {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// GetBalance implements eth_getBalance. Balances only reflect the
+// withdrawals credited by the server.
+func (api *ethAPI) GetBalance(
+	ctx context.Context,
+	address gethcommon.Address,
+	_ rpc.BlockNumberOrHash,
+) (*hexutil.Big, error) {
+	if _, err := api.s.applyFault(ctx, getBalanceMethod); err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(api.s.Balance(address)), nil
+}
+
+// matches returns whether the given log matches the filter.
+func (f *logFilter) matches(log *types.Log) bool {
+	if len(f.Addresses) > 0 && !slices.Contains(f.Addresses, log.Address) {
+		return false
+	}
+	if len(f.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, topics := range f.Topics {
+		if len(topics) > 0 && !slices.Contains(topics, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+)
+
+// Fault describes a failure injected into the responses of a JSON-RPC
+// method.
+type Fault struct {
+	// Status overrides the payload status returned by engine_newPayload and
+	// engine_forkchoiceUpdated, e.g. SYNCING or INVALID.
+	Status string
+	// ValidationError is reported alongside an INVALID status.
+	ValidationError string
+	// Code, if non-zero, makes the method fail with a JSON-RPC error.
+	Code int
+	// Message is the message of the JSON-RPC error.
+	Message string
+	// Delay holds back the response, which simulates a timeout when it
+	// exceeds the deadline of the caller.
+	Delay time.Duration
+	// Remaining is the number of calls the fault applies to. Zero means the
+	// fault applies until it is cleared.
+	Remaining int
+}
+
+// SyncingFault makes the engine report the SYNCING status.
+func SyncingFault() Fault {
+	return Fault{Status: engine.SYNCING}
+}
+
+// InvalidFault makes the engine report the INVALID status with the given
+// validation error.
+func InvalidFault(reason string) Fault {
+	return Fault{Status: engine.INVALID, ValidationError: reason}
+}
+
+// TimeoutFault holds back the response for the given duration.
+func TimeoutFault(delay time.Duration) Fault {
+	return Fault{Delay: delay}
+}
+
+// RPCErrorFault makes the method fail with the given JSON-RPC error.
+func RPCErrorFault(code int, message string) Fault {
+	return Fault{Code: code, Message: message}
+}
+
+// Times limits the fault to the next n calls.
+func (f Fault) Times(n int) Fault {
+	f.Remaining = n
+	return f
+}
+
+// InjectFault injects a fault into the responses of the given JSON-RPC
+// method, replacing any fault previously injected into it.
+func (s *Server) InjectFault(method string, fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[method] = &fault
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.faults)
+}
+
+// applyFault applies the fault injected into the given method, if any. It
+// returns the fault so that the caller can apply a status override.
+func (s *Server) applyFault(
+	ctx context.Context,
+	method string,
+) (*Fault, error) {
+	s.mu.Lock()
+	injected, ok := s.faults[method]
+	if !ok {
+		s.mu.Unlock()
+		return nil, nil
+	}
+	fault := *injected
+	if injected.Remaining > 0 {
+		if injected.Remaining--; injected.Remaining == 0 {
+			delete(s.faults, method)
+		}
+	}
+	s.mu.Unlock()
+
+	if fault.Delay > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(fault.Delay):
+		}
+	}
+	if fault.Code != 0 {
+		return nil, &rpcError{code: fault.Code, message: fault.Message}
+	}
+	return &fault, nil
+}
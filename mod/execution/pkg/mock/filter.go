@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// logFilter is the filter argument of eth_getLogs.
+type logFilter struct {
+	// BlockHash restricts the filter to a single block.
+	BlockHash *gethcommon.Hash
+	// FromBlock is the first block of the range.
+	FromBlock rpc.BlockNumber
+	// ToBlock is the last block of the range.
+	ToBlock rpc.BlockNumber
+	// Addresses restricts the logs to the given emitters.
+	Addresses []gethcommon.Address
+	// Topics restricts the logs by position, an empty position matching any
+	// topic.
+	Topics [][]gethcommon.Hash
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both the address and each topic
+// position may either be a single value or a list of values.
+func (f *logFilter) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		BlockHash *gethcommon.Hash  `json:"blockHash"`
+		FromBlock *rpc.BlockNumber  `json:"fromBlock"`
+		ToBlock   *rpc.BlockNumber  `json:"toBlock"`
+		Addresses json.RawMessage   `json:"address"`
+		Topics    []json.RawMessage `json:"topics"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	f.BlockHash = raw.BlockHash
+	f.FromBlock, f.ToBlock = rpc.LatestBlockNumber, rpc.LatestBlockNumber
+	if raw.FromBlock != nil {
+		f.FromBlock = *raw.FromBlock
+	}
+	if raw.ToBlock != nil {
+		f.ToBlock = *raw.ToBlock
+	}
+
+	var err error
+	if f.Addresses, err = unmarshalOneOrMany[gethcommon.Address](
+		raw.Addresses,
+	); err != nil {
+		return err
+	}
+	f.Topics = make([][]gethcommon.Hash, len(raw.Topics))
+	for i, topics := range raw.Topics {
+		if f.Topics[i], err = unmarshalOneOrMany[gethcommon.Hash](
+			topics,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmarshalOneOrMany unmarshals either a single value, a list of values or
+// null.
+func unmarshalOneOrMany[T any](data json.RawMessage) ([]T, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	var many []T
+	if err := json.Unmarshal(data, &many); err == nil {
+		return many, nil
+	}
+	var one T
+	if err := json.Unmarshal(data, &one); err != nil {
+		return nil, err
+	}
+	return []T{one}, nil
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"math/big"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Option is a functional option for the mock execution server.
+type Option func(*Server)
+
+// WithChainID sets the chain ID reported by eth_chainId.
+func WithChainID(chainID uint64) Option {
+	return func(s *Server) {
+		s.chainID = chainID
+	}
+}
+
+// WithJWTSecret requires every request to carry a token signed with the
+// given secret, mirroring the authentication of a real execution client.
+func WithJWTSecret(secret *jwt.Secret) Option {
+	return func(s *Server) {
+		s.jwtSecret = secret
+	}
+}
+
+// WithDepositContract sets the address from which deposit logs are emitted.
+func WithDepositContract(address common.ExecutionAddress) Option {
+	return func(s *Server) {
+		s.depositContract = gethcommon.Address(address)
+	}
+}
+
+// WithGenesisHeader replaces the default genesis header, which allows the
+// server to follow an existing execution genesis.
+func WithGenesisHeader(header *types.Header) Option {
+	return func(s *Server) {
+		s.genesisHeader = header
+	}
+}
+
+// WithGasLimit sets the gas limit of the payloads built by the server.
+func WithGasLimit(gasLimit uint64) Option {
+	return func(s *Server) {
+		s.gasLimit = gasLimit
+	}
+}
+
+// WithBaseFee sets the base fee of the payloads built by the server.
+func WithBaseFee(baseFee *big.Int) Option {
+	return func(s *Server) {
+		s.baseFee = baseFee
+	}
+}
+
+// WithClientVersion sets the version reported by engine_getClientVersionV1.
+func WithClientVersion(version engine.ClientVersionV1) Option {
+	return func(s *Server) {
+		s.clientVersion = version
+	}
+}
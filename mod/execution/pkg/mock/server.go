@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	gjwt "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// defaultChainID matches the chain ID of the local devnet genesis.
+	defaultChainID = 80087
+	// defaultGasLimit is the gas limit of the payloads built by the server.
+	defaultGasLimit = 30_000_000
+	// jwtMaxIssuedAtSkew is the maximum allowed distance between the
+	// issued-at claim of a token and the local clock.
+	jwtMaxIssuedAtSkew = 60 * time.Second
+	// readHeaderTimeout is the read header timeout of ListenAndServe.
+	readHeaderTimeout = 5 * time.Second
+)
+
+// Server is an in-memory execution client which serves the subset of the
+// Engine and Eth JSON-RPC APIs used by beacon-kit. The payloads it builds are
+// a deterministic function of their parent and the payload attributes, so
+// the same inputs always yield the same block hash.
+type Server struct {
+	// rpc is the underlying JSON-RPC server.
+	rpc *rpc.Server
+	// chainID is the chain ID reported by eth_chainId.
+	chainID uint64
+	// jwtSecret is the secret used to authenticate requests, if any.
+	jwtSecret *jwt.Secret
+	// depositContract is the address deposit logs are emitted from.
+	depositContract gethcommon.Address
+	// genesisHeader is the header of the genesis block.
+	genesisHeader *types.Header
+	// gasLimit is the gas limit of the payloads built by the server.
+	gasLimit uint64
+	// baseFee is the base fee of the payloads built by the server.
+	baseFee *big.Int
+	// clientVersion is reported by engine_getClientVersionV1.
+	clientVersion engine.ClientVersionV1
+
+	// mu protects the chain state below.
+	mu sync.RWMutex
+	// blocks holds every block known to the server by hash.
+	blocks map[gethcommon.Hash]*types.Block
+	// logs holds the logs of every known block by block hash.
+	logs map[gethcommon.Hash][]*types.Log
+	// canonical maps block numbers to the hashes of the canonical chain.
+	canonical map[uint64]gethcommon.Hash
+	// head is the hash of the current head block.
+	head gethcommon.Hash
+	// safe is the hash of the current safe block.
+	safe gethcommon.Hash
+	// finalized is the hash of the current finalized block.
+	finalized gethcommon.Hash
+	// payloads maps the IDs of the payloads built by the server to their
+	// block hash.
+	payloads map[engine.PayloadID]gethcommon.Hash
+	// built holds the payloads built by the server by block hash.
+	built map[gethcommon.Hash]*builtPayload
+	// pendingDeposits holds deposit logs waiting to be included in a block.
+	pendingDeposits []*types.Log
+	// depositCount is the index assigned to the next deposit.
+	depositCount uint64
+	// balances tracks the withdrawals credited to each address, in wei.
+	balances map[gethcommon.Address]*big.Int
+	// faults holds the injected faults by JSON-RPC method.
+	faults map[string]*Fault
+}
+
+// New creates a new mock execution server with the given options.
+func New(opts ...Option) (*Server, error) {
+	s := &Server{
+		rpc:      rpc.NewServer(),
+		chainID:  defaultChainID,
+		gasLimit: defaultGasLimit,
+		baseFee:  big.NewInt(params.InitialBaseFee),
+		clientVersion: engine.ClientVersionV1{
+			Code:    "MK",
+			Name:    "beacon-kit-mock",
+			Version: "v0.0.0",
+			Commit:  "00000000",
+		},
+		blocks:    make(map[gethcommon.Hash]*types.Block),
+		logs:      make(map[gethcommon.Hash][]*types.Log),
+		canonical: make(map[uint64]gethcommon.Hash),
+		payloads:  make(map[engine.PayloadID]gethcommon.Hash),
+		built:     make(map[gethcommon.Hash]*builtPayload),
+		balances:  make(map[gethcommon.Address]*big.Int),
+		faults:    make(map[string]*Fault),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	genesis := s.genesisBlock()
+	s.blocks[genesis.Hash()] = genesis
+	s.canonical[0] = genesis.Hash()
+	s.head, s.safe, s.finalized = genesis.Hash(), genesis.Hash(), genesis.Hash()
+
+	if err := s.rpc.RegisterName("engine", &engineAPI{s: s}); err != nil {
+		return nil, err
+	}
+	if err := s.rpc.RegisterName("eth", &ethAPI{s: s}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := s.authenticate(r); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	s.rpc.ServeHTTP(w, r)
+}
+
+// ListenAndServe serves the JSON-RPC APIs on the given address until the
+// context is cancelled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           s,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Close stops the JSON-RPC server.
+func (s *Server) Close() {
+	s.rpc.Stop()
+}
+
+// GenesisHash returns the hash of the genesis block.
+func (s *Server) GenesisHash() gethcommon.Hash {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.canonical[0]
+}
+
+// HeadBlock returns the current head block.
+func (s *Server) HeadBlock() *types.Block {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.blocks[s.head]
+}
+
+// BlockByHash returns the block with the given hash, if known.
+func (s *Server) BlockByHash(hash gethcommon.Hash) (*types.Block, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	block, ok := s.blocks[hash]
+	return block, ok
+}
+
+// Balance returns the amount credited to the given address by withdrawals,
+// in wei.
+func (s *Server) Balance(address gethcommon.Address) *big.Int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if balance, ok := s.balances[address]; ok {
+		return new(big.Int).Set(balance)
+	}
+	return new(big.Int)
+}
+
+// genesisBlock returns the genesis block of the server.
+func (s *Server) genesisBlock() *types.Block {
+	header := s.genesisHeader
+	if header == nil {
+		var (
+			zero       uint64
+			beaconRoot gethcommon.Hash
+		)
+		header = &types.Header{
+			Difficulty:       new(big.Int),
+			Number:           new(big.Int),
+			GasLimit:         s.gasLimit,
+			BaseFee:          new(big.Int).Set(s.baseFee),
+			Root:             types.EmptyRootHash,
+			BlobGasUsed:      &zero,
+			ExcessBlobGas:    &zero,
+			ParentBeaconRoot: &beaconRoot,
+		}
+	}
+	return types.NewBlock(
+		header,
+		&types.Body{Withdrawals: make([]*types.Withdrawal, 0)},
+		nil,
+		trie.NewStackTrie(nil),
+	)
+}
+
+// authenticate verifies the JWT bearer token of the request, if the server
+// was configured with a secret.
+func (s *Server) authenticate(r *http.Request) error {
+	s.mu.RLock()
+	secret := s.jwtSecret
+	s.mu.RUnlock()
+	if secret == nil {
+		return nil
+	}
+
+	raw, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return ErrMissingToken
+	}
+
+	claims := gjwt.MapClaims{}
+	if _, err := gjwt.ParseWithClaims(
+		raw, claims,
+		func(*gjwt.Token) (any, error) { return secret.Bytes(), nil },
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+	); err != nil {
+		return errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return ErrMissingIssuedAt
+	}
+	if time.Since(issuedAt.Time).Abs() > jwtMaxIssuedAtSkew {
+		return ErrStaleToken
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mock_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/mock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+var depositContract = common.NewExecutionAddressFromHex(
+	"0x4242424242424242424242424242424242424242",
+)
+
+func newTestServer(t *testing.T) (*mock.Server, *rpc.Client) {
+	t.Helper()
+	server, err := mock.New(
+		mock.WithChainID(80087),
+		mock.WithDepositContract(depositContract),
+	)
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(server)
+	client, err := rpc.DialHTTP(httpServer.URL)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.Close()
+		httpServer.Close()
+		server.Close()
+	})
+	return server, client
+}
+
+func buildPayload(
+	t *testing.T,
+	client *rpc.Client,
+	parent gethcommon.Hash,
+	attrs *engine.PayloadAttributes,
+) *engine.ExecutionPayloadEnvelope {
+	t.Helper()
+	var response engine.ForkChoiceResponse
+	require.NoError(t, client.Call(
+		&response, ethclient.ForkchoiceUpdatedMethodV3,
+		engine.ForkchoiceStateV1{HeadBlockHash: parent}, attrs,
+	))
+	require.Equal(t, engine.VALID, response.PayloadStatus.Status)
+	require.NotNil(t, response.PayloadID)
+
+	var envelope engine.ExecutionPayloadEnvelope
+	require.NoError(t, client.Call(
+		&envelope, ethclient.GetPayloadMethodV3, response.PayloadID,
+	))
+	return &envelope
+}
+
+func TestServerBuildsAndImportsPayloads(t *testing.T) {
+	server, client := newTestServer(t)
+
+	var chainID hexutil.Uint64
+	require.NoError(t, client.Call(&chainID, "eth_chainId"))
+	require.Equal(t, hexutil.Uint64(80087), chainID)
+
+	index, err := server.EmitDeposit(
+		crypto.BLSPubkey{1}, [32]byte{2}, math.Gwei(32e9), crypto.BLSSignature{3},
+	)
+	require.NoError(t, err)
+	require.Zero(t, index)
+
+	recipient := gethcommon.Address{0xaa}
+	beaconRoot := gethcommon.Hash{0xbb}
+	attrs := &engine.PayloadAttributes{
+		Timestamp:             1,
+		SuggestedFeeRecipient: recipient,
+		Withdrawals: []*types.Withdrawal{
+			{Index: 0, Validator: 0, Address: recipient, Amount: 5},
+		},
+		BeaconRoot: &beaconRoot,
+	}
+	envelope := buildPayload(t, client, server.GenesisHash(), attrs)
+	payload := envelope.ExecutionPayload
+	require.NotNil(t, envelope.BlobsBundle)
+	require.Len(t, payload.Withdrawals, 1)
+
+	// Building twice with the same inputs yields the same payload.
+	require.Equal(
+		t,
+		payload.BlockHash,
+		buildPayload(t, client, server.GenesisHash(), attrs).
+			ExecutionPayload.BlockHash,
+	)
+
+	var status engine.PayloadStatusV1
+	require.NoError(t, client.Call(
+		&status, ethclient.NewPayloadMethodV3,
+		payload, []gethcommon.Hash{}, &beaconRoot,
+	))
+	require.Equal(t, engine.VALID, status.Status)
+	require.Equal(t, payload.BlockHash, *status.LatestValidHash)
+
+	var response engine.ForkChoiceResponse
+	require.NoError(t, client.Call(
+		&response, ethclient.ForkchoiceUpdatedMethodV3,
+		engine.ForkchoiceStateV1{HeadBlockHash: payload.BlockHash}, nil,
+	))
+	require.Equal(t, payload.BlockHash, server.HeadBlock().Hash())
+	require.Equal(t, "5000000000", server.Balance(recipient).String())
+
+	var logs []types.Log
+	require.NoError(t, client.Call(&logs, "eth_getLogs", map[string]any{
+		"fromBlock": "0x0",
+		"toBlock":   "latest",
+		"address":   []gethcommon.Address{gethcommon.Address(depositContract)},
+	}))
+	require.Len(t, logs, 1)
+	require.Equal(t, payload.BlockHash, logs[0].BlockHash)
+}
+
+func TestServerFaults(t *testing.T) {
+	server, client := newTestServer(t)
+
+	server.InjectFault(
+		ethclient.ForkchoiceUpdatedMethodV3, mock.SyncingFault().Times(1),
+	)
+	state := engine.ForkchoiceStateV1{HeadBlockHash: server.GenesisHash()}
+	var response engine.ForkChoiceResponse
+	require.NoError(t, client.Call(
+		&response, ethclient.ForkchoiceUpdatedMethodV3, state, nil,
+	))
+	require.Equal(t, engine.SYNCING, response.PayloadStatus.Status)
+	require.NoError(t, client.Call(
+		&response, ethclient.ForkchoiceUpdatedMethodV3, state, nil,
+	))
+	require.Equal(t, engine.VALID, response.PayloadStatus.Status)
+
+	server.InjectFault(ethclient.GetPayloadMethodV3, mock.TimeoutFault(time.Second))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.Error(t, client.CallContext(
+		ctx, nil, ethclient.GetPayloadMethodV3, engine.PayloadID{},
+	))
+
+	server.ClearFaults()
+	server.InjectFault("eth_chainId", mock.RPCErrorFault(-32000, "boom"))
+	var rpcErr rpc.Error
+	err := client.Call(nil, "eth_chainId")
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, -32000, rpcErr.ErrorCode())
+}
@@ -45,16 +45,22 @@ type (
 	LogsBloom      = coretypes.Bloom
 	Header         = coretypes.Header
 	Receipt        = coretypes.Receipt
+	Signer         = coretypes.Signer
 	Transaction    = coretypes.Transaction
 	Transactions   = coretypes.Transactions
 	Withdrawals    = coretypes.Withdrawals
 )
 
+// BlobTxType is the type of blob transactions.
+const BlobTxType = coretypes.BlobTxType
+
 //nolint:gochecknoglobals // alias.
 var (
-	BlockToExecutableData = engine.BlockToExecutableData
-	NewBlockWithHeader    = coretypes.NewBlockWithHeader
-	DeriveSha             = coretypes.DeriveSha
-	EmptyUncleHash        = coretypes.EmptyUncleHash
-	NewStackTrie          = trie.NewStackTrie
+	BlockToExecutableData  = engine.BlockToExecutableData
+	NewBlockWithHeader     = coretypes.NewBlockWithHeader
+	DeriveSha              = coretypes.DeriveSha
+	EmptyUncleHash         = coretypes.EmptyUncleHash
+	LatestSignerForChainID = coretypes.LatestSignerForChainID
+	Sender                 = coretypes.Sender
+	NewStackTrie           = trie.NewStackTrie
 )
@@ -27,6 +27,7 @@ type (
 	ContractFilterer = bind.ContractFilterer
 	FilterOpts       = bind.FilterOpts
 	TransactOpts     = bind.TransactOpts
+	WatchOpts        = bind.WatchOpts
 )
 
 //nolint:gochecknoglobals //used an alias.
@@ -25,3 +25,9 @@ import "github.com/ethereum/go-ethereum/rpc"
 type (
 	BlockNumber = rpc.BlockNumber
 )
+
+const (
+	SafeBlockNumber      = rpc.SafeBlockNumber
+	FinalizedBlockNumber = rpc.FinalizedBlockNumber
+	LatestBlockNumber    = rpc.LatestBlockNumber
+)
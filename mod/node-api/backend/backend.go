@@ -66,6 +66,7 @@ type Backend[
 	node NodeT
 
 	sp StateProcessor[BeaconStateT]
+	bs BlobSampler
 }
 
 // New creates and returns a new Backend instance.
@@ -103,6 +104,7 @@ func New[
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
 	sp StateProcessor[BeaconStateT],
+	bs BlobSampler,
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarT, BlobSidecarsT, BlockStoreT,
@@ -120,6 +122,7 @@ func New[
 		sb: storageBackend,
 		cs: cs,
 		sp: sp,
+		bs: bs,
 	}
 }
 
@@ -21,8 +21,11 @@
 package backend
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -63,36 +66,137 @@ func (b Backend[
 			continue
 		}
 
-		blobHex, err := blobSidecar.GetBlob().MarshalText()
+		blobSidecarData, err := blobSidecarData[BeaconBlockHeaderT](blobSidecar)
 		if err != nil {
 			return nil, err
 		}
-		kzgCommitmentHex, err := blobSidecar.GetKzgCommitment().MarshalText()
+		blobSidecarsResponse = append(blobSidecarsResponse, blobSidecarData)
+	}
+
+	return blobSidecarsResponse, nil
+}
+
+// BlobSidecarByVersionedHash returns the blob sidecar of the given versioned
+// hash and the slot of its block.
+func (b Backend[
+	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlobSidecarByVersionedHash(
+	hash common.ExecutionHash,
+) (math.Slot, *beacontypes.BlobSidecarData[BeaconBlockHeaderT], error) {
+	slot, blobSidecar, err := b.blobSidecarByVersionedHash(hash)
+	if err != nil {
+		return 0, nil, err
+	}
+	blobSidecarData, err := blobSidecarData[BeaconBlockHeaderT](blobSidecar)
+	return slot, blobSidecarData, err
+}
+
+// BlobSamples returns the field elements of the blob of the given versioned
+// hash at the given indices, each with the KZG proof of its evaluation, and
+// the slot of its block.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlobSamples(
+	hash common.ExecutionHash,
+	indices []uint64,
+) (math.Slot, *beacontypes.BlobSamplesData, error) {
+	slot, blobSidecar, err := b.blobSidecarByVersionedHash(hash)
+	if err != nil {
+		return 0, nil, err
+	}
+	kzgCommitmentHex, err := blobSidecar.GetKzgCommitment().MarshalText()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	blob := blobSidecar.GetBlob()
+	samples := make([]*beacontypes.BlobSampleData, len(indices))
+	for i, index := range indices {
+		z, y, proof, err := b.bs.SampleBlob(&blob, index)
 		if err != nil {
-			return nil, err
+			return 0, nil, err
 		}
-		kzgProofHex, err := blobSidecar.GetKzgProof().MarshalText()
+		kzgProofHex, err := proof.MarshalText()
 		if err != nil {
-			return nil, err
+			return 0, nil, err
 		}
-		inclusionProof := blobSidecar.GetInclusionProof()
-		inclusionProofStrings := make([]string, len(inclusionProof))
-		for j, proof := range inclusionProof {
-			inclusionProofStrings[j] = proof.String()
+		samples[i] = &beacontypes.BlobSampleData{
+			Index:    index,
+			Point:    z.String(),
+			Value:    y.String(),
+			KZGProof: string(kzgProofHex),
 		}
+	}
 
-		blobSidecarsResponse = append(blobSidecarsResponse, &beacontypes.BlobSidecarData[BeaconBlockHeaderT]{
-			Index: blobSidecar.GetIndex(),
-			Blob:  string(blobHex),
-			SignedBlockHeader: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
-				Message:   blobSidecar.GetBeaconBlockHeader(),
-				Signature: bytes.B48{}, // TODO: implement
-			},
-			KZGCommitment:               string(kzgCommitmentHex),
-			KZGProof:                    string(kzgProofHex),
-			KZGCommitmentInclusionProof: inclusionProofStrings,
-		})
+	return slot, &beacontypes.BlobSamplesData{
+		KZGCommitment: string(kzgCommitmentHex),
+		Samples:       samples,
+	}, nil
+}
+
+// blobSidecarByVersionedHash returns the blob sidecar of the given versioned
+// hash and the slot of its block.
+func (b Backend[
+	_, _, _, _, _, _, BlobSidecarT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) blobSidecarByVersionedHash(
+	hash common.ExecutionHash,
+) (math.Slot, BlobSidecarT, error) {
+	var zero BlobSidecarT
+	slot, _, err := b.sb.AvailabilityStore().LookupVersionedHash(hash)
+	if err != nil {
+		return 0, zero, errors.Join(types.ErrNotFound, err)
 	}
 
-	return blobSidecarsResponse, nil
+	blobSidecars, err := b.sb.AvailabilityStore().GetBlobsFromStore(slot)
+	if err != nil {
+		return 0, zero, err
+	}
+
+	// The index of the sidecar is not trusted, the versioned hash of the
+	// commitment of the sidecar must match.
+	for i := 0; i < blobSidecars.Len(); i++ {
+		blobSidecar := blobSidecars.Get(i)
+		if blobSidecar.GetKzgCommitment().ToVersionedHash() == hash {
+			return slot, blobSidecar, nil
+		}
+	}
+	return 0, zero, types.ErrNotFound
+}
+
+// blobSidecarData returns the API representation of a blob sidecar.
+func blobSidecarData[
+	BeaconBlockHeaderT any,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+](
+	blobSidecar BlobSidecarT,
+) (*beacontypes.BlobSidecarData[BeaconBlockHeaderT], error) {
+	blobHex, err := blobSidecar.GetBlob().MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	kzgCommitmentHex, err := blobSidecar.GetKzgCommitment().MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	kzgProofHex, err := blobSidecar.GetKzgProof().MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	inclusionProof := blobSidecar.GetInclusionProof()
+	inclusionProofStrings := make([]string, len(inclusionProof))
+	for j, proof := range inclusionProof {
+		inclusionProofStrings[j] = proof.String()
+	}
+
+	return &beacontypes.BlobSidecarData[BeaconBlockHeaderT]{
+		Index: blobSidecar.GetIndex(),
+		Blob:  string(blobHex),
+		SignedBlockHeader: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
+			Message:   blobSidecar.GetBeaconBlockHeader(),
+			Signature: bytes.B48{}, // TODO: implement
+		},
+		KZGCommitment:               string(kzgCommitmentHex),
+		KZGProof:                    string(kzgProofHex),
+		KZGCommitmentInclusionProof: inclusionProofStrings,
+	}, nil
 }
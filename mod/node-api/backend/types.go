@@ -23,6 +23,7 @@ package backend
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
@@ -45,6 +46,19 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	Persist(math.Slot, BlobSidecarsT) error
 	// GetBlobsFromStore returns all blob sidecars for a given slot.
 	GetBlobsFromStore(math.Slot) (BlobSidecarsT, error)
+	// LookupVersionedHash returns the slot and the index of the blob sidecar
+	// of a versioned hash.
+	LookupVersionedHash(common.ExecutionHash) (math.Slot, uint64, error)
+}
+
+// BlobSampler samples the field elements of blobs, each with the KZG proof of
+// its evaluation.
+type BlobSampler interface {
+	// SampleBlob returns the field element of the blob at the given index,
+	// along with its evaluation point and the KZG proof of the evaluation.
+	SampleBlob(
+		blob *eip4844.Blob, index uint64,
+	) (z, y common.Bytes32, proof eip4844.KZGProof, err error)
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -98,7 +112,7 @@ type BlobSidecars[T, BlobSidecarT any] interface {
 	Get(index int) BlobSidecarT
 	GetSidecars() []BlobSidecarT
 	ValidateBlockRoots() error
-	VerifyInclusionProofs(kzgOffset uint64) error
+	VerifyInclusionProofs(inclusion types.KZGInclusion) error
 }
 
 // BlockStore is the interface for block storage.
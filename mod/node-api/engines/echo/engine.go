@@ -67,10 +67,15 @@ func (e *Engine) RegisterRoutes(
 	group := e.Group(hs.BasePath)
 	for _, route := range hs.Routes {
 		route.DecorateWithLogs(e.logger)
+		var middlewares []echo.MiddlewareFunc
+		if route.LocalOnly {
+			middlewares = append(middlewares, localOnlyMiddleware)
+		}
 		group.Add(
 			route.Method,
 			route.Path,
 			responseMiddleware(route),
+			middlewares...,
 		)
 	}
 }
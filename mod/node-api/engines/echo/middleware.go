@@ -21,6 +21,7 @@
 package echo
 
 import (
+	"net"
 	"net/http"
 
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -47,6 +48,21 @@ func responseMiddleware(
 	}
 }
 
+// localOnlyMiddleware is a middleware that rejects the requests not made
+// from the loopback interface. The remote address of the connection is
+// checked rather than forwarding headers, which clients control.
+func localOnlyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c Context) error {
+		host, _, err := net.SplitHostPort(c.Request().RemoteAddr)
+		if ip := net.ParseIP(host); err != nil || ip == nil ||
+			!ip.IsLoopback() {
+			code, response := responseFromError(nil, types.ErrForbidden)
+			return c.JSON(code, response)
+		}
+		return next(c)
+	}
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
@@ -63,6 +79,16 @@ func responseFromError(data any, err error) (int, any) {
 			Code:    http.StatusBadRequest,
 			Message: err.Error(),
 		}
+	case errors.Is(err, types.ErrServiceUnavailable):
+		return http.StatusServiceUnavailable, ErrorResponse{
+			Code:    http.StatusServiceUnavailable,
+			Message: err.Error(),
+		}
+	case errors.Is(err, types.ErrForbidden):
+		return http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: err.Error(),
+		}
 	case errors.Is(err, types.ErrNotImplemented):
 		return http.StatusNotImplemented, ErrorResponse{
 			Code:    http.StatusNotImplemented,
@@ -60,12 +60,13 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 
 func ConstructValidator() *validator.Validate {
 	validators := map[string](func(fl validator.FieldLevel) bool){
-		"state_id":     ValidateStateID,
-		"block_id":     ValidateBlockID,
-		"timestamp_id": ValidateTimestampID,
-		"validator_id": ValidateValidatorID,
-		"epoch":        ValidateUint64,
-		"slot":         ValidateUint64,
+		"state_id":       ValidateStateID,
+		"block_id":       ValidateBlockID,
+		"timestamp_id":   ValidateTimestampID,
+		"validator_id":   ValidateValidatorID,
+		"epoch":          ValidateUint64,
+		"slot":           ValidateUint64,
+		"versioned_hash": ValidateVersionedHash,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -153,6 +154,12 @@ func ValidateRoot(value string) bool {
 	return valid
 }
 
+// ValidateVersionedHash checks if the provided field is a valid versioned
+// hash of a blob, a 32 byte hex-encoded hash with "0x" prefix.
+func ValidateVersionedHash(fl validator.FieldLevel) bool {
+	return ValidateRoot(fl.Field().String())
+}
+
 func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	// Eth Beacon Node API specs: https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
 	allowedStatuses := map[string]bool{
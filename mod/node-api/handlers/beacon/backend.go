@@ -56,6 +56,8 @@ type RandaoBackend interface {
 
 type BlobBackend[BeaconBlockHeaderT any] interface {
 	BlobSidecarsAtSlot(slot math.Slot, indices []uint64) ([]*types.BlobSidecarData[BeaconBlockHeaderT], error)
+	BlobSidecarByVersionedHash(hash common.ExecutionHash) (math.Slot, *types.BlobSidecarData[BeaconBlockHeaderT], error)
+	BlobSamples(hash common.ExecutionHash, indices []uint64) (math.Slot, *types.BlobSamplesData, error)
 }
 
 type BlockBackend[BeaconBlockHeaderT any] interface {
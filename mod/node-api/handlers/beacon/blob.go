@@ -24,9 +24,15 @@ import (
 	"strconv"
 
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
+// fieldElementsPerBlob is the number of 32-byte field elements in a blob.
+const fieldElementsPerBlob = uint64(len(eip4844.Blob{}) / 32)
+
 func (h *Handler[
 	BeaconBlockHeaderT, ContextT, _, _,
 ]) GetBlobSidecars(c ContextT) (any, error) {
@@ -60,3 +66,76 @@ func (h *Handler[
 		Data: blobSidecars,
 	}, nil
 }
+
+// GetBlobSidecarByVersionedHash returns the blob sidecar of the EIP-4844
+// versioned hash of a blob transaction, with its KZG proof and the inclusion
+// proof of its commitment in the block body.
+func (h *Handler[
+	BeaconBlockHeaderT, ContextT, _, _,
+]) GetBlobSidecarByVersionedHash(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlobSidecarByVersionedHashRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var hash common.ExecutionHash
+	if err = hash.UnmarshalText([]byte(req.VersionedHash)); err != nil {
+		return nil, err
+	}
+
+	slot, blobSidecar, err := h.backend.BlobSidecarByVersionedHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return beacontypes.BlobSidecarResponse[BeaconBlockHeaderT]{
+		Slot:          slot.Unwrap(),
+		VersionedHash: hash,
+		Data:          blobSidecar,
+	}, nil
+}
+
+// GetBlobSamples returns the field elements of the blob of the EIP-4844
+// versioned hash at the requested indices, each with the KZG proof opening
+// the commitment of the blob at the evaluation point of the element. Light
+// clients sampling random indices gain confidence that the node stores the
+// blob without downloading it.
+func (h *Handler[
+	_, ContextT, _, _,
+]) GetBlobSamples(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlobSamplesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var hash common.ExecutionHash
+	if err = hash.UnmarshalText([]byte(req.VersionedHash)); err != nil {
+		return nil, err
+	}
+
+	indices := make([]uint64, len(req.Indices))
+	for i, idx := range req.Indices {
+		indices[i], err = strconv.ParseUint(idx, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if indices[i] >= fieldElementsPerBlob {
+			return nil, types.ErrInvalidRequest
+		}
+	}
+
+	slot, samples, err := h.backend.BlobSamples(hash, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	return beacontypes.BlobSamplesResponse{
+		Slot:          slot.Unwrap(),
+		VersionedHash: hash,
+		Data:          samples,
+	}, nil
+}
@@ -118,11 +118,6 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Path:    "/eth/v1/beacon/blocks",
 			Handler: h.NotImplemented,
 		},
-		{
-			Method:  http.MethodPost,
-			Path:    "eth/v2/beacon/blocks",
-			Handler: h.NotImplemented,
-		},
 		{
 			Method:  http.MethodGet,
 			Path:    "eth/v2/beacon/blocks/:block_id",
@@ -143,6 +138,16 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
 			Handler: h.GetBlobSidecars,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/blobs/:versioned_hash",
+			Handler: h.GetBlobSidecarByVersionedHash,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/blobs/:versioned_hash/samples",
+			Handler: h.GetBlobSamples,
+		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/rewards/sync_committee/:block_id",
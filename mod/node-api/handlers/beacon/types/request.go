@@ -131,6 +131,15 @@ type GetBlobSidecarsRequest struct {
 	Indices []string `query:"indices" validate:"dive,numeric"`
 }
 
+type GetBlobSidecarByVersionedHashRequest struct {
+	VersionedHash string `param:"versioned_hash" validate:"required,versioned_hash"`
+}
+
+type GetBlobSamplesRequest struct {
+	VersionedHash string   `param:"versioned_hash" validate:"required,versioned_hash"`
+	Indices       []string `query:"indices"        validate:"required,max=16,dive,numeric"`
+}
+
 type PostRewardsSyncCommitteeRequest struct {
 	types.BlockIDRequest
 	IDs []string `validate:"dive,validator_id"`
@@ -97,3 +97,35 @@ type BlobSidecarData[BlockHeaderT any] struct {
 type BlobSidecarsResponse[BlockHeaderT any] struct {
 	Data []*BlobSidecarData[BlockHeaderT] `json:"data"`
 }
+
+// BlobSidecarResponse is the blob sidecar of a versioned hash, with the slot
+// of its block.
+type BlobSidecarResponse[BlockHeaderT any] struct {
+	Slot          uint64                         `json:"slot,string"`
+	VersionedHash common.ExecutionHash           `json:"versioned_hash"`
+	Data          *BlobSidecarData[BlockHeaderT] `json:"data"`
+}
+
+// BlobSampleData is a field element of a blob, with the KZG proof opening the
+// commitment of the blob at the evaluation point of the element.
+type BlobSampleData struct {
+	Index    uint64 `json:"index,string"`
+	Point    string `json:"point"`
+	Value    string `json:"value"`
+	KZGProof string `json:"kzg_proof"`
+}
+
+// BlobSamplesData is the commitment of a blob with samples of its field
+// elements.
+type BlobSamplesData struct {
+	KZGCommitment string            `json:"kzg_commitment"`
+	Samples       []*BlobSampleData `json:"samples"`
+}
+
+// BlobSamplesResponse is the samples of the blob of a versioned hash, with
+// the slot of its block.
+type BlobSamplesResponse struct {
+	Slot          uint64               `json:"slot,string"`
+	VersionedHash common.ExecutionHash `json:"versioned_hash"`
+	Data          *BlobSamplesData     `json:"data"`
+}
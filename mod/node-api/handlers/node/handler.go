@@ -25,15 +25,27 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
+// HealthChecker reports the health of a component of the node.
+type HealthChecker interface {
+	// Health returns an error describing why the component is unhealthy, or
+	// nil if it is healthy.
+	Health() error
+}
+
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	// checkers report the health of the node.
+	checkers []HealthChecker
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+func NewHandler[ContextT context.Context](
+	checkers ...HealthChecker,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		checkers: checkers,
 	}
 	return h
 }
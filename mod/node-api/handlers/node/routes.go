@@ -65,7 +65,7 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/health",
-			Handler: h.NotImplemented,
+			Handler: h.Health,
 		},
 	})
 }
@@ -29,6 +29,9 @@ type Route[ContextT any] struct {
 	Method  string
 	Path    string
 	Handler handlerFn[ContextT]
+	// LocalOnly restricts the route to requests made from the loopback
+	// interface, for routes changing the behaviour of the node.
+	LocalOnly bool
 }
 
 // DecorateWithLogs adds logging to the route's handler function as soon as
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Backend is the interface for backend of the validator API.
+type Backend[ValidatorT any] interface {
+	ValidatorByID(
+		slot math.Slot, id string,
+	) (*beacontypes.ValidatorData[ValidatorT], error)
+}
+
+// BlockProducer produces blocks for an external signer and accepts them back
+// once signed.
+type BlockProducer[BeaconBlockT, BlobSidecarsT any] interface {
+	// ProduceBlock builds the block of the given slot being proposed, with
+	// the given randao reveal.
+	ProduceBlock(
+		slot math.Slot, reveal crypto.BLSSignature,
+	) (BeaconBlockT, BlobSidecarsT, error)
+	// PublishBlock accepts the SSZ encoded block previously produced back
+	// with its signature.
+	PublishBlock(bz []byte, signature crypto.BLSSignature) error
+}
+
+// ProposerSettings holds the settings of the proposers of the node.
+type ProposerSettings interface {
+	// SetFeeRecipient sets the fee recipient of the given proposer.
+	SetFeeRecipient(
+		pubkey crypto.BLSPubkey, feeRecipient common.ExecutionAddress,
+	)
+}
+
+// Validator is the interface for a validator of the beacon state.
+type Validator interface {
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// forkName is the name of the fork of the produced blocks.
+const forkName = "deneb"
+
+// ProduceBlock builds the block of the requested slot, which must be being
+// proposed, with the randao reveal of the external signer, and returns it
+// and its blob sidecars SSZ encoded for signing.
+func (h *Handler[_, _, ContextT, _]) ProduceBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.ProduceBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.U64FromString(req.Slot)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	var reveal crypto.BLSSignature
+	if err = reveal.UnmarshalText([]byte(req.RandaoReveal)); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	blk, sidecars, err := h.producer.ProduceBlock(slot, reveal)
+	if err != nil {
+		return nil, err
+	}
+
+	blkBz, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	sidecarsBz, err := sidecars.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return validatortypes.ProduceBlockResponse{
+		Version:                 forkName,
+		ExecutionPayloadBlinded: false,
+		Data: validatortypes.BlockContents{
+			Block:        blkBz,
+			BlobSidecars: sidecarsBz,
+		},
+	}, nil
+}
+
+// PublishBlock accepts a block produced for the external signer back with
+// its signature, to be proposed in its slot.
+func (h *Handler[_, _, ContextT, _]) PublishBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.PublishBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message   bytes.Bytes
+		signature crypto.BLSSignature
+	)
+	if err = message.UnmarshalText([]byte(req.Message)); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	if err = signature.UnmarshalText([]byte(req.Signature)); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	if err = h.producer.PublishBlock(message, signature); err != nil {
+		return nil, err
+	}
+	return nil, nil //nolint:nilnil // empty response on success.
+}
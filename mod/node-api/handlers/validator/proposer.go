@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// PrepareBeaconProposer sets the fee recipients of the given validators for
+// their upcoming proposals. The fee recipients take precedence over the
+// proposer configuration until the node restarts. As it redirects the fees
+// of the validators, it is only served to requests made from the loopback
+// interface.
+func (h *Handler[_, _, ContextT, _]) PrepareBeaconProposer(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.PrepareProposerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request is a list, so its elements are validated one by one, and
+	// all of them before any fee recipient is set.
+	pubkeys := make([]crypto.BLSPubkey, len(req))
+	feeRecipients := make([]common.ExecutionAddress, len(req))
+	for i, preparation := range req {
+		if err = c.Validate(&preparation); err != nil {
+			return nil, types.ErrInvalidRequest
+		}
+		if err = feeRecipients[i].UnmarshalText(
+			[]byte(preparation.FeeRecipient),
+		); err != nil {
+			return nil, types.ErrInvalidRequest
+		}
+		validator, lookupErr := h.backend.ValidatorByID(
+			utils.Head, preparation.ValidatorIndex,
+		)
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		pubkeys[i] = validator.Validator.GetPubkey()
+	}
+
+	for i, pubkey := range pubkeys {
+		h.proposerSettings.SetFeeRecipient(pubkey, feeRecipients[i])
+		h.Logger().Info(
+			"Prepared beacon proposer",
+			"validator_index", req[i].ValidatorIndex,
+			"fee_recipient", feeRecipients[i],
+		)
+	}
+	return nil, nil //nolint:nilnil // empty response on success.
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// PrepareProposerRequest is the request to set the fee recipients of
+// validators for their upcoming proposals.
+type PrepareProposerRequest []ProposerPreparation
+
+// ProposerPreparation is the fee recipient of a validator for its upcoming
+// proposals.
+type ProposerPreparation struct {
+	ValidatorIndex string `json:"validator_index" validate:"required,validator_id"`
+	FeeRecipient   string `json:"fee_recipient"   validate:"required,eth_addr"`
+}
+
+// ProduceBlockRequest is the request to produce the block of a slot for an
+// external signer.
+type ProduceBlockRequest struct {
+	Slot         string `param:"slot"          validate:"required,slot"`
+	RandaoReveal string `query:"randao_reveal" validate:"required"`
+}
+
+// PublishBlockRequest is the request to publish a block produced for an
+// external signer along with its signature.
+type PublishBlockRequest struct {
+	Message   string `json:"message"   validate:"required"`
+	Signature string `json:"signature" validate:"required"`
+}
@@ -27,12 +27,16 @@ import (
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
+// errNilConfig is returned when the components are built without a config.
+var errNilConfig = errors.New("config is nil")
+
 // NodeBuilder is a construction helper for creating nodes that implement
 // the types.NodeI interface.
 // TODO: #Make nodebuilder build a node. Currently this is just a builder for
@@ -90,17 +94,7 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 
 	// build all node components using depinject
 	if err := depinject.Inject(
-		depinject.Configs(
-			depinject.Provide(
-				nb.components...,
-			),
-			depinject.Supply(
-				appOpts,
-				logger,
-				db,
-				cmtCfg,
-			),
-		),
+		nb.configs(logger, db, cmtCfg, appOpts),
 		&apiBackend,
 		&beaconNode,
 		&cmtService,
@@ -120,3 +114,49 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 	apiBackend.AttachQueryBackend(cmtService)
 	return beaconNode
 }
+
+// Inject uses the node builder options and runtime parameters to build the
+// given components of the node, without building the node itself. It is
+// used by the commands working on the data of a stopped node, and adheres
+// to the types.ComponentInjector[T] interface.
+func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Inject(
+	logger LoggerT,
+	db dbm.DB,
+	cmtCfg *cmtcfg.Config,
+	appOpts servertypes.AppOptions,
+	outputs ...any,
+) error {
+	var config *config.Config
+	if err := depinject.Inject(
+		nb.configs(logger, db, cmtCfg, appOpts),
+		append(outputs, &config)...,
+	); err != nil {
+		return err
+	}
+	if config == nil {
+		return errNilConfig
+	}
+
+	logger.WithConfig(any(config.GetLogger()).(LoggerConfigT))
+	return nil
+}
+
+// configs returns the depinject configuration of the node components.
+func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) configs(
+	logger LoggerT,
+	db dbm.DB,
+	cmtCfg *cmtcfg.Config,
+	appOpts servertypes.AppOptions,
+) depinject.Config {
+	return depinject.Configs(
+		depinject.Provide(
+			nb.components...,
+		),
+		depinject.Supply(
+			appOpts,
+			logger,
+			db,
+			cmtCfg,
+		),
+	)
+}
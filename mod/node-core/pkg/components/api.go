@@ -23,6 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
@@ -52,6 +53,7 @@ type NodeAPIBackendInput[
 		DepositT, ExecutionPayloadHeaderT,
 	]
 	StorageBackend StorageBackendT
+	BlobSampler    *kzg.BlobSampler
 }
 
 func ProvideNodeAPIBackend[
@@ -118,6 +120,7 @@ func ProvideNodeAPIBackend[
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.BlobSampler,
 	)
 }
 
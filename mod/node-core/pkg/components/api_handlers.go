@@ -22,6 +22,10 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/beacon/validator/proposer"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
@@ -30,9 +34,12 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/mod/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 )
 
 type NodeAPIHandlersInput[
+	BeaconBlockT constraints.SSZMarshaler,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
@@ -43,6 +50,7 @@ type NodeAPIHandlersInput[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlobSidecarsT constraints.SSZMarshaler,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
@@ -61,9 +69,13 @@ type NodeAPIHandlersInput[
 		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
 		NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
 	]
+	ValidatorAPIHandler *validatorapi.Handler[
+		BeaconBlockT, BlobSidecarsT, NodeAPIContextT, *Validator,
+	]
 }
 
 func ProvideNodeAPIHandlers[
+	BeaconBlockT constraints.SSZMarshaler,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
@@ -74,15 +86,16 @@ func ProvideNodeAPIHandlers[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlobSidecarsT constraints.SSZMarshaler,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
 	WithdrawalT Withdrawal[WithdrawalT],
 ](
 	in NodeAPIHandlersInput[
-		BeaconBlockHeaderT, BeaconStateT,
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, KVStoreT,
-		NodeAPIContextT, WithdrawalT,
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT,
+		BeaconStateMarshallableT, BlobSidecarsT, ExecutionPayloadHeaderT,
+		KVStoreT, NodeAPIContextT, WithdrawalT,
 	],
 ) []handlers.Handlers[NodeAPIContextT] {
 	return []handlers.Handlers[NodeAPIContextT]{
@@ -93,6 +106,7 @@ func ProvideNodeAPIHandlers[
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
@@ -143,9 +157,23 @@ func ProvideNodeAPIEventsHandler[
 }
 
 func ProvideNodeAPINodeHandler[
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	NodeAPIContextT NodeAPIContext,
-]() *nodeapi.Handler[NodeAPIContextT] {
-	return nodeapi.NewHandler[NodeAPIContextT]()
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	engineClient *client.EngineClient[
+		ExecutionPayloadT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+	],
+	proposerReadiness *validator.Readiness,
+) *nodeapi.Handler[NodeAPIContextT] {
+	return nodeapi.NewHandler[NodeAPIContextT](
+		engineClient, proposerReadiness,
+	)
 }
 
 func ProvideNodeAPIProofHandler[
@@ -183,3 +211,51 @@ func ProvideNodeAPIProofHandler[
 		*Validator,
 	](b)
 }
+
+func ProvideNodeAPIValidatorHandler[
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
+		*Validator, Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT any,
+	BlobSidecarsT constraints.SSZMarshaler,
+	DepositT any,
+	DepositStoreT DepositStore[DepositT],
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT any,
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	b NodeAPIBackend[
+		BeaconBlockHeaderT,
+		BeaconStateT,
+		*Fork,
+		NodeT,
+		*Validator,
+	],
+	validatorService *validator.Service[
+		*AttestationData, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
+		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		*ForkData, *SlashingInfo, *SlotData, WithdrawalT,
+	],
+	proposerSettings *proposer.Store,
+) *validatorapi.Handler[
+	BeaconBlockT, BlobSidecarsT, NodeAPIContextT, *Validator,
+] {
+	return validatorapi.NewHandler[
+		BeaconBlockT, BlobSidecarsT, NodeAPIContextT, *Validator,
+	](b, validatorService, proposerSettings)
+}
@@ -22,19 +22,23 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator/proposer"
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 type AttributesFactoryInput[LoggerT any] struct {
 	depinject.In
 
-	ChainSpec common.ChainSpec
-	Config    *config.Config
-	Logger    LoggerT
+	ChainSpec        common.ChainSpec
+	Config           *config.Config
+	Logger           LoggerT
+	ProposerSettings *proposer.Store
+	Signer           crypto.BLSSigner
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -62,6 +66,7 @@ func ProvideAttributesFactory[
 	](
 		in.ChainSpec,
 		in.Logger,
-		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.Signer.PublicKey(),
+		in.ProposerSettings,
 	), nil
 }
@@ -26,6 +26,7 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store/archive"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -37,12 +38,17 @@ import (
 	"github.com/spf13/cast"
 )
 
+// blobsShardSize is the number of slots of blob sidecars kept in each
+// directory of the availability store.
+const blobsShardSize = 1024
+
 // AvailabilityStoreInput is the input for the ProviderAvailabilityStore
 // function for the depinject framework.
 type AvailabilityStoreInput[LoggerT any] struct {
 	depinject.In
 	AppOpts   config.AppOptions
 	ChainSpec common.ChainSpec
+	Cfg       *config.Config
 	Logger    LoggerT
 }
 
@@ -55,22 +61,69 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput[LoggerT],
 ) (*dastore.Store[BeaconBlockBodyT], error) {
-	return dastore.New[BeaconBlockBodyT](
-		filedb.NewRangeDB(
-			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
-				filedb.WithFileExtension("ssz"),
-				filedb.WithDirectoryPermissions(os.ModePerm),
-				filedb.WithLogger(in.Logger),
-			),
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	archiveCfg := in.Cfg.BlobArchive
+	if err := archiveCfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var cold *archive.Archive
+	if archiveCfg.Mode == archive.ModeSegments {
+		var err error
+		if cold, err = archive.New(
+			homeDir+"/data/blobs-archive",
+			archiveCfg.SegmentEpochs*in.ChainSpec.SlotsPerEpoch(),
+			in.Logger.With("service", "da-archive"),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	db := filedb.NewDB(
+		filedb.WithRootDirectory(homeDir+"/data/blobs"),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(os.ModePerm),
+		filedb.WithLogger(in.Logger),
+		filedb.WithShardSize(blobsShardSize),
+	)
+	// quarantine the blob sidecars left corrupt by a crash, so that they are
+	// not reported as available.
+	quarantined, err := db.Recover()
+	if err != nil {
+		return nil, err
+	}
+	if quarantined > 0 {
+		in.Logger.Warn("Quarantined corrupt blob sidecars", "num", quarantined)
+	}
+
+	store := dastore.New[BeaconBlockBodyT](
+		filedb.NewRangeDB(db),
+		filedb.NewDB(
+			filedb.WithRootDirectory(homeDir+"/data/blobs-index"),
+			filedb.WithFileExtension("idx"),
+			filedb.WithDirectoryPermissions(os.ModePerm),
+			filedb.WithLogger(in.Logger),
 		),
+		archiveCfg.Mode,
+		cold,
 		in.Logger.With("service", "da-store"),
 		in.ChainSpec,
-	), nil
+	)
+
+	// index the blob sidecars stored before the versioned hash index, so
+	// that they can be looked up by versioned hash on upgraded nodes.
+	slots, err := db.Indexes()
+	if err != nil {
+		return nil, err
+	}
+	indexed, err := store.Reindex(slots)
+	if err != nil {
+		return nil, err
+	}
+	if indexed > 0 {
+		in.Logger.Info("Indexed blob sidecars by versioned hash", "num", indexed)
+	}
+	return store, nil
 }
 
 // AvailabilityPrunerInput is the input for the ProviderAvailabilityPruner
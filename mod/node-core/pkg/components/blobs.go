@@ -24,14 +24,16 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/config"
-	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/da/pkg/da"
+	"github.com/berachain/beacon-kit/mod/da/pkg/integrity"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/transfer"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	"github.com/spf13/cast"
 )
 
@@ -40,7 +42,7 @@ import (
 type BlobProofVerifierInput struct {
 	depinject.In
 	AppOpts          config.AppOptions
-	JSONTrustedSetup *gokzg4844.JSONTrustedSetup
+	JSONTrustedSetup *goethkzg.JSONTrustedSetup
 }
 
 // ProvideBlobProofVerifier is a function that provides the module to the
@@ -54,10 +56,19 @@ func ProvideBlobProofVerifier(
 	)
 }
 
+// ProvideBlobSampler is a function that provides the sampler of the blobs
+// served to light clients.
+func ProvideBlobSampler(
+	blobProofVerifier kzg.BlobProofVerifier,
+) *kzg.BlobSampler {
+	return kzg.NewBlobSampler(blobProofVerifier)
+}
+
 // BlobVerifierInput is the input for the BlobVerifier.
 type BlobVerifierInput struct {
 	depinject.In
 	BlobProofVerifier kzg.BlobProofVerifier
+	ChainSpec         common.ChainSpec
 	TelemetrySink     *metrics.TelemetrySink
 }
 
@@ -74,7 +85,7 @@ func ProvideBlobVerifier[
 		BeaconBlockHeaderT,
 		BlobSidecarT,
 		BlobSidecarsT,
-	](in.BlobProofVerifier, in.TelemetrySink)
+	](in.BlobProofVerifier, in.ChainSpec, in.TelemetrySink)
 }
 
 // BlobProcessorIn is the input for the BlobProcessor.
@@ -115,7 +126,6 @@ func ProvideBlobProcessor[
 		in.Logger.With("service", "blob-processor"),
 		in.ChainSpec,
 		in.BlobVerifier,
-		types.BlockBodyKZGOffset,
 		in.TelemetrySink,
 	)
 }
@@ -160,3 +170,100 @@ func ProvideDAService[
 		in.Logger.With("service", "da"),
 	)
 }
+
+// BlobArchiverInput is the input for the ProvideBlobArchiver function for
+// the depinject framework.
+type BlobArchiverInput[
+	AvailabilityStoreT any,
+	BeaconBlockStoreT any,
+	LoggerT any,
+] struct {
+	depinject.In
+	AvailabilityStore AvailabilityStoreT
+	BlockStore        BeaconBlockStoreT
+	BlobVerifier      BlobVerifier[*datypes.BlobSidecars]
+	ChainSpec         common.ChainSpec
+	Logger            LoggerT
+}
+
+// ProvideBlobArchiver provides the exporter and importer of the blob
+// sidecars of the availability store.
+func ProvideBlobArchiver[
+	AvailabilityStoreT transfer.AvailabilityStore,
+	BeaconBlockStoreT transfer.BlockStore,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BlobArchiverInput[AvailabilityStoreT, BeaconBlockStoreT, LoggerT],
+) *transfer.Archiver {
+	return transfer.New(
+		in.AvailabilityStore,
+		in.BlockStore,
+		in.BlobVerifier,
+		in.ChainSpec,
+		in.Logger.With("service", "blob-archiver"),
+	)
+}
+
+// BlobCheckerInput is the input for the ProvideBlobChecker function for the
+// depinject framework.
+type BlobCheckerInput[
+	AvailabilityStoreT any,
+	BeaconBlockStoreT any,
+	LoggerT any,
+] struct {
+	depinject.In
+	AvailabilityStore AvailabilityStoreT
+	BlockStore        BeaconBlockStoreT
+	BlobVerifier      BlobVerifier[*datypes.BlobSidecars]
+	ChainSpec         common.ChainSpec
+	Logger            LoggerT
+	TelemetrySink     *metrics.TelemetrySink
+}
+
+// ProvideBlobChecker provides the integrity checker of the blob sidecars of
+// the availability store.
+func ProvideBlobChecker[
+	AvailabilityStoreT integrity.AvailabilityStore,
+	BeaconBlockStoreT integrity.BlockStore,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BlobCheckerInput[AvailabilityStoreT, BeaconBlockStoreT, LoggerT],
+) *integrity.Checker {
+	return integrity.New(
+		in.AvailabilityStore,
+		in.BlockStore,
+		in.BlobVerifier,
+		in.ChainSpec,
+		in.TelemetrySink,
+		in.Logger.With("service", "blob-integrity"),
+	)
+}
+
+// BlobIntegrityServiceInput is the input for the
+// ProvideBlobIntegrityService function for the depinject framework.
+type BlobIntegrityServiceInput[LoggerT any] struct {
+	depinject.In
+	BlobChecker *integrity.Checker
+	Config      *config.Config
+	Dispatcher  Dispatcher
+	Logger      LoggerT
+}
+
+// ProvideBlobIntegrityService provides the service checking the blob
+// sidecars of the availability store in the background.
+func ProvideBlobIntegrityService[
+	BeaconBlockT integrity.BeaconBlock,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BlobIntegrityServiceInput[LoggerT],
+) (*integrity.Service[BeaconBlockT], error) {
+	if err := in.Config.BlobIntegrity.Validate(); err != nil {
+		return nil, err
+	}
+	return integrity.NewService[BeaconBlockT](
+		in.Config.BlobIntegrity,
+		in.BlobChecker,
+		in.Dispatcher,
+		in.Logger.With("service", "blob-integrity"),
+	), nil
+}
@@ -21,13 +21,25 @@
 package components
 
 import (
+	"context"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstore "github.com/cometbft/cometbft/store"
 )
 
+// errMissingBeaconBlock is returned when a block committed by CometBFT does
+// not hold a beacon block.
+var errMissingBeaconBlock = errors.New("missing beacon block")
+
 // BlockStoreInput is the input for the dep inject framework.
 type BlockStoreInput[
 	BeaconBlockT BeaconBlock[
@@ -62,3 +74,100 @@ func ProvideBlockStore[
 		in.Config.BlockStoreService.AvailabilityWindow,
 	), nil
 }
+
+// BlockStoreLoaderInput is the input for the ProvideBlockStoreLoader
+// function for the depinject framework.
+type BlockStoreLoaderInput[BeaconBlockStoreT any] struct {
+	depinject.In
+	BlockStore BeaconBlockStoreT
+	ChainSpec  common.ChainSpec
+	CmtCfg     *cmtcfg.Config
+	Config     *config.Config
+}
+
+// ProvideBlockStoreLoader provides the loader of the blocks committed by
+// CometBFT into the block store, used by the commands run on a stopped node.
+func ProvideBlockStoreLoader[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+	BeaconBlockStoreT BlockStore[BeaconBlockT],
+](
+	in BlockStoreLoaderInput[BeaconBlockStoreT],
+) *BlockStoreLoader[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconBlockStoreT,
+] {
+	return &BlockStoreLoader[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconBlockStoreT,
+	]{
+		store:     in.BlockStore,
+		chainSpec: in.ChainSpec,
+		cmtCfg:    in.CmtCfg,
+		window:    int64(in.Config.BlockStoreService.AvailabilityWindow),
+	}
+}
+
+// BlockStoreLoader loads the beacon blocks committed by CometBFT into the
+// block store.
+type BlockStoreLoader[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+	BeaconBlockStoreT BlockStore[BeaconBlockT],
+] struct {
+	// store is the block store the blocks are loaded into.
+	store BeaconBlockStoreT
+	// chainSpec gives the fork version of the blocks.
+	chainSpec common.ChainSpec
+	// cmtCfg locates the block store of CometBFT.
+	cmtCfg *cmtcfg.Config
+	// window is the number of blocks the block store holds.
+	window int64
+}
+
+// LoadBlocks loads the last blocks committed by CometBFT, as many as the
+// block store holds.
+func (l *BlockStoreLoader[BeaconBlockT, _, _, _]) LoadBlocks(
+	ctx context.Context,
+) error {
+	db, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: l.cmtCfg},
+	)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	blocks := cmtstore.NewBlockStore(db)
+	from := max(blocks.Base(), blocks.Height()-l.window+1, 1)
+	for height := from; height <= blocks.Height(); height++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		blk, _ := blocks.LoadBlock(height)
+		if blk == nil ||
+			uint(len(blk.Txs)) <= middleware.BeaconBlockTxIndex {
+			return errors.Wrapf(
+				errMissingBeaconBlock, "height %d", height,
+			)
+		}
+
+		var beaconBlk BeaconBlockT
+		//#nosec:G115 // the heights of the block store are positive.
+		slot := math.Slot(height)
+		if beaconBlk, err = beaconBlk.NewFromSSZ(
+			blk.Txs[middleware.BeaconBlockTxIndex],
+			l.chainSpec.ActiveForkVersionForSlot(slot),
+		); err != nil {
+			return errors.Wrapf(err, "height %d", height)
+		}
+		if err = l.store.Set(beaconBlk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -24,9 +24,11 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	"github.com/berachain/beacon-kit/mod/config"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/inclusionlist"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -43,15 +45,16 @@ type ChainServiceInput[
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	StorageBackendT any,
-	LoggerT any,
+	LoggerT log.AdvancedLogger[LoggerT],
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
 
-	ChainSpec    common.ChainSpec
-	Cfg          *config.Config
-	EngineClient *client.EngineClient[
+	ChainSpec       common.ChainSpec
+	Cfg             *config.Config
+	CometBFTService *cometbft.Service[LoggerT]
+	EngineClient    *client.EngineClient[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
@@ -62,6 +65,7 @@ type ChainServiceInput[
 		WithdrawalsT,
 	]
 	Dispatcher     Dispatcher
+	InclusionLists *inclusionlist.Store[ExecutionPayloadT]
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
 	Signer         crypto.BLSSigner
@@ -138,5 +142,8 @@ func ProvideChainService[
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+		in.CometBFTService,
+		in.Cfg.PayloadBuilder.SpeculativeBuildRounds,
+		in.InclusionLists,
 	)
 }
@@ -64,6 +64,7 @@ func ProvideChainSpec() common.ChainSpec {
 
 		sd := spec.BaseSpec()
 		sd.DepositEth1ChainID = chainSpecInput.Eth1ChainID
+		sd.VoteExtensionsEnableHeight = chainSpecInput.VoteExtensionsEnableHeight
 		chainSpec = chain.NewChainSpec(sd)
 
 		return chainSpec
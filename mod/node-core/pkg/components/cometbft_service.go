@@ -24,6 +24,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/inclusionlist"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -33,6 +34,7 @@ import (
 
 // ProvideCometBFTService provides the CometBFT service component.
 func ProvideCometBFTService[
+	ExecutionPayloadT inclusionlist.ExecutionPayload,
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	logger LoggerT,
@@ -42,6 +44,7 @@ func ProvideCometBFTService[
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
 	chainSpec common.ChainSpec,
+	inclusionLists *inclusionlist.Store[ExecutionPayloadT],
 ) *cometbft.Service[LoggerT] {
 	return cometbft.NewService(
 		storeKey,
@@ -50,6 +53,9 @@ func ProvideCometBFTService[
 		abciMiddleware,
 		cmtCfg,
 		chainSpec,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
+		append(
+			builder.DefaultServiceOptions[LoggerT](appOpts),
+			cometbft.SetVoteExtender[LoggerT](inclusionLists),
+		)...,
 	)
 }
@@ -22,18 +22,19 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // DepositServiceIn is the input for the deposit service.
 type DepositServiceIn[
 	BeaconBlockT any,
+	BeaconStateT any,
 	DepositContractT any,
 	DepositStoreT any,
 	ExecutionPayloadT ExecutionPayload[
@@ -41,20 +42,23 @@ type DepositServiceIn[
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	LoggerT any,
+	StorageBackendT any,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
 	BeaconDepositContract DepositContractT
 	ChainSpec             common.ChainSpec
+	Config                *config.Config
 	DepositStore          DepositStoreT
 	Dispatcher            Dispatcher
 	EngineClient          *client.EngineClient[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
-	Logger        LoggerT
-	TelemetrySink *metrics.TelemetrySink
+	Logger         LoggerT
+	StorageBackend StorageBackendT
+	TelemetrySink  *metrics.TelemetrySink
 }
 
 // ProvideDepositService provides the deposit service to the depinject
@@ -68,6 +72,7 @@ func ProvideDepositService[
 		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
 	],
 	BeaconBlockHeaderT any,
+	BeaconStateT deposit.BeaconState,
 	DepositT Deposit[
 		DepositT, *ForkData, WithdrawalCredentials,
 	],
@@ -78,29 +83,45 @@ func ProvideDepositService[
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT deposit.StorageBackend[BeaconStateT],
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalsT Withdrawals[WithdrawalT],
 ](
 	in DepositServiceIn[
-		BeaconBlockT, DepositContractT, DepositStoreT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, LoggerT, WithdrawalT, WithdrawalsT,
+		BeaconBlockT, BeaconStateT, DepositContractT, DepositStoreT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, LoggerT, StorageBackendT,
+		WithdrawalT, WithdrawalsT,
 	],
 ) (*deposit.Service[
-	BeaconBlockT, BeaconBlockBodyT, DepositT,
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT,
 	ExecutionPayloadT, WithdrawalCredentials,
 ], error) {
+	// Stream deposits from the execution client if an endpoint is set.
+	var sc deposit.SubscriptionClient[DepositT]
+	if in.Config.Deposit.SubscriptionURL != "" {
+		sc = deposit.NewSubscriber[DepositT, WithdrawalCredentials](
+			in.Config.Deposit.SubscriptionURL,
+			in.ChainSpec.DepositContractAddress(),
+		)
+	}
+
 	// Build the deposit service.
 	return deposit.NewService[
 		BeaconBlockT,
 		BeaconBlockBodyT,
+		BeaconStateT,
 		DepositT,
 		ExecutionPayloadT,
 	](
+		&in.Config.Deposit,
 		in.Logger.With("service", "deposit"),
-		math.U64(in.ChainSpec.Eth1FollowDistance()),
 		in.TelemetrySink,
+		in.ChainSpec,
+		in.StorageBackend,
+		in.EngineClient,
 		in.DepositStore,
 		in.BeaconDepositContract,
+		sc,
 		in.Dispatcher,
 	), nil
 }
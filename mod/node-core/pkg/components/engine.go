@@ -21,9 +21,8 @@
 package components
 
 import (
-	"math/big"
-
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
@@ -68,7 +67,7 @@ func ProvideEngineClient[
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
 		in.TelemetrySink,
-		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
+		in.ChainSpec,
 	)
 }
 
@@ -87,8 +86,9 @@ type ExecutionEngineInputs[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
-	Logger        LoggerT
-	TelemetrySink *metrics.TelemetrySink
+	Logger            LoggerT
+	ProposerReadiness *validator.Readiness
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideExecutionEngine provides the execution engine to the depinject
@@ -120,6 +120,7 @@ func ProvideExecutionEngine[
 	](
 		in.EngineClient,
 		in.Logger.With("service", "execution-engine"),
+		in.ProposerReadiness,
 		in.TelemetrySink,
 	)
 }
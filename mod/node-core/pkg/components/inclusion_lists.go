@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/inclusionlist"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// InclusionListsInput is the input for the dep inject framework.
+type InclusionListsInput[
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT any,
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+] struct {
+	depinject.In
+	ChainSpec    common.ChainSpec
+	Config       *config.Config
+	EngineClient *client.EngineClient[
+		ExecutionPayloadT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+	]
+	Logger        LoggerT
+	TelemetrySink *metrics.TelemetrySink
+}
+
+// ProvideInclusionLists provides the store of the inclusion lists of the
+// validators to the depinject framework.
+func ProvideInclusionLists[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT interface {
+		GetExecutionPayload() ExecutionPayloadT
+	},
+	BeaconBlockHeaderT any,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in InclusionListsInput[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, LoggerT, WithdrawalT,
+		WithdrawalsT,
+	],
+) *inclusionlist.Store[ExecutionPayloadT] {
+	return inclusionlist.NewStore[ExecutionPayloadT](
+		&in.Config.InclusionList,
+		in.Logger.With("service", "inclusion-lists"),
+		in.EngineClient,
+		func(slot math.Slot, txs [][]byte) (ExecutionPayloadT, error) {
+			var (
+				blk     BeaconBlockT
+				payload ExecutionPayloadT
+				err     error
+			)
+			if uint(len(txs)) <= middleware.BeaconBlockTxIndex {
+				return payload, errMissingBeaconBlock
+			}
+			if blk, err = blk.NewFromSSZ(
+				txs[middleware.BeaconBlockTxIndex],
+				in.ChainSpec.ActiveForkVersionForSlot(slot),
+			); err != nil {
+				return payload, err
+			}
+			return blk.GetBody().GetExecutionPayload(), nil
+		},
+		in.ChainSpec.DepositEth1ChainID(),
+		in.TelemetrySink,
+	)
+}
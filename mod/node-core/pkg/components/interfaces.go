@@ -25,6 +25,8 @@ import (
 	"context"
 	"encoding/json"
 
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
@@ -80,6 +82,9 @@ type (
 		Persist(math.Slot, BlobSidecarsT) error
 		// GetBlobsFromStore returns all blob sidecars for a given slot.
 		GetBlobsFromStore(math.Slot) (BlobSidecarsT, error)
+		// LookupVersionedHash returns the slot and the index of the blob
+		// sidecar of a versioned hash.
+		LookupVersionedHash(common.ExecutionHash) (math.Slot, uint64, error)
 	}
 
 	// BeaconBlock represents a generic interface for a beacon block.
@@ -137,6 +142,10 @@ type (
 		GetTopLevelRoots() []common.Root
 		// GetRandaoReveal returns the RANDAO reveal signature.
 		GetRandaoReveal() crypto.BLSSignature
+		// GetEth1Data returns the Eth1 data of the beacon block body.
+		GetEth1Data() Eth1DataT
+		// GetGraffiti returns the graffiti of the beacon block body.
+		GetGraffiti() common.Bytes32
 		// GetExecutionPayload returns the execution payload.
 		GetExecutionPayload() ExecutionPayloadT
 		// GetDeposits returns the list of deposits.
@@ -160,6 +169,10 @@ type (
 		// SetBlobKzgCommitments sets the blob KZG commitments of the beacon
 		// block body.
 		SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+		// HashTreeRootBlinded returns the hash tree root of the beacon block
+		// body with its execution payload replaced by the header with the
+		// given hash tree root.
+		HashTreeRootBlinded(common.Root) common.Root
 	}
 
 	// BeaconBlockHeader is the interface for a beacon block header.
@@ -179,6 +192,7 @@ type (
 		GetStateRoot() common.Root
 		SetStateRoot(common.Root)
 		GetBodyRoot() common.Root
+		SetBodyRoot(common.Root)
 		GetTree() (*fastssz.Node, error)
 	}
 
@@ -232,10 +246,14 @@ type (
 		VerifySidecars(
 			sidecars BlobSidecarsT,
 		) error
+		// VerifySidecarsBatch verifies the blobs of several blocks, returning
+		// the error of each block at its index.
+		VerifySidecarsBatch(blocks []BlobSidecarsT) []error
 	}
 
 	// BlobSidecar is the interface for a single blob sidecar.
 	BlobSidecar[BeaconBlockHeaderT any] interface {
+		HashTreeRoot() common.Root
 		GetIndex() uint64
 		GetBeaconBlockHeader() BeaconBlockHeaderT
 		GetBlob() eip4844.Blob
@@ -253,13 +271,17 @@ type (
 		Get(index int) BlobSidecarT
 		GetSidecars() []BlobSidecarT
 		ValidateBlockRoots() error
-		VerifyInclusionProofs(kzgOffset uint64) error
+		VerifyInclusionProofs(inclusion ctypes.KZGInclusion) error
 	}
 
 	BlobVerifier[BlobSidecarsT any] interface {
-		VerifyInclusionProofs(scs BlobSidecarsT, kzgOffset uint64) error
+		VerifyInclusionProofs(scs BlobSidecarsT) error
 		VerifyKZGProofs(scs BlobSidecarsT) error
-		VerifySidecars(sidecars BlobSidecarsT, kzgOffset uint64) error
+		VerifySidecars(sidecars BlobSidecarsT) error
+		VerifySidecarsBatch(blocks []BlobSidecarsT) []error
+		VerifyDataColumnSidecars(
+			columns []*datypes.DataColumnSidecar,
+		) error
 	}
 
 	// 	// BlockchainService defines the interface for interacting with the
@@ -392,6 +414,11 @@ type (
 		Prune(start, end uint64) error
 		// EnqueueDeposits adds a list of deposits to the deposit store.
 		EnqueueDeposits(deposits []DepositT) error
+		// GetSyncCursor returns the last execution block whose deposits
+		// were enqueued and the index of the next expected deposit.
+		GetSyncCursor() (uint64, uint64, bool, error)
+		// SetSyncCursor persists the sync cursor.
+		SetSyncCursor(lastSyncedBlock, nextIndex uint64) error
 	}
 
 	// 	Eth1Data[T any] interface {
@@ -502,9 +529,12 @@ type (
 	// ExecutionPayloadHeader is the interface for the execution payload
 	// header.
 	ExecutionPayloadHeader[T any] interface {
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
+		constraints.JSONMarshallable
 		constraints.Versionable
 		NewFromSSZ([]byte, uint32) (T, error)
+		// Empty returns an empty ExecutionPayloadHeader.
+		Empty() T
 		// GetNumber returns the block number of the ExecutionPayloadHeader.
 		GetNumber() math.U64
 		// GetFeeRecipient returns the fee recipient address of the
@@ -516,6 +546,12 @@ type (
 		GetBlockHash() common.ExecutionHash
 		// GetParentHash returns the parent hash.
 		GetParentHash() common.ExecutionHash
+		// GetPrevRandao returns the prev randao.
+		GetPrevRandao() common.Bytes32
+		// GetWithdrawalsRoot returns the withdrawals root.
+		GetWithdrawalsRoot() common.Root
+		// GetGasLimit returns the gas limit.
+		GetGasLimit() math.U64
 	}
 
 	// 	Fork[T any] interface {
@@ -580,6 +616,7 @@ type (
 		RetrievePayload(
 			ctx context.Context,
 			slot math.Slot,
+			timestamp uint64,
 			parentBlockRoot common.Root,
 		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 		// RequestPayloadSync requests a payload for the given slot and
@@ -593,6 +630,17 @@ type (
 			headEth1BlockHash common.ExecutionHash,
 			finalEth1BlockHash common.ExecutionHash,
 		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+		// RequestEmptyPayload requests a payload without transactions for the
+		// given slot from a build distinct from the cached ones.
+		RequestEmptyPayload(
+			ctx context.Context,
+			st BeaconStateT,
+			slot math.Slot,
+			timestamp uint64,
+			parentBlockRoot common.Root,
+			headEth1BlockHash common.ExecutionHash,
+			finalEth1BlockHash common.ExecutionHash,
+		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 	}
 
 	// 	// PayloadAttributes is the interface for the payload attributes.
@@ -1147,6 +1195,8 @@ type (
 
 	BlobBackend[BeaconBlockHeaderT any] interface {
 		BlobSidecarsAtSlot(slot math.Slot, indices []uint64) ([]*types.BlobSidecarData[BeaconBlockHeaderT], error)
+		BlobSidecarByVersionedHash(hash common.ExecutionHash) (math.Slot, *types.BlobSidecarData[BeaconBlockHeaderT], error)
+		BlobSamples(hash common.ExecutionHash, indices []uint64) (math.Slot, *types.BlobSamplesData, error)
 	}
 
 	BlockBackend[BeaconBlockHeaderT any] interface {
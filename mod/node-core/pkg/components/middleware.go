@@ -35,10 +35,11 @@ type ABCIMiddlewareInput[
 	LoggerT log.Logger,
 ] struct {
 	depinject.In
-	ChainSpec     common.ChainSpec
-	Dispatcher    Dispatcher
-	Logger        LoggerT
-	TelemetrySink *metrics.TelemetrySink
+	ChainSpec         common.ChainSpec
+	Dispatcher        Dispatcher
+	Logger            LoggerT
+	RejectionRecorder middleware.RejectionRecorder
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideABCIMiddleware is a depinject provider for the validator
@@ -68,5 +69,6 @@ func ProvideABCIMiddleware[
 		in.Dispatcher,
 		in.Logger,
 		in.TelemetrySink,
+		in.RejectionRecorder,
 	), nil
 }
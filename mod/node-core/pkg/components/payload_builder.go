@@ -25,7 +25,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/inclusionlist"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -55,7 +57,9 @@ type LocalBuilderInput[
 		PayloadID,
 		WithdrawalsT,
 	]
-	Logger LoggerT
+	InclusionLists *inclusionlist.Store[ExecutionPayloadT]
+	Logger         LoggerT
+	TelemetrySink  *metrics.TelemetrySink
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -98,5 +102,7 @@ func ProvideLocalBuilder[
 			[32]byte, math.Slot,
 		](),
 		in.AttributesFactory,
+		in.InclusionLists,
+		in.TelemetrySink,
 	)
 }
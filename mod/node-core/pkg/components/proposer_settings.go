@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator/proposer"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// ProposerSettingsInput is the input for the dep inject framework.
+type ProposerSettingsInput[LoggerT any] struct {
+	depinject.In
+	Config *config.Config
+	Logger LoggerT
+}
+
+// ProvideProposerSettings provides the proposer settings of the validators
+// of the node, read from the proposer configuration file if one is set and
+// falling back to the node configuration.
+func ProvideProposerSettings[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in ProposerSettingsInput[LoggerT],
+) (*proposer.Store, error) {
+	return proposer.New(
+		in.Config.Validator.ProposerConfigPath,
+		proposer.Settings{
+			FeeRecipient:   in.Config.PayloadBuilder.SuggestedFeeRecipient,
+			Graffiti:       in.Config.Validator.Graffiti,
+			GasLimit:       in.Config.Relay.GasLimit,
+			BuilderEnabled: in.Config.Relay.Enabled,
+		},
+		in.Logger.With("service", "proposer-settings"),
+	)
+}
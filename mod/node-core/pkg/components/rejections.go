@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/beacon/replay"
+	"github.com/berachain/beacon-kit/mod/config"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rejections"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// RejectedProposalsInput is the input for the ProvideRejectedProposals
+// function for the depinject framework.
+type RejectedProposalsInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+}
+
+// ProvideRejectedProposals provides the ring of the proposals rejected by
+// the node.
+func ProvideRejectedProposals(in RejectedProposalsInput) *rejections.Ring {
+	return rejections.NewRing(
+		cast.ToString(
+			in.AppOpts.Get(flags.FlagHome),
+		)+"/data/rejected-proposals",
+		in.Config.RejectedProposals.Capacity,
+	)
+}
+
+// RejectionRecorderInput is the input for the ProvideRejectionRecorder
+// function for the depinject framework.
+type RejectionRecorderInput[
+	LoggerT any,
+	StorageBackendT any,
+] struct {
+	depinject.In
+	Logger            LoggerT
+	RejectedProposals *rejections.Ring
+	StorageBackend    StorageBackendT
+}
+
+// ProvideRejectionRecorder provides the recorder of the proposals rejected
+// by the node.
+func ProvideRejectionRecorder[
+	BeaconStateT rejections.BeaconState,
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT rejections.StorageBackend[BeaconStateT],
+](
+	in RejectionRecorderInput[LoggerT, StorageBackendT],
+) *rejections.Recorder[BeaconStateT] {
+	return rejections.NewRecorder[BeaconStateT](
+		in.RejectedProposals,
+		in.StorageBackend,
+		in.Logger.With("service", "rejected-proposals"),
+	)
+}
+
+// ProposalReplayerInput is the input for the ProvideProposalReplayer
+// function for the depinject framework.
+type ProposalReplayerInput[
+	BlobSidecarsT any,
+	LoggerT any,
+	StorageBackendT any,
+] struct {
+	depinject.In
+	BlobVerifier      BlobVerifier[BlobSidecarsT]
+	ChainSpec         common.ChainSpec
+	CmtCfg            *cmtcfg.Config
+	DB                dbm.DB
+	Logger            LoggerT
+	RejectedProposals *rejections.Ring
+	Signer            crypto.BLSSigner
+	StorageBackend    StorageBackendT
+	StoreKey          *storetypes.KVStoreKey
+}
+
+// ProvideProposalReplayer provides the replayer of the proposals rejected
+// by the node, which does not verify their payloads. Neither its state
+// processor nor the CometBFT service it loads the committed states from
+// are wired to the execution engine, so that replaying proposals requires
+// neither the engine client nor the JWT secret.
+func ProvideProposalReplayer[
+	BeaconBlockT interface {
+		BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT]
+		replay.BeaconBlock[BeaconBlockT]
+	},
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT, *Validator,
+		Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT any,
+	BlobSidecarsT replay.BlobSidecars[BlobSidecarsT],
+	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT BeaconStore[
+		KVStoreT, BeaconBlockHeaderT, *Eth1Data, ExecutionPayloadHeaderT,
+		*Fork, *Validator, Validators, WithdrawalT,
+	],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT replay.StorageBackend[BeaconStateT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+	WithdrawalT Withdrawal[WithdrawalT],
+](
+	in ProposalReplayerInput[BlobSidecarsT, LoggerT, StorageBackendT],
+) *replay.Replayer[
+	BeaconBlockT, BeaconStateT, BlobSidecarsT, *rejections.Proposal,
+] {
+	return replay.NewReplayer[
+		BeaconBlockT, BeaconStateT, BlobSidecarsT, *rejections.Proposal,
+	](
+		in.ChainSpec,
+		in.RejectedProposals,
+		core.NewStateProcessor[
+			BeaconBlockT,
+			BeaconBlockBodyT,
+			BeaconBlockHeaderT,
+			BeaconStateT,
+			*Context,
+			DepositT,
+			*Eth1Data,
+			ExecutionPayloadT,
+			ExecutionPayloadHeaderT,
+			*Fork,
+			*ForkData,
+			KVStoreT,
+			*Validator,
+			Validators,
+			WithdrawalT,
+			WithdrawalsT,
+			WithdrawalCredentials,
+		](
+			in.ChainSpec,
+			nil,
+			in.Signer,
+		),
+		in.BlobVerifier,
+		cometbft.NewService(
+			in.StoreKey, in.Logger, in.DB, nil, in.CmtCfg, in.ChainSpec,
+		),
+		in.StorageBackend,
+	)
+}
+
+// PayloadReplayerInput is the input for the ProvidePayloadReplayer function
+// for the depinject framework.
+type PayloadReplayerInput[
+	BeaconBlockT any,
+	BeaconStateT any,
+	BlobSidecarsT any,
+	DepositT any,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT any,
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+] struct {
+	depinject.In
+	BlobVerifier    BlobVerifier[BlobSidecarsT]
+	ChainSpec       common.ChainSpec
+	CometBFTService *cometbft.Service[LoggerT]
+	EngineClient    *client.EngineClient[
+		ExecutionPayloadT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+	]
+	RejectedProposals *rejections.Ring
+	StateProcessor    StateProcessor[
+		BeaconBlockT, BeaconStateT, *Context,
+		DepositT, ExecutionPayloadHeaderT,
+	]
+	StorageBackend StorageBackendT
+}
+
+// ProvidePayloadReplayer provides the replayer of the proposals rejected by
+// the node verifying their payloads with the execution client.
+func ProvidePayloadReplayer[
+	BeaconBlockT replay.BeaconBlock[BeaconBlockT],
+	BeaconStateT replay.BeaconState,
+	BlobSidecarsT replay.BlobSidecars[BlobSidecarsT],
+	DepositT any,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT replay.StorageBackend[BeaconStateT],
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in PayloadReplayerInput[
+		BeaconBlockT, BeaconStateT, BlobSidecarsT, DepositT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, LoggerT,
+		StorageBackendT, WithdrawalT, WithdrawalsT,
+	],
+) *replay.PayloadReplayer[
+	BeaconBlockT, BeaconStateT, BlobSidecarsT, *rejections.Proposal,
+] {
+	return replay.NewPayloadReplayer[
+		BeaconBlockT, BeaconStateT, BlobSidecarsT, *rejections.Proposal,
+	](
+		in.ChainSpec,
+		in.RejectedProposals,
+		in.StateProcessor,
+		in.BlobVerifier,
+		in.CometBFTService,
+		in.StorageBackend,
+		in.EngineClient,
+	)
+}
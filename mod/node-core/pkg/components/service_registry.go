@@ -24,9 +24,11 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/beacon/validator/proposer"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
 	"github.com/berachain/beacon-kit/mod/da/pkg/da"
+	"github.com/berachain/beacon-kit/mod/da/pkg/integrity"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
@@ -73,7 +75,8 @@ type ServiceRegistryInput[
 	ABCIService *middleware.ABCIMiddleware[
 		BeaconBlockT, BlobSidecarsT, GenesisT, *SlotData,
 	]
-	BlockStoreService *blockstore.Service[
+	BlobIntegrityService *integrity.Service[BeaconBlockT]
+	BlockStoreService    *blockstore.Service[
 		BeaconBlockT, BeaconBlockStoreT,
 	]
 	ChainService *blockchain.Service[
@@ -85,7 +88,7 @@ type ServiceRegistryInput[
 	DAService      *da.Service[AvailabilityStoreT, BlobSidecarsT]
 	DBManager      *DBManager
 	DepositService *deposit.Service[
-		BeaconBlockT, BeaconBlockBodyT, DepositT,
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT,
 		ExecutionPayloadT, WithdrawalCredentials,
 	]
 	Dispatcher   Dispatcher
@@ -95,14 +98,15 @@ type ServiceRegistryInput[
 	]
 	Logger           LoggerT
 	NodeAPIServer    *server.Server[NodeAPIContextT]
+	ProposerSettings *proposer.Store
 	ReportingService *ReportingService
 	TelemetrySink    *metrics.TelemetrySink
 	TelemetryService *telemetry.Service
 	ValidatorService *validator.Service[
-		*AttestationData, BeaconBlockT, BeaconBlockBodyT,
+		*AttestationData, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
 		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		*ForkData, *SlashingInfo, *SlotData,
+		*ForkData, *SlashingInfo, *SlotData, WithdrawalT,
 	]
 	CometBFTService *cometbft.Service[LoggerT]
 }
@@ -149,10 +153,12 @@ func ProvideServiceRegistry[
 		service.WithLogger(in.Logger),
 		service.WithService(in.ABCIService),
 		service.WithService(in.Dispatcher),
+		service.WithService(in.ProposerSettings),
 		service.WithService(in.ValidatorService),
 		service.WithService(in.BlockStoreService),
 		service.WithService(in.ChainService),
 		service.WithService(in.DAService),
+		service.WithService(in.BlobIntegrityService),
 		service.WithService(in.DepositService),
 		service.WithService(in.NodeAPIServer),
 		service.WithService(in.ReportingService),
@@ -22,16 +22,17 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
-	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
 type SidecarFactoryInput struct {
 	depinject.In
-	ChainSpec     common.ChainSpec
-	TelemetrySink *metrics.TelemetrySink
+	BlobProofVerifier kzg.BlobProofVerifier
+	ChainSpec         common.ChainSpec
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 func ProvideSidecarFactory[
@@ -59,7 +60,7 @@ func ProvideSidecarFactory[
 		BeaconBlockHeaderT,
 	](
 		in.ChainSpec,
-		types.KZGPositionDeneb,
+		in.BlobProofVerifier,
 		in.TelemetrySink,
 	)
 }
@@ -24,7 +24,12 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/peerdas"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	"github.com/spf13/afero"
 	"github.com/spf13/cast"
@@ -33,29 +38,54 @@ import (
 // TrustedSetupInput is the input for the dep inject framework.
 type TrustedSetupInput struct {
 	depinject.In
-	AppOpts config.AppOptions
+	AppOpts   config.AppOptions
+	ChainSpec common.ChainSpec
 }
 
 // ProvideTrustedSetup provides the module to the application.
 func ProvideTrustedSetup(
 	in TrustedSetupInput,
-) (*gokzg4844.JSONTrustedSetup, error) {
-	return ReadTrustedSetup(
+) (*goethkzg.JSONTrustedSetup, error) {
+	ts, err := ReadTrustedSetup(
 		cast.ToString(in.AppOpts.Get(flags.KZGTrustedSetupPath)),
 	)
+	if err != nil {
+		return nil, err
+	}
+	// The data column sidecars of Electra need the monomial points for their
+	// cell proofs, so they are only required once the fork is scheduled.
+	if !peerdas.HasMonomialSetup(ts) &&
+		in.ChainSpec.ElectraForkEpoch() < math.Epoch(
+			spec.UnscheduledForkEpoch,
+		) {
+		return nil, peerdas.ErrMissingMonomialSetup
+	}
+	return ts, nil
 }
 
-// ReadTrustedSetup reads the trusted setup from the file system.
-func ReadTrustedSetup(filePath string) (*gokzg4844.JSONTrustedSetup, error) {
+// ReadTrustedSetup reads the trusted setup from the file system. The G1
+// points in monomial form are optional, as the trusted setup files of
+// EIP-4844 do not carry them.
+func ReadTrustedSetup(filePath string) (*goethkzg.JSONTrustedSetup, error) {
 	config, err := afero.ReadFile(afero.NewOsFs(), filePath)
 	if err != nil {
 		return nil, err
 	}
-	params := new(gokzg4844.JSONTrustedSetup)
+	params := new(goethkzg.JSONTrustedSetup)
 	if err = json.Unmarshal(config, params); err != nil {
 		return nil, err
 	}
-	if err = gokzg4844.CheckTrustedSetupIsWellFormed(params); err != nil {
+	if peerdas.HasMonomialSetup(params) {
+		err = goethkzg.CheckTrustedSetupIsWellFormed(params)
+	} else {
+		err = gokzg4844.CheckTrustedSetupIsWellFormed(
+			&gokzg4844.JSONTrustedSetup{
+				SetupG2:         params.SetupG2,
+				SetupG1Lagrange: params.SetupG1Lagrange,
+			},
+		)
+	}
+	if err != nil {
 		return nil, err
 	}
 	return params, nil
@@ -23,9 +23,14 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/beacon/validator/proposer"
 	"github.com/berachain/beacon-kit/mod/config"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/inclusionlist"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
@@ -47,12 +52,19 @@ type ValidatorServiceInput[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
-	Cfg            *config.Config
-	ChainSpec      common.ChainSpec
-	Dispatcher     Dispatcher
-	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
-	Logger         LoggerT
-	StateProcessor StateProcessor[
+	Cfg          *config.Config
+	ChainSpec    common.ChainSpec
+	Dispatcher   Dispatcher
+	EngineClient *client.EngineClient[
+		ExecutionPayloadT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+	]
+	InclusionLists    *inclusionlist.Store[ExecutionPayloadT]
+	LocalBuilder      LocalBuilder[BeaconStateT, ExecutionPayloadT]
+	Logger            LoggerT
+	ProposerReadiness *validator.Readiness
+	ProposerSettings  *proposer.Store
+	StateProcessor    StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context, DepositT, ExecutionPayloadHeaderT,
 	]
 	StorageBackend StorageBackendT
@@ -71,7 +83,7 @@ func ProvideValidatorService[
 		BeaconBlockBodyT, *AttestationData, DepositT,
 		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
 	],
-	BeaconBlockHeaderT any,
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
 		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
@@ -80,7 +92,9 @@ func ProvideValidatorService[
 	BeaconStateMarshallableT any,
 	BeaconBlockStoreT any,
 	BlobSidecarsT any,
-	DepositT any,
+	DepositT Deposit[
+		DepositT, *ForkData, WithdrawalCredentials,
+	],
 	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -100,16 +114,37 @@ func ProvideValidatorService[
 		LoggerT, StorageBackendT, WithdrawalT, WithdrawalsT,
 	],
 ) (*validator.Service[
-	*AttestationData, BeaconBlockT, BeaconBlockBodyT,
+	*AttestationData, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
 	*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	*ForkData, *SlashingInfo, *SlotData,
+	*ForkData, *SlashingInfo, *SlotData, WithdrawalT,
 ], error) {
+	// Build the relay client, if payloads are requested from a relay.
+	var relayClient validator.Relay[
+		BeaconBlockT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	]
+	if in.Cfg.Relay.Enabled {
+		c, err := relay.New[
+			BeaconBlockT, BeaconBlockBodyT, DepositT, *Eth1Data,
+			ExecutionPayloadT, ExecutionPayloadHeaderT,
+		](
+			&in.Cfg.Relay,
+			in.ChainSpec,
+			in.Logger.With("service", "relay"),
+			in.Signer,
+		)
+		if err != nil {
+			return nil, err
+		}
+		relayClient = c
+	}
+
 	// Build the builder service.
 	return validator.NewService[
 		*AttestationData,
 		BeaconBlockT,
 		BeaconBlockBodyT,
+		BeaconBlockHeaderT,
 		BeaconStateT,
 		BlobSidecarsT,
 		DepositT,
@@ -120,6 +155,7 @@ func ProvideValidatorService[
 		*ForkData,
 		*SlashingInfo,
 		*SlotData,
+		WithdrawalT,
 	](
 		&in.Cfg.Validator,
 		in.Logger.With("service", "validator"),
@@ -127,11 +163,16 @@ func ProvideValidatorService[
 		in.StorageBackend,
 		in.StateProcessor,
 		in.Signer,
+		in.EngineClient,
+		in.ProposerSettings,
 		in.SidecarFactory,
 		in.LocalBuilder,
 		[]validator.PayloadBuilder[BeaconStateT, ExecutionPayloadT]{
 			in.LocalBuilder,
 		},
+		relayClient,
+		in.InclusionLists,
+		in.ProposerReadiness,
 		in.TelemetrySink,
 		in.Dispatcher,
 	), nil
@@ -64,13 +64,25 @@ func (n *node) Start(
 	g, gctx := errgroup.WithContext(cctx)
 
 	// listen for quit signals so the calling parent process can gracefully exit
-	n.listenForQuitSignals(g, true, cancelFn)
+	n.listenForQuitSignals(gctx, g, true, cancelFn)
 
 	// Start all the registered services.
 	if err := n.registry.StartAll(gctx); err != nil {
 		return err
 	}
 
+	// Stop all the services, as on an exit signal, once one of them fails.
+	for _, errCh := range n.registry.Errs() {
+		g.Go(func() error {
+			select {
+			case err := <-errCh:
+				return err
+			case <-gctx.Done():
+				return nil
+			}
+		})
+	}
+
 	// Wait for those aforementioned exit signals.
 	return g.Wait()
 }
@@ -84,6 +96,7 @@ func (n *node) Start(
 // The caller must ensure the corresponding context derived from the cancelFn is
 // used correctly.
 func (n *node) listenForQuitSignals(
+	ctx context.Context,
 	g *errgroup.Group,
 	block bool,
 	cancelFn context.CancelFunc,
@@ -92,10 +105,12 @@ func (n *node) listenForQuitSignals(
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
 	f := func() {
-		sig := <-sigCh
-		cancelFn()
-
-		n.logger.Info("caught exit signal", "signal", sig.String())
+		select {
+		case sig := <-sigCh:
+			cancelFn()
+			n.logger.Info("caught exit signal", "signal", sig.String())
+		case <-ctx.Done():
+		}
 	}
 
 	if block {
@@ -35,6 +35,12 @@ type Basic interface {
 	Name() string
 }
 
+// Fallible is a service which may fail after it was started.
+type Fallible interface {
+	// Err returns a channel receiving the error failing the service.
+	Err() <-chan error
+}
+
 type Dispatcher interface {
 	Start(ctx context.Context) error
 }
@@ -85,6 +91,18 @@ func (s *Registry) StartAll(ctx context.Context) error {
 	return nil
 }
 
+// Errs returns the error channels of the registered services which may fail
+// after they were started.
+func (s *Registry) Errs() []<-chan error {
+	var errs []<-chan error
+	for _, typeName := range s.serviceTypes {
+		if svc, ok := s.services[typeName].(Fallible); ok {
+			errs = append(errs, svc.Err())
+		}
+	}
+	return errs
+}
+
 // RegisterService appends a service constructor function to the service
 // registry.
 func (s *Registry) RegisterService(service Basic) error {
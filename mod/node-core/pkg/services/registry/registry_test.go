@@ -81,3 +81,30 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+// fallible is a service which may fail after it was started.
+type fallible struct {
+	*mocks.Basic
+	errCh chan error
+}
+
+func (f fallible) Err() <-chan error {
+	return f.errCh
+}
+
+func TestRegistry_Errs(t *testing.T) {
+	logger := noop.NewLogger[any]()
+	registry := service.NewRegistry(service.WithLogger(logger))
+
+	service1 := new(mocks.Basic)
+	service1.On("Name").Return("Service1")
+	service2 := fallible{Basic: new(mocks.Basic), errCh: make(chan error, 1)}
+	service2.On("Name").Return("Service2")
+	require.NoError(t, registry.RegisterService(service1))
+	require.NoError(t, registry.RegisterService(service2))
+
+	errs := registry.Errs()
+	require.Len(t, errs, 1)
+	service2.errCh <- context.Canceled
+	require.ErrorIs(t, <-errs[0], context.Canceled)
+}
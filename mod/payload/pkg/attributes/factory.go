@@ -23,6 +23,7 @@ package attributes
 import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -36,9 +37,11 @@ type Factory[
 	chainSpec common.ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger
-	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	// proposer is the public key of the validator proposing the payloads.
+	proposer crypto.BLSPubkey
+	// proposerSettings provides the fee recipient sent to the execution
+	// client for the payload build.
+	proposerSettings ProposerSettings
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -49,12 +52,14 @@ func NewAttributesFactory[
 ](
 	chainSpec common.ChainSpec,
 	logger log.Logger,
-	suggestedFeeRecipient common.ExecutionAddress,
+	proposer crypto.BLSPubkey,
+	proposerSettings ProposerSettings,
 ) *Factory[BeaconStateT, PayloadAttributesT, WithdrawalT] {
 	return &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+		chainSpec:        chainSpec,
+		logger:           logger,
+		proposer:         proposer,
+		proposerSettings: proposerSettings,
 	}
 }
 
@@ -97,7 +102,7 @@ func (f *Factory[
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.proposerSettings.FeeRecipient(f.proposer),
 		withdrawals,
 		prevHeadRoot,
 	)
@@ -23,6 +23,7 @@ package attributes
 import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
 // BeaconState is an interface for accessing the beacon state.
@@ -46,3 +47,10 @@ type PayloadAttributes[SelfT any, WithdrawalT any] interface {
 		common.Root,
 	) (SelfT, error)
 }
+
+// ProposerSettings provides the settings of the proposers of the node.
+type ProposerSettings interface {
+	// FeeRecipient returns the fee recipient of the proposer with the given
+	// public key.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
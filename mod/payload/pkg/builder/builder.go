@@ -21,6 +21,8 @@
 package builder
 
 import (
+	"sync"
+
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -50,6 +52,28 @@ type PayloadBuilder[
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot]
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory[BeaconStateT, PayloadAttributesT]
+	// inclusionLists holds the transactions the payloads must include, nil
+	// if inclusion lists are not used.
+	inclusionLists InclusionLists
+	// builds holds the requests of the in-flight payloads by payload ID, so
+	// that they can be refreshed with an updated timestamp.
+	builds map[PayloadIDT]*build[PayloadAttributesT]
+	// refreshing holds the IDs of the payloads whose builds are being
+	// refreshed.
+	refreshing map[PayloadIDT]struct{}
+	// buildsMu protects builds and refreshing.
+	buildsMu sync.Mutex
+	// metrics is the metrics for the payload builder.
+	metrics *payloadBuilderMetrics
+}
+
+// build is the request of an in-flight payload.
+type build[PayloadAttributesT any] struct {
+	slot               math.Slot
+	parentBlockRoot    common.Root
+	attrs              PayloadAttributesT
+	headEth1BlockHash  common.ExecutionHash
+	finalEth1BlockHash common.ExecutionHash
 }
 
 // New creates a new service.
@@ -67,6 +91,8 @@ func New[
 	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT],
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot],
 	af AttributesFactory[BeaconStateT, PayloadAttributesT],
+	il InclusionLists,
+	ts TelemetrySink,
 ) *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -81,7 +107,25 @@ func New[
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		inclusionLists:    il,
+		builds:            make(map[PayloadIDT]*build[PayloadAttributesT]),
+		refreshing:        make(map[PayloadIDT]struct{}),
+		metrics:           newPayloadBuilderMetrics(ts),
+	}
+}
+
+// inclusionListTransactions returns the transactions the payload of the
+// given slot must include, which are only passed to execution clients
+// supporting inclusion lists. The others would ignore or reject the payload
+// attribute carrying them.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) inclusionListTransactions(slot math.Slot) [][]byte {
+	if pb.inclusionLists == nil || !pb.inclusionLists.Supported() {
+		return nil
 	}
+	return pb.inclusionLists.Transactions(slot)
 }
 
 // Enabled returns true if the payload builder is enabled.
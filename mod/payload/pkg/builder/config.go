@@ -30,6 +30,14 @@ const (
 	// defaultPayloadTimeout is the default value for local build
 	// payload timeout.
 	defaultPayloadTimeout = 1200 * time.Millisecond
+
+	// defaultPayloadRefreshDelay is the default time given to the execution
+	// client to fill a payload refreshed with an updated timestamp.
+	defaultPayloadRefreshDelay = 250 * time.Millisecond
+
+	// defaultSpeculativeBuildRounds is the default number of rounds of the
+	// next height searched for proposer turns of the node.
+	defaultSpeculativeBuildRounds = 3
 )
 
 // Config is the configuration for the payload builder.
@@ -46,13 +54,25 @@ type Config struct {
 	// timeout on your execution client. It also must be less than
 	// timeout_proposal in the CometBFT configuration.
 	PayloadTimeout time.Duration `mapstructure:"payload-timeout"`
+	// PayloadRefreshDelay is the time given to the execution client to fill
+	// a payload whose build is refreshed with an updated timestamp, since it
+	// was started for an earlier round than the one it is proposed in,
+	// before it replaces the stale payload. Proposals never wait for it.
+	PayloadRefreshDelay time.Duration `mapstructure:"payload-refresh-delay"`
+	// SpeculativeBuildRounds is the number of CometBFT rounds of the next
+	// height searched for proposer turns of the node. Payloads are only built
+	// ahead of time for heights the node is predicted to propose in. 0 builds
+	// ahead of time for every height.
+	SpeculativeBuildRounds uint32 `mapstructure:"speculative-build-rounds"`
 }
 
 // DefaultConfig returns the default fork configuration.
 func DefaultConfig() Config {
 	return Config{
-		Enabled:               true,
-		SuggestedFeeRecipient: common.ExecutionAddress{},
-		PayloadTimeout:        defaultPayloadTimeout,
+		Enabled:                true,
+		SuggestedFeeRecipient:  common.ExecutionAddress{},
+		PayloadTimeout:         defaultPayloadTimeout,
+		PayloadRefreshDelay:    defaultPayloadRefreshDelay,
+		SpeculativeBuildRounds: defaultSpeculativeBuildRounds,
 	}
 }
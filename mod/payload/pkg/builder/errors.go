@@ -37,4 +37,8 @@ var (
 	// ErrNilPayloadEnvelope is returned when a nil payload envelope is
 	// received.
 	ErrNilPayloadEnvelope = errors.New("received nil payload envelope")
+
+	// ErrPayloadNotEmpty is returned when the execution client returns no
+	// payload without transactions within the payload timestamp bound.
+	ErrPayloadNotEmpty = errors.New("execution client returned no empty payload")
 )
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+// payloadBuilderMetrics is a struct that contains metrics for the payload
+// builder.
+type payloadBuilderMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newPayloadBuilderMetrics creates a new payloadBuilderMetrics.
+func newPayloadBuilderMetrics(
+	sink TelemetrySink,
+) *payloadBuilderMetrics {
+	return &payloadBuilderMetrics{
+		sink: sink,
+	}
+}
+
+// markPayloadHit increments the counter for the number of payloads retrieved
+// from builds started ahead of time.
+func (pm *payloadBuilderMetrics) markPayloadHit() {
+	pm.sink.IncrementCounter("beacon_kit.payload_builder.payload_hit")
+}
+
+// markPayloadMiss increments the counter for the number of payloads that were
+// not built ahead of time.
+func (pm *payloadBuilderMetrics) markPayloadMiss() {
+	pm.sink.IncrementCounter("beacon_kit.payload_builder.payload_miss")
+}
+
+// markPayloadRefreshed increments the counter for the number of builds
+// refreshed with an updated timestamp before their payload was retrieved.
+func (pm *payloadBuilderMetrics) markPayloadRefreshed() {
+	pm.sink.IncrementCounter("beacon_kit.payload_builder.payload_refreshed")
+}
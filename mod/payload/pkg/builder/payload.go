@@ -21,7 +21,9 @@
 package builder
 
 import (
+	"bytes"
 	"context"
+	"slices"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
@@ -29,6 +31,10 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// emptyPayloadAttemptTimeout is the time each attempt at retrieving a payload
+// without transactions may take.
+const emptyPayloadAttemptTimeout = 250 * time.Millisecond
+
 // RequestPayloadAsync builds a payload for the given slot and
 // returns the payload ID.
 func (pb *PayloadBuilder[
@@ -64,30 +70,213 @@ func (pb *PayloadBuilder[
 	if err != nil {
 		return nil, err
 	}
+	if txs := pb.inclusionListTransactions(slot); len(txs) > 0 {
+		attrs = attrs.WithInclusionListTransactions(txs)
+	}
+
+	return pb.startBuild(ctx, &build[PayloadAttributesT]{
+		slot:               slot,
+		parentBlockRoot:    parentBlockRoot,
+		attrs:              attrs,
+		headEth1BlockHash:  headEth1BlockHash,
+		finalEth1BlockHash: finalEth1BlockHash,
+	})
+}
+
+// startBuild submits the forkchoice update starting the build of the given
+// request to the execution client and caches the returned payload ID.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) startBuild(
+	ctx context.Context,
+	b *build[PayloadAttributesT],
+) (*PayloadIDT, error) {
+	payloadID, err := pb.notifyBuild(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only add to cache if we received back a payload ID.
+	if payloadID != nil {
+		pb.pc.Set(b.slot, b.parentBlockRoot, *payloadID)
+		pb.setBuild(*payloadID, b)
+	}
 
+	return payloadID, nil
+}
+
+// notifyBuild submits the forkchoice update starting the build of the given
+// request to the execution client and returns the payload ID.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) notifyBuild(
+	ctx context.Context,
+	b *build[PayloadAttributesT],
+) (*PayloadIDT, error) {
 	// Submit the forkchoice update to the execution client.
-	var payloadID *PayloadIDT
-	payloadID, _, err = pb.ee.NotifyForkchoiceUpdate(
+	payloadID, _, err := pb.ee.NotifyForkchoiceUpdate(
 		ctx, &engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT]{
 			State: &engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      headEth1BlockHash,
-				SafeBlockHash:      finalEth1BlockHash,
-				FinalizedBlockHash: finalEth1BlockHash,
+				HeadBlockHash:      b.headEth1BlockHash,
+				SafeBlockHash:      b.finalEth1BlockHash,
+				FinalizedBlockHash: b.finalEth1BlockHash,
 			},
-			PayloadAttributes: attrs,
-			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
+			PayloadAttributes: b.attrs,
+			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(b.slot),
 		},
 	)
-	if err != nil {
-		return nil, err
+	return payloadID, err
+}
+
+// setBuild records the request of the given in-flight payload, dropping the
+// requests of payloads no longer held by the payload ID cache.
+func (pb *PayloadBuilder[
+	_, _, _, PayloadAttributesT, PayloadIDT, _,
+]) setBuild(payloadID PayloadIDT, b *build[PayloadAttributesT]) {
+	pb.buildsMu.Lock()
+	defer pb.buildsMu.Unlock()
+	for id, other := range pb.builds {
+		if pid, found := pb.pc.Get(
+			other.slot, other.parentBlockRoot,
+		); !found || pid != id {
+			delete(pb.builds, id)
+		}
 	}
+	pb.builds[payloadID] = b
+}
 
-	// Only add to cache if we received back a payload ID.
-	if payloadID != nil {
-		pb.pc.Set(slot, parentBlockRoot, *payloadID)
+// getBuild returns the request of the given in-flight payload, if known.
+func (pb *PayloadBuilder[
+	_, _, _, PayloadAttributesT, PayloadIDT, _,
+]) getBuild(payloadID PayloadIDT) (*build[PayloadAttributesT], bool) {
+	pb.buildsMu.Lock()
+	defer pb.buildsMu.Unlock()
+	b, found := pb.builds[payloadID]
+	return b, found
+}
+
+// refreshStaleBuild restarts the build of the given payload with the given
+// timestamp, if the build was started for a timestamp further than the
+// payload timestamp bound from it, e.g. for a mispredicted time or for an
+// earlier round than the one the payload is proposed in. Builds started
+// before the inclusion lists of the slot were known are restarted as well.
+// The build is restarted in the background, so the given payload is
+// retrieved until the refreshed one replaces it in the payload ID cache.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) refreshStaleBuild(
+	ctx context.Context,
+	payloadID PayloadIDT,
+	timestamp uint64,
+) {
+	b, found := pb.getBuild(payloadID)
+	if !found {
+		return
 	}
 
-	return payloadID, nil
+	txs := pb.inclusionListTransactions(b.slot)
+	if pb.withinTimestampBound(b.attrs.GetTimestamp().Unwrap(), timestamp) &&
+		slices.EqualFunc(
+			b.attrs.GetInclusionListTransactions(), txs, bytes.Equal,
+		) {
+		return
+	}
+	if !pb.startRefresh(payloadID) {
+		return
+	}
+
+	pb.logger.Info(
+		"Refreshing stale payload build, using stale payload meanwhile",
+		"for_slot", b.slot.Base10(),
+	)
+	go func() {
+		defer pb.endRefresh(payloadID)
+		pb.refreshBuild(
+			// The refresh outlives the retrieval of the stale payload.
+			context.WithoutCancel(ctx),
+			payloadID,
+			&build[PayloadAttributesT]{
+				slot:            b.slot,
+				parentBlockRoot: b.parentBlockRoot,
+				attrs: b.attrs.WithTimestamp(timestamp).
+					WithInclusionListTransactions(txs),
+				headEth1BlockHash:  b.headEth1BlockHash,
+				finalEth1BlockHash: b.finalEth1BlockHash,
+			},
+		)
+	}()
+}
+
+// refreshBuild starts the given build refreshing the build of the given
+// stale payload and, once the execution client had time to fill it, caches
+// it in place of the stale payload.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) refreshBuild(
+	ctx context.Context,
+	stale PayloadIDT,
+	b *build[PayloadAttributesT],
+) {
+	refreshed, err := pb.notifyBuild(ctx, b)
+	if err != nil || refreshed == nil {
+		pb.logger.Warn(
+			"Failed to refresh stale payload build",
+			"for_slot", b.slot.Base10(),
+			"error", err,
+		)
+		return
+	}
+	pb.metrics.markPayloadRefreshed()
+
+	// Give the execution client time to fill the refreshed payload.
+	time.Sleep(pb.cfg.PayloadRefreshDelay)
+
+	// The stale payload may have been replaced in the meantime.
+	if payloadID, found := pb.pc.Get(
+		b.slot, b.parentBlockRoot,
+	); !found || payloadID != stale {
+		return
+	}
+	pb.pc.Set(b.slot, b.parentBlockRoot, *refreshed)
+	pb.setBuild(*refreshed, b)
+}
+
+// startRefresh returns true if the build of the given payload is not being
+// refreshed yet, marking it as being refreshed.
+func (pb *PayloadBuilder[
+	_, _, _, _, PayloadIDT, _,
+]) startRefresh(payloadID PayloadIDT) bool {
+	pb.buildsMu.Lock()
+	defer pb.buildsMu.Unlock()
+	if _, found := pb.refreshing[payloadID]; found {
+		return false
+	}
+	pb.refreshing[payloadID] = struct{}{}
+	return true
+}
+
+// endRefresh marks the build of the given payload as no longer being
+// refreshed.
+func (pb *PayloadBuilder[
+	_, _, _, _, PayloadIDT, _,
+]) endRefresh(payloadID PayloadIDT) {
+	pb.buildsMu.Lock()
+	defer pb.buildsMu.Unlock()
+	delete(pb.refreshing, payloadID)
+}
+
+// withinTimestampBound returns true if a payload built for the given
+// timestamp may be proposed for the wanted one, i.e. if the two are no
+// further apart than the payload timestamp bound of the chain spec.
+func (pb *PayloadBuilder[
+	_, _, _, _, _, _,
+]) withinTimestampBound(built, wanted uint64) bool {
+	bound := pb.chainSpec.PayloadTimestampBound()
+	return built <= wanted+bound && wanted <= built+bound
 }
 
 // RequestPayloadSync request a payload for the given slot and
@@ -149,16 +338,114 @@ func (pb *PayloadBuilder[
 	)
 }
 
+// RequestEmptyPayload returns a payload without transactions for the given
+// slot and timestamp. Execution clients prepare a payload without
+// transactions as soon as a build starts, so a build retrieved right away
+// yields one even when the execution client fails to fill payloads in time.
+// As execution clients return the payload of the existing build for the same
+// attributes, the payload is checked to be empty and, if it is not, the build
+// is retried for the next timestamps within the payload timestamp bound.
+// Each attempt has its own timeout, so that an execution client failing to
+// respond does not hold up the proposal. The builds are not cached, so that
+// they are never proposed as regular payloads. They do not carry the
+// transactions of the inclusion lists, which payloads without transactions
+// are exempt from.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) RequestEmptyPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	parentEth1Hash common.ExecutionHash,
+	finalBlockHash common.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	if !pb.Enabled() {
+		return nil, ErrPayloadBuilderDisabled
+	}
+
+	attrs, err := pb.attributesFactory.
+		BuildPayloadAttributes(st, slot, timestamp, parentBlockRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	bound := pb.chainSpec.PayloadTimestampBound()
+	for ts := timestamp; ts <= timestamp+bound; ts++ {
+		envelope, err := pb.requestEmptyPayloadAttempt(
+			ctx, &build[PayloadAttributesT]{
+				slot:               slot,
+				parentBlockRoot:    parentBlockRoot,
+				attrs:              attrs.WithTimestamp(ts),
+				headEth1BlockHash:  parentEth1Hash,
+				finalEth1BlockHash: finalBlockHash,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(envelope.GetExecutionPayload().GetTransactions()) == 0 {
+			return envelope, nil
+		}
+		pb.logger.Warn(
+			"Execution client returned a filled payload for an empty build",
+			"for_slot", slot.Base10(),
+			"timestamp", ts,
+		)
+	}
+	return nil, ErrPayloadNotEmpty
+}
+
+// requestEmptyPayloadAttempt starts the given build and retrieves its
+// payload right away, giving up once the empty payload attempt timeout
+// expires.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) requestEmptyPayloadAttempt(
+	ctx context.Context,
+	b *build[PayloadAttributesT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	ctx, cancel := context.WithTimeout(ctx, emptyPayloadAttemptTimeout)
+	defer cancel()
+
+	payloadID, err := pb.notifyBuild(ctx, b)
+	if err != nil {
+		return nil, err
+	} else if payloadID == nil {
+		return nil, ErrNilPayloadID
+	}
+
+	envelope, err := pb.ee.GetPayload(
+		ctx,
+		&engineprimitives.GetPayloadRequest[PayloadIDT]{
+			PayloadID:   *payloadID,
+			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(b.slot),
+		},
+	)
+	if err != nil {
+		return nil, err
+	} else if envelope == nil || envelope.GetExecutionPayload().IsNil() {
+		return nil, ErrNilPayloadEnvelope
+	}
+	return envelope, nil
+}
+
 // RetrievePayload attempts to pull a previously built payload
 // by reading a payloadID from the builder's cache. If it fails to
 // retrieve a payload, it will build a new payload and wait for the
-// execution client to return the payload.
+// execution client to return the payload. Payloads built for a different
+// timestamp are rebuilt with the given one in the background.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
 ]) RetrievePayload(
 	ctx context.Context,
 	slot math.Slot,
+	timestamp uint64,
 	parentBlockRoot common.Root,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	if !pb.Enabled() {
@@ -169,8 +456,13 @@ func (pb *PayloadBuilder[
 	// this particular slot and parent block root.
 	payloadID, found := pb.pc.Get(slot, parentBlockRoot)
 	if !found {
+		pb.metrics.markPayloadMiss()
 		return nil, ErrPayloadIDNotFound
 	}
+	pb.metrics.markPayloadHit()
+
+	// The payload may have been built ahead of time for a different time.
+	pb.refreshStaleBuild(ctx, payloadID, timestamp)
 
 	envelope, err := pb.ee.GetPayload(
 		ctx,
@@ -206,14 +498,15 @@ func (pb *PayloadBuilder[
 
 	pb.logger.Info("Payload retrieved from local builder", args...)
 
-	// If the payload was built by a different builder, something is
-	// wrong the EL<>CL setup.
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	// If the payload was built for a fee recipient other than the one it
+	// was requested for, something is wrong the EL<>CL setup.
+	if b, found := pb.getBuild(payloadID); found && !payload.IsNil() &&
+		payload.GetFeeRecipient() != b.attrs.GetSuggestedFeeRecipient() {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", b.attrs.GetSuggestedFeeRecipient(),
 		)
 	}
 	return envelope, err
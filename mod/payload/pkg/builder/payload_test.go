@@ -0,0 +1,382 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testWithdrawal = engineprimitives.Withdrawal
+	testAttributes = engineprimitives.PayloadAttributes[*testWithdrawal]
+	testState      = builder.BeaconState[
+		builder.ExecutionPayloadHeader, *testWithdrawal,
+	]
+	testBuilder = builder.PayloadBuilder[
+		testState, *testPayload, builder.ExecutionPayloadHeader,
+		*testAttributes, engineprimitives.PayloadID,
+		*engineprimitives.Withdrawal,
+	]
+)
+
+type testPayload struct {
+	timestamp    uint64
+	transactions engineprimitives.Transactions
+}
+
+func (p *testPayload) Empty(uint32) *testPayload { return &testPayload{} }
+
+func (p *testPayload) Version() uint32 { return version.Deneb }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (p *testPayload) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+func (p *testPayload) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{}
+}
+
+func (p *testPayload) GetTransactions() engineprimitives.Transactions {
+	return p.transactions
+}
+
+type testEnvelope struct {
+	payload *testPayload
+}
+
+func (e *testEnvelope) GetExecutionPayload() *testPayload { return e.payload }
+
+func (e *testEnvelope) GetValue() *math.U256 { return nil }
+
+func (e *testEnvelope) GetBlobsBundle() engineprimitives.BlobsBundle {
+	return nil
+}
+
+func (e *testEnvelope) ShouldOverrideBuilder() bool { return false }
+
+// testEngine is an execution engine which, like execution clients, derives
+// the payload ID from the attributes of the build, so that starting a build
+// with the attributes of an existing one returns the existing one.
+type testEngine struct {
+	mu             sync.Mutex
+	timestamps     map[engineprimitives.PayloadID]uint64
+	filled         map[engineprimitives.PayloadID]bool
+	inclusionLists map[engineprimitives.PayloadID][][]byte
+	// stalled makes the retrieval of payloads hang until it is cancelled.
+	stalled bool
+}
+
+func newTestEngine() *testEngine {
+	return &testEngine{
+		timestamps:     make(map[engineprimitives.PayloadID]uint64),
+		filled:         make(map[engineprimitives.PayloadID]bool),
+		inclusionLists: make(map[engineprimitives.PayloadID][][]byte),
+	}
+}
+
+func (e *testEngine) NotifyForkchoiceUpdate(
+	_ context.Context,
+	req *engineprimitives.ForkchoiceUpdateRequest[*testAttributes],
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	h := sha256.New()
+	h.Write(req.State.HeadBlockHash[:])
+	h.Write(binary.LittleEndian.AppendUint64(
+		nil, req.PayloadAttributes.GetTimestamp().Unwrap(),
+	))
+	h.Write(req.PayloadAttributes.PrevRandao[:])
+	var id engineprimitives.PayloadID
+	copy(id[:], h.Sum(nil))
+	e.timestamps[id] = req.PayloadAttributes.GetTimestamp().Unwrap()
+	e.inclusionLists[id] = req.PayloadAttributes.
+		GetInclusionListTransactions()
+	return &id, nil, nil
+}
+
+func (e *testEngine) GetPayload(
+	ctx context.Context,
+	req *engineprimitives.GetPayloadRequest[engineprimitives.PayloadID],
+) (engineprimitives.BuiltExecutionPayloadEnv[*testPayload], error) {
+	if e.stalled {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	payload := &testPayload{timestamp: e.timestamps[req.PayloadID]}
+	if e.filled[req.PayloadID] {
+		payload.transactions = engineprimitives.Transactions{{0x01}}
+	}
+	return &testEnvelope{payload: payload}, nil
+}
+
+type testAttributesFactory struct{}
+
+func (testAttributesFactory) BuildPayloadAttributes(
+	_ testState,
+	_ math.U64,
+	timestamp uint64,
+	parentBlockRoot [32]byte,
+) (*testAttributes, error) {
+	return (&testAttributes{}).New(
+		version.Deneb, timestamp, common.Bytes32{1},
+		common.ExecutionAddress{}, []*engineprimitives.Withdrawal{},
+		parentBlockRoot,
+	)
+}
+
+// testInclusionLists requires the given transactions, which the execution
+// client supports unless unsupported is set.
+type testInclusionLists struct {
+	txs         [][]byte
+	unsupported bool
+}
+
+func (l testInclusionLists) Supported() bool { return !l.unsupported }
+
+func (l testInclusionLists) Transactions(math.Slot) [][]byte {
+	return l.txs
+}
+
+type testSink struct{}
+
+func (testSink) IncrementCounter(string, ...string) {}
+
+func newTestBuilder(ee *testEngine, il builder.InclusionLists) (
+	*testBuilder,
+	*cache.PayloadIDCache[engineprimitives.PayloadID, [32]byte, math.Slot],
+) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:         32,
+			PayloadTimestampBound: 2,
+		},
+	)
+	pc := cache.NewPayloadIDCache[
+		engineprimitives.PayloadID, [32]byte, math.Slot,
+	]()
+	return builder.New[
+		testState, *testPayload, builder.ExecutionPayloadHeader,
+		*testAttributes, engineprimitives.PayloadID,
+		*engineprimitives.Withdrawal,
+	](
+		&builder.Config{Enabled: true}, cs, noop.NewLogger[any](), ee, pc,
+		testAttributesFactory{}, il, testSink{},
+	), pc
+}
+
+func TestRequestEmptyPayload(t *testing.T) {
+	const (
+		slot      = math.Slot(10)
+		timestamp = uint64(100)
+	)
+	ctx := context.Background()
+	parentBlockRoot := common.Root{2}
+
+	t.Run("distinct from the cached build", func(t *testing.T) {
+		ee := newTestEngine()
+		pb, pc := newTestBuilder(ee, nil)
+
+		// The cached build for the slot has been filled.
+		payloadID, err := pb.RequestPayloadAsync(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.NoError(t, err)
+		ee.filled[*payloadID] = true
+
+		envelope, err := pb.RequestEmptyPayload(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.NoError(t, err)
+		payload := envelope.GetExecutionPayload()
+		require.Empty(t, payload.GetTransactions())
+		require.Equal(t, timestamp+1, payload.timestamp)
+
+		// The empty build does not replace the cached one.
+		cached, found := pc.Get(slot, parentBlockRoot)
+		require.True(t, found)
+		require.Equal(t, *payloadID, cached)
+	})
+
+	t.Run("unfilled build", func(t *testing.T) {
+		ee := newTestEngine()
+		pb, _ := newTestBuilder(ee, nil)
+
+		envelope, err := pb.RequestEmptyPayload(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.NoError(t, err)
+		payload := envelope.GetExecutionPayload()
+		require.Empty(t, payload.GetTransactions())
+		require.Equal(t, timestamp, payload.timestamp)
+	})
+
+	t.Run("no empty build within the bound", func(t *testing.T) {
+		ee := newTestEngine()
+		pb, _ := newTestBuilder(ee, nil)
+
+		for ts := timestamp; ts <= timestamp+2; ts++ {
+			payloadID, _, err := ee.NotifyForkchoiceUpdate(
+				ctx,
+				&engineprimitives.ForkchoiceUpdateRequest[*testAttributes]{
+					State: &engineprimitives.ForkchoiceStateV1{},
+					PayloadAttributes: (&testAttributes{
+						Timestamp:  math.U64(ts),
+						PrevRandao: common.Bytes32{1},
+					}),
+				},
+			)
+			require.NoError(t, err)
+			ee.filled[*payloadID] = true
+		}
+
+		_, err := pb.RequestEmptyPayload(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.ErrorIs(t, err, builder.ErrPayloadNotEmpty)
+	})
+	t.Run("without inclusion list transactions", func(t *testing.T) {
+		ee := newTestEngine()
+		pb, _ := newTestBuilder(
+			ee, testInclusionLists{txs: [][]byte{{0x02}}},
+		)
+
+		// Regular builds carry the inclusion list transactions.
+		payloadID, err := pb.RequestPayloadAsync(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{{0x02}}, ee.inclusionLists[*payloadID])
+		ee.filled[*payloadID] = true
+
+		// The empty payload is exempt from them.
+		envelope, err := pb.RequestEmptyPayload(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.NoError(t, err)
+		payload := envelope.GetExecutionPayload()
+		require.Empty(t, payload.GetTransactions())
+		for id, ts := range ee.timestamps {
+			if ts == payload.timestamp {
+				require.Empty(t, ee.inclusionLists[id])
+			}
+		}
+	})
+	t.Run("execution client without inclusion lists", func(t *testing.T) {
+		ee := newTestEngine()
+		pb, _ := newTestBuilder(ee, testInclusionLists{
+			txs: [][]byte{{0x02}}, unsupported: true,
+		})
+
+		// The attribute is not sent to execution clients lacking the
+		// inclusion list extension of the engine API.
+		payloadID, err := pb.RequestPayloadAsync(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.NoError(t, err)
+		require.Empty(t, ee.inclusionLists[*payloadID])
+	})
+	t.Run("stalled execution client", func(t *testing.T) {
+		ee := newTestEngine()
+		ee.stalled = true
+		pb, _ := newTestBuilder(ee, nil)
+
+		// The attempt gives up even though the context has no deadline.
+		_, err := pb.RequestEmptyPayload(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestRetrievePayload(t *testing.T) {
+	const (
+		slot      = math.Slot(10)
+		timestamp = uint64(100)
+	)
+	ctx := context.Background()
+	parentBlockRoot := common.Root{2}
+
+	t.Run("stale build", func(t *testing.T) {
+		ee := newTestEngine()
+		pb, pc := newTestBuilder(ee, nil)
+
+		stale, err := pb.RequestPayloadAsync(
+			ctx, nil, slot, timestamp, parentBlockRoot,
+			common.ExecutionHash{}, common.ExecutionHash{},
+		)
+		require.NoError(t, err)
+
+		// The stale payload is retrieved without waiting for the refresh
+		// of its build.
+		envelope, err := pb.RetrievePayload(
+			ctx, slot, timestamp+3, parentBlockRoot,
+		)
+		require.NoError(t, err)
+		require.Equal(t, timestamp, envelope.GetExecutionPayload().timestamp)
+
+		// The refreshed payload replaces it once started.
+		require.Eventually(t, func() bool {
+			payloadID, found := pc.Get(slot, parentBlockRoot)
+			return found && payloadID != *stale
+		}, time.Second, time.Millisecond)
+		envelope, err = pb.RetrievePayload(
+			ctx, slot, timestamp+3, parentBlockRoot,
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t, timestamp+3, envelope.GetExecutionPayload().timestamp,
+		)
+	})
+}
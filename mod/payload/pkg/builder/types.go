@@ -68,6 +68,8 @@ type ExecutionPayload[T any] interface {
 	GetFeeRecipient() common.ExecutionAddress
 	// GetParentHash returns the parent hash.
 	GetParentHash() common.ExecutionHash
+	// GetTransactions returns the transactions of the payload.
+	GetTransactions() engineprimitives.Transactions
 }
 
 // ExecutionPayloadHeader is the interface for the execution payload header.
@@ -97,6 +99,17 @@ type PayloadAttributes[
 	WithdrawalT any,
 ] interface {
 	engineprimitives.PayloadAttributer
+	// GetTimestamp returns the timestamp the payload is built for.
+	GetTimestamp() math.U64
+	// WithTimestamp returns a copy of the attributes for building the payload
+	// at the given timestamp.
+	WithTimestamp(uint64) SelfT
+	// GetInclusionListTransactions returns the transactions the payload must
+	// include.
+	GetInclusionListTransactions() [][]byte
+	// WithInclusionListTransactions returns a copy of the attributes for
+	// building a payload including the given transactions.
+	WithInclusionListTransactions([][]byte) SelfT
 	// New creates a new payload attributes instance.
 	New(
 		uint32,
@@ -124,3 +137,20 @@ type ExecutionEngine[
 		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
 	) (*PayloadIDT, *common.ExecutionHash, error)
 }
+
+// InclusionLists is the interface for the inclusion lists of the validators.
+type InclusionLists interface {
+	// Supported returns true if the execution client builds payloads with
+	// the inclusion list transactions they must include.
+	Supported() bool
+	// Transactions returns the transactions the payload of the given slot
+	// must include.
+	Transactions(slot math.Slot) [][]byte
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+}
@@ -0,0 +1,346 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// forkName is the builder API name of the fork of the payloads built
+	// for beacon-kit chains.
+	forkName = "deneb"
+	// maxErrorBodySize is the maximum number of bytes of an error response
+	// included in the returned error.
+	maxErrorBodySize = 1024
+)
+
+// Client is a client for the builder API of a relay, it requests bids for
+// execution payloads from external block builders and reveals the payloads
+// of the bids it signs.
+type Client[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, Eth1DataT],
+	DepositT constraints.SSZRootable,
+	Eth1DataT constraints.SSZRootable,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// cfg is the relay configuration.
+	cfg *Config
+	// chainSpec is the chain spec the headers of revealed payloads are
+	// computed with.
+	chainSpec common.ChainSpec
+	// logger is the logger for the client.
+	logger log.Logger
+	// signer signs registrations and blinded blocks and verifies bids.
+	signer crypto.BLSSigner
+	// relayPubkey is the public key bids must be signed with, if any.
+	relayPubkey *crypto.BLSPubkey
+	// client is the underlying HTTP client.
+	client *http.Client
+}
+
+// New creates a new relay client.
+func New[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, Eth1DataT],
+	DepositT constraints.SSZRootable,
+	Eth1DataT constraints.SSZRootable,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+](
+	cfg *Config,
+	chainSpec common.ChainSpec,
+	logger log.Logger,
+	signer crypto.BLSSigner,
+) (*Client[
+	BeaconBlockT, BeaconBlockBodyT, DepositT, Eth1DataT,
+	ExecutionPayloadT, ExecutionPayloadHeaderT,
+], error) {
+	c := &Client[
+		BeaconBlockT, BeaconBlockBodyT, DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT,
+	]{
+		cfg:       cfg,
+		chainSpec: chainSpec,
+		logger:    logger,
+		signer:    signer,
+		client:    &http.Client{},
+	}
+	if cfg.Pubkey != "" {
+		c.relayPubkey = new(crypto.BLSPubkey)
+		if err := c.relayPubkey.UnmarshalText(
+			[]byte(cfg.Pubkey),
+		); err != nil {
+			return nil, errors.Wrap(err, "invalid relay pubkey")
+		}
+	}
+	return c, nil
+}
+
+// RegisterValidator registers the validator of the signer with the relay,
+// with the fee recipient and gas limit target its blocks are built for. The
+// registration is signed in the given builder domain.
+func (c *Client[_, _, _, _, _, _]) RegisterValidator(
+	ctx context.Context,
+	domain common.Domain,
+	feeRecipient common.ExecutionAddress,
+	gasLimit uint64,
+) error {
+	registration := &ValidatorRegistration{
+		FeeRecipient: feeRecipient,
+		GasLimit:     gasLimit,
+		//#nosec:G115 // unix time is never negative.
+		Timestamp: uint64(time.Now().Unix()),
+		Pubkey:    c.signer.PublicKey(),
+	}
+	signingRoot := computeSigningRoot(registration, domain)
+	signature, err := c.signer.Sign(signingRoot[:])
+	if err != nil {
+		return err
+	}
+
+	if err = c.do(
+		ctx, http.MethodPost, "/eth/v1/builder/validators",
+		[]*SignedValidatorRegistration{{
+			Message:   registration,
+			Signature: signature,
+		}},
+		nil,
+	); err != nil {
+		return err
+	}
+
+	c.logger.Info(
+		"Registered validator with relay",
+		"fee_recipient", registration.FeeRecipient,
+		"gas_limit", registration.GasLimit,
+	)
+	return nil
+}
+
+// GetHeader requests the best bid of the relay for the payload built on top
+// of the given parent hash in the given slot. The signature of the bid is
+// verified in the given builder domain.
+func (c *Client[_, _, _, _, _, ExecutionPayloadHeaderT]) GetHeader(
+	ctx context.Context,
+	domain common.Domain,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+) (engineprimitives.BuilderBid[ExecutionPayloadHeaderT], error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	var header ExecutionPayloadHeaderT
+	resp := &versioned[*SignedBuilderBid[ExecutionPayloadHeaderT]]{
+		Data: &SignedBuilderBid[ExecutionPayloadHeaderT]{
+			Message: &BuilderBid[ExecutionPayloadHeaderT]{
+				Header: header.Empty(),
+			},
+		},
+	}
+	if err := c.do(
+		ctx, http.MethodGet,
+		"/eth/v1/builder/header/"+slot.Base10()+"/"+parentHash.Hex()+
+			"/"+c.signer.PublicKey().String(),
+		nil, resp,
+	); err != nil {
+		return nil, err
+	}
+
+	bid := resp.Data.Message
+	if c.relayPubkey != nil && bid.Pubkey != *c.relayPubkey {
+		return nil, ErrUnexpectedBidPubkey
+	}
+	signingRoot := computeSigningRoot(bid, domain)
+	if err := c.signer.VerifySignature(
+		bid.Pubkey, signingRoot[:], resp.Data.Signature,
+	); err != nil {
+		return nil, errors.Wrap(ErrInvalidBidSignature, err.Error())
+	}
+	return bid, nil
+}
+
+// SubmitBlindedBlock signs the blinded form of the block, which commits to
+// the payload of the bid, in the given proposer domain and submits it to the
+// relay, which reveals the execution payload and blobs of the bid in
+// exchange. The state root of the block must be the one of its blinded form.
+func (c *Client[
+	BeaconBlockT, _, DepositT, Eth1DataT,
+	ExecutionPayloadT, ExecutionPayloadHeaderT,
+]) SubmitBlindedBlock(
+	ctx context.Context,
+	domain common.Domain,
+	blk BeaconBlockT,
+	bid engineprimitives.BuilderBid[ExecutionPayloadHeaderT],
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	body := blk.GetBody()
+	blinded := &BlindedBeaconBlock[
+		DepositT, Eth1DataT, ExecutionPayloadHeaderT,
+	]{
+		Slot:          blk.GetSlot().Unwrap(),
+		ProposerIndex: blk.GetProposerIndex().Unwrap(),
+		ParentRoot:    blk.GetParentBlockRoot(),
+		StateRoot:     blk.GetStateRoot(),
+		Body: &BlindedBeaconBlockBody[
+			DepositT, Eth1DataT, ExecutionPayloadHeaderT,
+		]{
+			RandaoReveal:           body.GetRandaoReveal(),
+			Eth1Data:               body.GetEth1Data(),
+			Graffiti:               body.GetGraffiti(),
+			Deposits:               body.GetDeposits(),
+			ExecutionPayloadHeader: bid.GetHeader(),
+			BlobKZGCommitments:     bid.GetBlobKZGCommitments(),
+		},
+	}
+	signingRoot := computeSigningRoot(blinded, domain)
+	signature, err := c.signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var payload ExecutionPayloadT
+	resp := &versioned[*ExecutionPayloadAndBlobsBundle[ExecutionPayloadT]]{
+		Data: &ExecutionPayloadAndBlobsBundle[ExecutionPayloadT]{
+			ExecutionPayload: payload.Empty(version.Deneb),
+		},
+	}
+	if err = c.do(
+		ctx, http.MethodPost, "/eth/v1/builder/blinded_blocks",
+		&SignedBlindedBeaconBlock[
+			DepositT, Eth1DataT, ExecutionPayloadHeaderT,
+		]{
+			Message:   blinded,
+			Signature: signature,
+		},
+		resp,
+	); err != nil {
+		return nil, err
+	}
+
+	// The relay must reveal exactly the payload and blobs that were bid on,
+	// otherwise the block would not match the header we signed.
+	revealed := resp.Data
+	if revealed.BlobsBundle == nil {
+		revealed.BlobsBundle = &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{}
+	}
+	header, err := revealed.ExecutionPayload.ToHeader(
+		c.chainSpec.MaxWithdrawalsPerPayload(),
+		c.chainSpec.DepositEth1ChainID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if header.HashTreeRoot() != bid.GetHeader().HashTreeRoot() ||
+		!slices.Equal(
+			revealed.BlobsBundle.GetCommitments(),
+			bid.GetBlobKZGCommitments(),
+		) {
+		return nil, ErrPayloadMismatch
+	}
+
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: revealed.ExecutionPayload,
+		BlockValue:       bid.GetValue(),
+		BlobsBundle:      revealed.BlobsBundle,
+	}, nil
+}
+
+// do sends a request with the given JSON body to the relay and decodes the
+// JSON response into result, if any.
+func (c *Client[_, _, _, _, _, _]) do(
+	ctx context.Context,
+	method, path string,
+	body, result any,
+) error {
+	var reqBody io.Reader
+	if body != nil {
+		bz, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(bz)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, method, c.cfg.URL+path, reqBody,
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Eth-Consensus-Version", forkName)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return ErrNoBid
+	default:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errors.Wrapf(
+			ErrUnexpectedStatus, "%d: %s",
+			resp.StatusCode, bytes.TrimSpace(msg),
+		)
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testHeader struct {
+		BlockHash common.ExecutionHash `json:"blockHash"`
+	}
+	testPayload struct {
+		BlockHash common.ExecutionHash `json:"blockHash"`
+	}
+	testHeaderJSON  testHeader
+	testPayloadJSON testPayload
+
+	// testObject stands in for the eth1 data and deposits of a block.
+	testObject struct {
+		Root common.Root `json:"root"`
+	}
+	testBody struct {
+		eth1Data *testObject
+		deposits []*testObject
+	}
+	testBlock struct {
+		stateRoot common.Root
+		body      *testBody
+	}
+)
+
+func (o *testObject) HashTreeRoot() common.Root { return o.Root }
+
+func (b *testBody) GetRandaoReveal() crypto.BLSSignature {
+	return crypto.BLSSignature{1}
+}
+
+func (b *testBody) GetEth1Data() *testObject { return b.eth1Data }
+
+func (b *testBody) GetGraffiti() common.Bytes32 { return common.Bytes32{2} }
+
+func (b *testBody) GetDeposits() []*testObject { return b.deposits }
+
+func (b *testBlock) GetSlot() math.Slot { return 1 }
+
+func (b *testBlock) GetProposerIndex() math.ValidatorIndex { return 3 }
+
+func (b *testBlock) GetParentBlockRoot() common.Root { return common.Root{4} }
+
+func (b *testBlock) GetStateRoot() common.Root { return b.stateRoot }
+
+func (b *testBlock) GetBody() *testBody { return b.body }
+
+type testClient = relay.Client[
+	*testBlock, *testBody, *testObject, *testObject, *testPayload, *testHeader,
+]
+
+func (h *testHeader) Empty() *testHeader { return &testHeader{} }
+
+func (h *testHeader) HashTreeRoot() common.Root {
+	return common.Root(h.BlockHash)
+}
+
+func (h *testHeader) GetBlockHash() common.ExecutionHash { return h.BlockHash }
+
+func (h *testHeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal((*testHeaderJSON)(h))
+}
+
+func (h *testHeader) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, (*testHeaderJSON)(h))
+}
+
+func (p *testPayload) Empty(uint32) *testPayload { return &testPayload{} }
+
+func (p *testPayload) GetBlockHash() common.ExecutionHash {
+	return p.BlockHash
+}
+
+func (p *testPayload) ToHeader(uint64, uint64) (*testHeader, error) {
+	return &testHeader{BlockHash: p.BlockHash}, nil
+}
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	return json.Marshal((*testPayloadJSON)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(bz []byte) error {
+	return json.Unmarshal(bz, (*testPayloadJSON)(p))
+}
+
+// testRelay is a stand-in relay that bids on every slot with a payload of
+// the given block hash and reveals the payload of the given block hash.
+type testRelay struct {
+	bidHash      common.ExecutionHash
+	revealHash   common.ExecutionHash
+	commitments  []eip4844.KZGCommitment
+	pubkey       crypto.BLSPubkey
+	registration *relay.SignedValidatorRegistration
+	blinded      *relay.SignedBlindedBeaconBlock[
+		*testObject, *testObject, *testHeader,
+	]
+}
+
+func (r *testRelay) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch {
+	case req.URL.Path == "/eth/v1/builder/validators":
+		var regs []*relay.SignedValidatorRegistration
+		if err := json.NewDecoder(req.Body).Decode(&regs); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.registration = regs[0]
+	case strings.HasPrefix(req.URL.Path, "/eth/v1/builder/header/"):
+		if r.bidHash == (common.ExecutionHash{}) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"version": "deneb",
+			"data": &relay.SignedBuilderBid[*testHeader]{
+				Message: &relay.BuilderBid[*testHeader]{
+					Header:             &testHeader{BlockHash: r.bidHash},
+					BlobKZGCommitments: r.commitments,
+					Value:              math.NewU256(7),
+					Pubkey:             r.pubkey,
+				},
+			},
+		})
+	case req.URL.Path == "/eth/v1/builder/blinded_blocks":
+		if err := json.NewDecoder(req.Body).Decode(&r.blinded); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"version": "deneb",
+			"data": &relay.ExecutionPayloadAndBlobsBundle[*testPayload]{
+				ExecutionPayload: &testPayload{BlockHash: r.revealHash},
+				BlobsBundle: &engineprimitives.BlobsBundleV1[
+					eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+				]{
+					Commitments: r.commitments,
+					Proofs:      make([]eip4844.KZGProof, len(r.commitments)),
+					Blobs:       make([]*eip4844.Blob, len(r.commitments)),
+				},
+			},
+		})
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func newTestClient(
+	t *testing.T, r *testRelay, relayPubkey string,
+) *testClient {
+	t.Helper()
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().PublicKey().Return(crypto.BLSPubkey{1}).Maybe()
+	signer.EXPECT().Sign(mock.Anything).
+		Return(crypto.BLSSignature{2}, nil).Maybe()
+	signer.EXPECT().VerifySignature(
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil).Maybe()
+
+	cfg := relay.DefaultConfig()
+	cfg.URL = server.URL
+	cfg.Pubkey = relayPubkey
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{},
+	)
+	c, err := relay.New[
+		*testBlock, *testBody, *testObject, *testObject,
+		*testPayload, *testHeader,
+	](
+		&cfg, cs, noop.NewLogger[any](), signer,
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	r := &testRelay{
+		bidHash:     common.ExecutionHash{4},
+		revealHash:  common.ExecutionHash{4},
+		commitments: []eip4844.KZGCommitment{{5}},
+		pubkey:      crypto.BLSPubkey{6},
+	}
+	c := newTestClient(t, r, crypto.BLSPubkey{6}.String())
+
+	require.NoError(t, c.RegisterValidator(
+		ctx, common.Domain{}, common.ExecutionAddress{3}, 30_000_000,
+	))
+	require.NotNil(t, r.registration)
+	require.Equal(t, crypto.BLSPubkey{1}, r.registration.Message.Pubkey)
+	require.Equal(t,
+		common.ExecutionAddress{3}, r.registration.Message.FeeRecipient,
+	)
+	require.Equal(t, uint64(30_000_000), r.registration.Message.GasLimit)
+
+	bid, err := c.GetHeader(ctx, common.Domain{}, 1, common.ExecutionHash{})
+	require.NoError(t, err)
+	require.Equal(t, r.bidHash, bid.GetHeader().GetBlockHash())
+	require.Equal(t, math.NewU256(7), bid.GetValue())
+
+	blk := &testBlock{
+		stateRoot: common.Root{5},
+		body: &testBody{
+			eth1Data: &testObject{Root: common.Root{6}},
+			deposits: []*testObject{{Root: common.Root{7}}},
+		},
+	}
+	envelope, err := c.SubmitBlindedBlock(ctx, common.Domain{}, blk, bid)
+	require.NoError(t, err)
+	require.Equal(t, r.bidHash, envelope.GetExecutionPayload().BlockHash)
+	require.Equal(t,
+		r.commitments, envelope.GetBlobsBundle().GetCommitments(),
+	)
+
+	// The signed blinded block carries the full block with the payload
+	// swapped for the header of the bid.
+	blinded := r.blinded.Message
+	require.Equal(t, uint64(1), blinded.Slot)
+	require.Equal(t, uint64(3), blinded.ProposerIndex)
+	require.Equal(t, common.Root{4}, blinded.ParentRoot)
+	require.Equal(t, common.Root{5}, blinded.StateRoot)
+	require.Equal(t, crypto.BLSSignature{1}, blinded.Body.RandaoReveal)
+	require.Equal(t, blk.body.eth1Data, blinded.Body.Eth1Data)
+	require.Equal(t, common.Bytes32{2}, blinded.Body.Graffiti)
+	require.Equal(t, blk.body.deposits, blinded.Body.Deposits)
+	require.Equal(t,
+		r.bidHash, blinded.Body.ExecutionPayloadHeader.BlockHash,
+	)
+	require.Equal(t, r.commitments, blinded.Body.BlobKZGCommitments)
+
+	// The root of the blinded block commits to its state root and body.
+	root := blinded.HashTreeRoot()
+	blinded.StateRoot = common.Root{}
+	require.NotEqual(t, root, blinded.HashTreeRoot())
+	blinded.StateRoot = common.Root{5}
+	blinded.Body.Deposits = nil
+	require.NotEqual(t, root, blinded.HashTreeRoot())
+
+	// A relay revealing a payload other than the one bid on is rejected.
+	r.revealHash = common.ExecutionHash{8}
+	_, err = c.SubmitBlindedBlock(ctx, common.Domain{}, blk, bid)
+	require.ErrorIs(t, err, relay.ErrPayloadMismatch)
+
+	// Bids signed by a key other than the relay's are rejected.
+	r.pubkey = crypto.BLSPubkey{9}
+	_, err = c.GetHeader(ctx, common.Domain{}, 1, common.ExecutionHash{})
+	require.ErrorIs(t, err, relay.ErrUnexpectedBidPubkey)
+
+	// Slots without a bid are reported as such.
+	r.bidHash = common.ExecutionHash{}
+	_, err = c.GetHeader(ctx, common.Domain{}, 1, common.ExecutionHash{})
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
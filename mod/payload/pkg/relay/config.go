@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultTimeout is the default timeout for requests to the relay, it
+	// matches the cutoff used by the builder specs.
+	defaultTimeout = 950 * time.Millisecond
+	// defaultGasLimit is the default gas limit registered with the relay.
+	defaultGasLimit = 30_000_000
+)
+
+// Config is the configuration for the builder API relay client.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// Enabled determines if payloads are requested from the relay.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the URL of the relay's builder API.
+	URL string `mapstructure:"url"`
+	// Pubkey is the BLS public key of the relay. When set, only bids signed
+	// by this key are accepted.
+	Pubkey string `mapstructure:"pubkey"`
+	// Timeout is the timeout for each request to the relay. Bids that
+	// arrive later are ignored in favour of the local payload, payloads
+	// revealed later fail the slot.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// GasLimit is the gas limit the validator registers with the relay,
+	// unless set in the proposer configuration.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+}
+
+// DefaultConfig returns the default relay configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:  false,
+		URL:      "",
+		Pubkey:   "",
+		Timeout:  defaultTimeout,
+		GasLimit: defaultGasLimit,
+	}
+}
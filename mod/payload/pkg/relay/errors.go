@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoBid is returned when the relay has no bid for the requested slot.
+	ErrNoBid = errors.New("relay has no bid for the slot")
+
+	// ErrInvalidBidSignature is returned when the signature of a bid does
+	// not verify.
+	ErrInvalidBidSignature = errors.New("invalid bid signature")
+
+	// ErrUnexpectedBidPubkey is returned when a bid is not signed by the
+	// configured relay.
+	ErrUnexpectedBidPubkey = errors.New("bid not signed by the relay")
+
+	// ErrPayloadMismatch is returned when the payload revealed by the relay
+	// does not match the bid that was signed.
+	ErrPayloadMismatch = errors.New("revealed payload does not match bid")
+
+	// ErrUnexpectedStatus is returned when the relay responds with an
+	// unexpected HTTP status.
+	ErrUnexpectedStatus = errors.New("unexpected relay response status")
+)
@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// maxBlobCommitmentsPerBlock is the SSZ limit of the list of blob
+// commitments, it matches the limit of the beacon block body.
+const maxBlobCommitmentsPerBlock = 16
+
+// SizeSSZ returns the size of the ValidatorRegistration in SSZ encoding.
+func (*ValidatorRegistration) SizeSSZ() uint32 {
+	//nolint:mnd // 20 + 8 + 8 + 48 = 84.
+	return 84
+}
+
+// DefineSSZ defines the SSZ encoding for the ValidatorRegistration.
+func (r *ValidatorRegistration) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.FeeRecipient)
+	ssz.DefineUint64(codec, &r.GasLimit)
+	ssz.DefineUint64(codec, &r.Timestamp)
+	ssz.DefineStaticBytes(codec, &r.Pubkey)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ValidatorRegistration.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// builderBidRoots is the SSZ layout of a BuilderBid with the header
+// replaced by its hash tree root, which yields the same hash tree root as
+// the bid itself.
+type builderBidRoots struct {
+	HeaderRoot         common.Root
+	BlobKZGCommitments []eip4844.KZGCommitment
+	Value              *math.U256
+	Pubkey             crypto.BLSPubkey
+}
+
+// SizeSSZ returns the size of the builderBidRoots in SSZ encoding.
+func (b *builderBidRoots) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // 32 + 4 + 32 + 48 = 116.
+	var size uint32 = 116
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeSliceOfStaticBytes(b.BlobKZGCommitments)
+}
+
+// DefineSSZ defines the SSZ encoding for the builderBidRoots.
+func (b *builderBidRoots) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &b.HeaderRoot)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKZGCommitments, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineUint256(codec, &b.Value)
+	ssz.DefineStaticBytes(codec, &b.Pubkey)
+
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKZGCommitments, maxBlobCommitmentsPerBlock,
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BuilderBid.
+func (b *BuilderBid[_]) HashTreeRoot() common.Root {
+	return ssz.HashSequential(&builderBidRoots{
+		HeaderRoot:         b.Header.HashTreeRoot(),
+		BlobKZGCommitments: b.BlobKZGCommitments,
+		Value:              b.Value,
+		Pubkey:             b.Pubkey,
+	})
+}
+
+// maxDepositsPerBlock is the SSZ limit of the list of deposits, it matches
+// the limit of the beacon block body.
+const maxDepositsPerBlock = 16
+
+// blindedBeaconBlockBodyRoots is the SSZ layout of a BlindedBeaconBlockBody
+// with the eth1 data, the deposits and the execution payload header replaced
+// by their hash tree roots, which yields the same hash tree root as the body
+// itself.
+type blindedBeaconBlockBodyRoots struct {
+	RandaoReveal               crypto.BLSSignature
+	Eth1DataRoot               common.Root
+	Graffiti                   common.Bytes32
+	DepositRoots               []common.Root
+	ExecutionPayloadHeaderRoot common.Root
+	BlobKZGCommitments         []eip4844.KZGCommitment
+}
+
+// SizeSSZ returns the size of the blindedBeaconBlockBodyRoots in SSZ
+// encoding.
+func (b *blindedBeaconBlockBodyRoots) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // 96 + 32 + 32 + 4 + 32 + 4 = 200.
+	var size uint32 = 200
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeSliceOfStaticBytes(b.DepositRoots) +
+		ssz.SizeSliceOfStaticBytes(b.BlobKZGCommitments)
+}
+
+// DefineSSZ defines the SSZ encoding for the blindedBeaconBlockBodyRoots.
+func (b *blindedBeaconBlockBodyRoots) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticBytes(codec, &b.Eth1DataRoot)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.DepositRoots, maxDepositsPerBlock,
+	)
+	ssz.DefineStaticBytes(codec, &b.ExecutionPayloadHeaderRoot)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKZGCommitments, maxBlobCommitmentsPerBlock,
+	)
+
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.DepositRoots, maxDepositsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKZGCommitments, maxBlobCommitmentsPerBlock,
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the
+// BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody[_, _, _]) HashTreeRoot() common.Root {
+	depositRoots := make([]common.Root, 0, len(b.Deposits))
+	for _, deposit := range b.Deposits {
+		depositRoots = append(depositRoots, deposit.HashTreeRoot())
+	}
+	return ssz.HashSequential(&blindedBeaconBlockBodyRoots{
+		RandaoReveal:               b.RandaoReveal,
+		Eth1DataRoot:               b.Eth1Data.HashTreeRoot(),
+		Graffiti:                   b.Graffiti,
+		DepositRoots:               depositRoots,
+		ExecutionPayloadHeaderRoot: b.ExecutionPayloadHeader.HashTreeRoot(),
+		BlobKZGCommitments:         b.BlobKZGCommitments,
+	})
+}
+
+// blindedBeaconBlockRoots is the SSZ layout of a BlindedBeaconBlock with the
+// body replaced by its hash tree root, which is the layout of the header of
+// the block and yields the same hash tree root as the block itself.
+type blindedBeaconBlockRoots struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    common.Root
+	StateRoot     common.Root
+	BodyRoot      common.Root
+}
+
+// SizeSSZ returns the size of the blindedBeaconBlockRoots in SSZ encoding.
+func (*blindedBeaconBlockRoots) SizeSSZ() uint32 {
+	//nolint:mnd // 8 + 8 + 32 + 32 + 32 = 112.
+	return 112
+}
+
+// DefineSSZ defines the SSZ encoding for the blindedBeaconBlockRoots.
+func (b *blindedBeaconBlockRoots) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentRoot)
+	ssz.DefineStaticBytes(codec, &b.StateRoot)
+	ssz.DefineStaticBytes(codec, &b.BodyRoot)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock[_, _, _]) HashTreeRoot() common.Root {
+	return ssz.HashSequential(&blindedBeaconBlockRoots{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		BodyRoot:      b.Body.HashTreeRoot(),
+	})
+}
+
+// signingData is the container whose hash tree root is signed, as defined
+// in the Ethereum 2.0 specification.
+type signingData struct {
+	ObjectRoot common.Root
+	Domain     common.Domain
+}
+
+// SizeSSZ returns the size of the signingData in SSZ encoding.
+func (*signingData) SizeSSZ() uint32 {
+	//nolint:mnd // 32 + 32 = 64.
+	return 64
+}
+
+// DefineSSZ defines the SSZ encoding for the signingData.
+func (s *signingData) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &s.ObjectRoot)
+	ssz.DefineStaticBytes(codec, &s.Domain)
+}
+
+// computeSigningRoot computes the root signed for an object in a domain.
+func computeSigningRoot(
+	obj interface{ HashTreeRoot() common.Root },
+	domain common.Domain,
+) common.Root {
+	return ssz.HashSequential(&signingData{
+		ObjectRoot: obj.HashTreeRoot(),
+		Domain:     domain,
+	})
+}
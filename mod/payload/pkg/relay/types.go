@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ExecutionPayload is the interface for the execution payload revealed by
+// the relay.
+type ExecutionPayload[
+	ExecutionPayloadT, ExecutionPayloadHeaderT any,
+] interface {
+	constraints.JSONMarshallable
+	// Empty returns an empty execution payload for the given fork version.
+	Empty(uint32) ExecutionPayloadT
+	// GetBlockHash returns the block hash of the execution payload.
+	GetBlockHash() common.ExecutionHash
+	// ToHeader returns the header of the execution payload.
+	ToHeader(
+		maxWithdrawalsPerPayload uint64,
+		eth1ChainID uint64,
+	) (ExecutionPayloadHeaderT, error)
+}
+
+// ExecutionPayloadHeader is the interface for the execution payload header
+// bid on by the relay.
+type ExecutionPayloadHeader[ExecutionPayloadHeaderT any] interface {
+	constraints.JSONMarshallable
+	// Empty returns an empty execution payload header.
+	Empty() ExecutionPayloadHeaderT
+	// HashTreeRoot returns the hash tree root of the header.
+	HashTreeRoot() common.Root
+	// GetBlockHash returns the block hash of the execution payload.
+	GetBlockHash() common.ExecutionHash
+}
+
+// versioned wraps the data of builder API requests and responses together
+// with the name of the fork it belongs to.
+type versioned[T any] struct {
+	// Version is the name of the fork.
+	Version string `json:"version"`
+	// Data is the wrapped object.
+	Data T `json:"data"`
+}
+
+// ValidatorRegistration is the request of a validator to have its blocks
+// built by the relay.
+type ValidatorRegistration struct {
+	// FeeRecipient is the address receiving the payment for the block.
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	// GasLimit is the gas limit preferred by the validator.
+	GasLimit uint64 `json:"gas_limit,string"`
+	// Timestamp is the unix time of the registration.
+	Timestamp uint64 `json:"timestamp,string"`
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SignedValidatorRegistration is a validator registration signed by the
+// validator.
+type SignedValidatorRegistration struct {
+	// Message is the registration.
+	Message *ValidatorRegistration `json:"message"`
+	// Signature is the signature of the validator over the registration.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// BuilderBid is the bid of a builder for the execution payload of a slot.
+type BuilderBid[
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// Header is the header of the execution payload being bid on.
+	Header ExecutionPayloadHeaderT `json:"header"`
+	// BlobKZGCommitments are the commitments to the blobs of the payload.
+	BlobKZGCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// Value is the Wei value paid to the proposer.
+	Value *math.U256 `json:"value"`
+	// Pubkey is the public key of the builder that signed the bid.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// GetHeader returns the header of the execution payload being bid on.
+func (b *BuilderBid[HeaderT]) GetHeader() HeaderT {
+	return b.Header
+}
+
+// GetBlobKZGCommitments returns the commitments to the blobs of the payload.
+func (b *BuilderBid[_]) GetBlobKZGCommitments() []eip4844.KZGCommitment {
+	return b.BlobKZGCommitments
+}
+
+// GetValue returns the Wei value paid to the proposer.
+func (b *BuilderBid[_]) GetValue() *math.U256 {
+	return b.Value
+}
+
+// SignedBuilderBid is a bid signed by the builder.
+type SignedBuilderBid[
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// Message is the bid.
+	Message *BuilderBid[ExecutionPayloadHeaderT] `json:"message"`
+	// Signature is the signature of the builder over the bid.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// BeaconBlock is the interface for the beacon block whose blinded form is
+// signed in exchange for the execution payload of a bid.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the proposer of the block.
+	GetProposerIndex() math.ValidatorIndex
+	// GetParentBlockRoot returns the root of the parent block.
+	GetParentBlockRoot() common.Root
+	// GetStateRoot returns the state root of the block.
+	GetStateRoot() common.Root
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody is the interface for the body of the beacon block whose
+// blinded form is signed in exchange for the execution payload of a bid.
+type BeaconBlockBody[DepositT, Eth1DataT any] interface {
+	// GetRandaoReveal returns the randao reveal of the body.
+	GetRandaoReveal() crypto.BLSSignature
+	// GetEth1Data returns the eth1 data of the body.
+	GetEth1Data() Eth1DataT
+	// GetGraffiti returns the graffiti of the body.
+	GetGraffiti() common.Bytes32
+	// GetDeposits returns the deposits of the body.
+	GetDeposits() []DepositT
+}
+
+// BlindedBeaconBlock is a beacon block that commits to its execution payload
+// by its header only.
+type BlindedBeaconBlock[
+	DepositT, Eth1DataT constraints.SSZRootable,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// Slot is the slot of the block.
+	Slot uint64 `json:"slot,string"`
+	// ProposerIndex is the index of the proposer of the block.
+	ProposerIndex uint64 `json:"proposer_index,string"`
+	// ParentRoot is the root of the parent block.
+	ParentRoot common.Root `json:"parent_root"`
+	// StateRoot is the root of the state after the block.
+	StateRoot common.Root `json:"state_root"`
+	// Body is the blinded body of the block.
+	Body *BlindedBeaconBlockBody[
+		DepositT, Eth1DataT, ExecutionPayloadHeaderT,
+	] `json:"body"`
+}
+
+// BlindedBeaconBlockBody is the body of a blinded beacon block, which holds
+// the header of the execution payload in place of the payload.
+//
+//nolint:lll // struct tags.
+type BlindedBeaconBlockBody[
+	DepositT, Eth1DataT constraints.SSZRootable,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// RandaoReveal is the randao reveal of the proposer.
+	RandaoReveal crypto.BLSSignature `json:"randao_reveal"`
+	// Eth1Data is the eth1 data voted for by the proposer.
+	Eth1Data Eth1DataT `json:"eth1_data"`
+	// Graffiti is the graffiti of the proposer.
+	Graffiti common.Bytes32 `json:"graffiti"`
+	// Deposits are the deposits included in the block.
+	Deposits []DepositT `json:"deposits"`
+	// ExecutionPayloadHeader is the header of the execution payload.
+	ExecutionPayloadHeader ExecutionPayloadHeaderT `json:"execution_payload_header"`
+	// BlobKZGCommitments are the commitments to the blobs of the payload.
+	BlobKZGCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+}
+
+// SignedBlindedBeaconBlock is a blinded beacon block signed by its
+// proposer.
+type SignedBlindedBeaconBlock[
+	DepositT, Eth1DataT constraints.SSZRootable,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// Message is the blinded block.
+	Message *BlindedBeaconBlock[
+		DepositT, Eth1DataT, ExecutionPayloadHeaderT,
+	] `json:"message"`
+	// Signature is the signature of the proposer over the blinded block.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// ExecutionPayloadAndBlobsBundle is the execution payload and blobs revealed
+// by the relay in exchange for a signed blinded block.
+type ExecutionPayloadAndBlobsBundle[ExecutionPayloadT any] struct {
+	// ExecutionPayload is the execution payload.
+	ExecutionPayload ExecutionPayloadT `json:"execution_payload"`
+	// BlobsBundle holds the blobs, commitments and proofs of the payload.
+	BlobsBundle *engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	] `json:"blobs_bundle"`
+}
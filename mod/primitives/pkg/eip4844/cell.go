@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package eip4844
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// FieldElementsPerCell is the number of field elements in a cell.
+	FieldElementsPerCell = 64
+	// CellsPerExtBlob is the number of cells in an extended blob, which is
+	// also the number of columns of the extended blob matrix.
+	CellsPerExtBlob = 128
+)
+
+// Cell represents an EIP-7594 cell, a slice of the evaluations of the
+// extended blob polynomial.
+type Cell [2048]byte
+
+// UnmarshalJSON parses a cell in hex syntax.
+func (c *Cell) UnmarshalJSON(input []byte) error {
+	return bytes.UnmarshalFixedJSON(input, c[:])
+}
+
+// MarshalText returns the hex representation of c.
+func (c Cell) MarshalText() ([]byte, error) {
+	return bytes.Bytes(c[:]).MarshalText()
+}
+
+// SizeSSZ returns the size of the cell in SSZ encoding.
+func (c *Cell) SizeSSZ() uint32 {
+	return uint32(len(c))
+}
+
+// DefineSSZ defines the SSZ encoding of the cell. The cell is defined as its
+// 32 byte chunks, which encode and hash the same as the whole byte vector.
+func (c *Cell) DefineSSZ(codec *ssz.Codec) {
+	for i := 0; i < len(c); i += constants.RootLength {
+		ssz.DefineStaticBytes(
+			codec, (*[constants.RootLength]byte)(c[i:i+constants.RootLength]),
+		)
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filewatch
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch reads the file at the given path with read and passes its contents
+// to apply whenever the file changes, until the context is done.
+//
+// The parent directory is watched rather than the file itself, so that
+// files replaced by renaming or re-linking are picked up too. A file that
+// cannot be read is reported to onError and not applied, so that a
+// partially written file does not replace working contents. An error is
+// returned only if the file cannot be watched at all.
+func Watch[T any](
+	ctx context.Context,
+	path string,
+	read func(path string) (T, error),
+	apply func(T),
+	onError func(error),
+) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	path = filepath.Clean(path)
+	if err = watcher.Add(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Clean(event.Name) != path ||
+				!event.Has(fsnotify.Write|fsnotify.Create) {
+				continue
+			}
+			contents, readErr := read(path)
+			if readErr != nil {
+				onError(readErr)
+				continue
+			}
+			apply(contents)
+		case watchErr, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			onError(watchErr)
+		}
+	}
+}
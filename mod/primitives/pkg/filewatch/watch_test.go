@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filewatch_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/filewatch"
+	"github.com/stretchr/testify/require"
+)
+
+var errEmpty = errors.New("empty file")
+
+func readNonEmpty(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if len(data) == 0 {
+		return "", errEmpty
+	}
+	return string(data), nil
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(path, []byte("a"), 0o600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	applied := make(chan string, 8)
+	failed := make(chan error, 8)
+	done := make(chan error, 1)
+	go func() {
+		done <- filewatch.Watch(
+			ctx, path, readNonEmpty,
+			func(s string) { applied <- s },
+			func(err error) { failed <- err },
+		)
+	}()
+	// Give the watcher time to be set up.
+	time.Sleep(100 * time.Millisecond)
+
+	// Writes to other files of the directory are ignored, writes to the
+	// file are applied.
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "other"), []byte("x"), 0o600,
+	))
+	require.NoError(t, os.WriteFile(path, []byte("b"), 0o600))
+	require.Equal(t, "b", <-applied)
+
+	// Files replaced by renaming are picked up.
+	tmp := filepath.Join(dir, "file.tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte("c"), 0o600))
+	require.NoError(t, os.Rename(tmp, path))
+	require.Equal(t, "c", <-applied)
+
+	// Invalid contents are reported and not applied.
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	require.ErrorIs(t, <-failed, errEmpty)
+	require.Empty(t, applied)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestWatchMissingDir(t *testing.T) {
+	err := filewatch.Watch(
+		context.Background(),
+		filepath.Join(t.TempDir(), "missing", "file"),
+		readNonEmpty, func(string) {}, func(error) {},
+	)
+	require.Error(t, err)
+}
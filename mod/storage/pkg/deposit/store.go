@@ -30,13 +30,22 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix         = "deposit"
+	KeyLastSyncedBlockPrefix = "last_synced_block"
+	KeyNextIndexPrefix       = "next_deposit_index"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit[DepositT]] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// lastSyncedBlock is the last execution block whose deposits were
+	// enqueued.
+	lastSyncedBlock sdkcollections.Item[uint64]
+	// nextIndex is the index of the next expected deposit.
+	nextIndex sdkcollections.Item[uint64]
+	mu        sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -52,6 +61,18 @@ func NewStore[DepositT Deposit[DepositT]](
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		lastSyncedBlock: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyLastSyncedBlockPrefix)),
+			KeyLastSyncedBlockPrefix,
+			sdkcollections.Uint64Value,
+		),
+		nextIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyNextIndexPrefix)),
+			KeyNextIndexPrefix,
+			sdkcollections.Uint64Value,
+		),
 	}
 }
 
@@ -102,6 +123,40 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 	return kv.store.Set(context.TODO(), deposit.GetIndex().Unwrap(), deposit)
 }
 
+// GetSyncCursor returns the last execution block whose deposits were
+// enqueued and the index of the next expected deposit. It returns false if
+// the cursor was never set.
+func (kv *KVStore[DepositT]) GetSyncCursor() (uint64, uint64, bool, error) {
+	var ctx = context.TODO()
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	lastSyncedBlock, err := kv.lastSyncedBlock.Get(ctx)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, 0, false, nil
+	} else if err != nil {
+		return 0, 0, false, err
+	}
+	nextIndex, err := kv.nextIndex.Get(ctx)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return lastSyncedBlock, nextIndex, true, nil
+}
+
+// SetSyncCursor sets the last execution block whose deposits were enqueued
+// and the index of the next expected deposit.
+func (kv *KVStore[DepositT]) SetSyncCursor(
+	lastSyncedBlock, nextIndex uint64,
+) error {
+	var ctx = context.TODO()
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.nextIndex.Set(ctx, nextIndex); err != nil {
+		return err
+	}
+	return kv.lastSyncedBlock.Set(ctx, lastSyncedBlock)
+}
+
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	var ctx = context.TODO()
@@ -21,14 +21,23 @@
 package filedb
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/spf13/afero"
 )
 
+const (
+	// tmpSuffix is the suffix of the files being written.
+	tmpSuffix = ".tmp"
+	// filePerms are the permissions of the files.
+	filePerms = 0o644
+)
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
@@ -38,6 +47,9 @@ type DB struct {
 	rootDir   string
 	extension string
 	dirPerms  os.FileMode
+	// shardSize is the number of indexes of each directory the keys
+	// prefixed by an index are sharded into, 0 disables sharding.
+	shardSize uint64
 }
 
 // NewDB creates a new instance of the DB.
@@ -53,9 +65,20 @@ func NewDB(opts ...Option) *DB {
 	return db
 }
 
-// Get retrieves the value for a key.
+// Get retrieves the value for a key. A value which does not match its
+// checksum is quarantined.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	path := db.pathForKey(key)
+	bz, err := afero.ReadFile(db.fs, path)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := decodeFile(bz)
+	if errors.Is(err, ErrChecksumMismatch) {
+		db.quarantine(path, err)
+	}
+	return value, err
 }
 
 // Has returns true if the key exists in the database.
@@ -67,33 +90,47 @@ func (db *DB) Has(key []byte) (bool, error) {
 	return exists, nil
 }
 
-// Set stores the value for a key.
+// Set stores the value for a key. The value is written to a temporary file
+// renamed once synced, so that a crash never leaves a partial value behind
+// the key.
 func (db *DB) Set(key []byte, value []byte) error {
-	if exists, err := afero.Exists(db.fs, db.pathForKey(key)); err != nil {
+	path := db.pathForKey(key)
+	if exists, err := afero.Exists(db.fs, path); err != nil {
 		return err
 	} else if exists {
 		db.logger.Warn("Overriding existing key", "key", key)
 	}
 
-	if err := db.fs.MkdirAll(
-		filepath.Dir(db.pathForKey(key)), db.dirPerms,
-	); err != nil {
+	if err := db.fs.MkdirAll(filepath.Dir(path), db.dirPerms); err != nil {
 		return err
 	}
 
-	file, err := db.fs.Create(db.pathForKey(key))
+	tmpPath := path + tmpSuffix
+	file, err := db.fs.OpenFile(
+		tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, filePerms,
+	)
 	if err != nil {
 		return errors.Wrap(err, "failed to create file")
 	}
-	defer file.Close()
 
-	n, err := file.Write(value)
+	n, err := file.Write(encodeFile(value))
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
+		_ = db.fs.Remove(tmpPath)
 		return errors.Wrap(err, "failed to write to file")
 	}
-	db.logger.Debug("wrote %d bytes to %s", n, db.pathForKey(key))
 
-	return nil
+	if err = db.fs.Rename(tmpPath, path); err != nil {
+		return errors.Wrap(err, "failed to rename file")
+	}
+	db.logger.Debug("wrote %d bytes to %s", n, path)
+
+	return db.syncDir(filepath.Dir(path))
 }
 
 // Delete removes the value for a key.
@@ -101,8 +138,41 @@ func (db *DB) Delete(key []byte) error {
 	return db.fs.RemoveAll(db.pathForKey(key))
 }
 
-// pathForKey returns the path for a key.
-// TODO: for efficient storage we should expand this path
+// syncDir syncs a directory, persisting the renames of its files.
+func (db *DB) syncDir(path string) error {
+	dir, err := db.fs.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// pathForKey returns the path for a key. Keys prefixed by an index, as
+// written by the RangeDB, are sharded by index range.
 func (db *DB) pathForKey(key []byte) string {
-	return string(key) + "." + db.extension
+	path := string(key) + "." + db.extension
+	if db.shardSize == 0 {
+		return path
+	}
+	index, err := ExtractIndex(key)
+	if err != nil {
+		return path
+	}
+	return filepath.Join(db.shardForIndex(index), path)
+}
+
+// pathForIndex returns the directory of the keys prefixed by an index.
+func (db *DB) pathForIndex(index uint64) string {
+	path := strconv.FormatUint(index, 10) + "/"
+	if db.shardSize == 0 {
+		return path
+	}
+	return filepath.Join(db.shardForIndex(index), path) + "/"
+}
+
+// shardForIndex returns the directory of the shard of an index.
+func (db *DB) shardForIndex(index uint64) string {
+	start := index - index%db.shardSize
+	return fmt.Sprintf("%d-%d", start, start+db.shardSize-1)
 }
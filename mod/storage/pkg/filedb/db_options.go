@@ -69,3 +69,12 @@ func WithRootDirectory(rootDir string) Option {
 		return nil
 	}
 }
+
+// WithShardSize sets the number of indexes of each directory the keys
+// prefixed by an index are sharded into.
+func WithShardSize(shardSize uint64) Option {
+	return func(db *DB) error {
+		db.shardSize = shardSize
+		return nil
+	}
+}
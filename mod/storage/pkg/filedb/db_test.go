@@ -21,6 +21,8 @@
 package filedb_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -293,3 +295,73 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_ChecksumAndRecover(t *testing.T) {
+	dir := t.TempDir()
+	newDB := func() *file.DB {
+		return file.NewDB(
+			file.WithRootDirectory(dir),
+			file.WithFileExtension("ssz"),
+			file.WithDirectoryPermissions(0700),
+			file.WithLogger(log.NewNopLogger()),
+			file.WithShardSize(4),
+		)
+	}
+
+	// Indexes written before sharding are moved into their shards.
+	legacy := filepath.Join(dir, "5")
+	require.NoError(t, os.MkdirAll(legacy, 0700))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(legacy, "0x6c6567616379.ssz"), []byte("legacy"), 0600,
+	))
+	db := newDB()
+	n, err := db.Recover()
+	require.NoError(t, err)
+	require.Zero(t, n)
+	rdb := file.NewRangeDB(db)
+	value, err := rdb.Get(5, []byte("legacy"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("legacy"), value)
+
+	require.NoError(t, rdb.Set(6, []byte("key"), []byte("value")))
+	require.NoError(t, rdb.Set(9, []byte("key"), []byte("value")))
+	path := filepath.Join(dir, "4-7", "6", "0x6b6579.ssz")
+	require.FileExists(t, path)
+	require.FileExists(t, filepath.Join(dir, "8-11", "9", "0x6b6579.ssz"))
+
+	// A value not matching its checksum is quarantined on read.
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0600))
+	_, err = rdb.Get(6, []byte("key"))
+	require.ErrorIs(t, err, file.ErrChecksumMismatch)
+	exists, err := rdb.Has(6, []byte("key"))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Partial and truncated files are quarantined on recovery.
+	truncated := filepath.Join(dir, "8-11", "9", "0x6b6579.ssz")
+	bz, err = os.ReadFile(truncated)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(truncated, bz[:len(bz)-1], 0600))
+	require.NoError(t, os.WriteFile(truncated+".tmp", bz[:3], 0600))
+	n, err = newDB().Recover()
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.NoFileExists(t, truncated)
+	require.FileExists(t, filepath.Join(dir, "quarantine", "8-11", "9",
+		"0x6b6579.ssz.tmp"))
+
+	// The indexes are listed across shards.
+	indexes, err := db.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 6, 9}, indexes)
+
+	// Pruning removes whole shards.
+	require.NoError(t, rdb.Prune(0, 8))
+	require.NoDirExists(t, filepath.Join(dir, "4-7"))
+	indexes, err = db.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{9}, indexes)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// A file starts with a header followed by the value:
+//
+//	magic     [4]byte
+//	checksum  uint32 (crc32c of the value)
+//	length    uint64 (length of the value)
+//
+// Files written before headers were introduced hold the bare value, and are
+// read as they are. The magic can not start the ssz encoding of a sidecar,
+// which starts with a small little endian index.
+const headerSize = 16
+
+// magic marks the files starting with a header.
+//
+//nolint:gochecknoglobals // constant.
+var magic = []byte{0xbe, 0xac, 0xf1, 0x1e}
+
+// castagnoli is the crc32c table of the checksums.
+//
+//nolint:gochecknoglobals // constant.
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// encodeFile prefixes the value with its header.
+func encodeFile(value []byte) []byte {
+	bz := make([]byte, 0, headerSize+len(value))
+	bz = append(bz, magic...)
+	bz = binary.LittleEndian.AppendUint32(
+		bz, crc32.Checksum(value, castagnoli),
+	)
+	bz = binary.LittleEndian.AppendUint64(bz, uint64(len(value)))
+	return append(bz, value...)
+}
+
+// hasHeader returns true if the file starts with a header.
+func hasHeader(bz []byte) bool {
+	return len(bz) >= len(magic) && bytes.Equal(bz[:len(magic)], magic)
+}
+
+// checkLength returns an error if the header does not match the size of the
+// file, which happens when the file was truncated.
+func checkLength(header []byte, size int64) error {
+	if len(header) < headerSize {
+		return errors.Wrap(ErrChecksumMismatch, "truncated header")
+	}
+	length := binary.LittleEndian.Uint64(header[8:headerSize])
+	//#nosec:G115 // sizes of files are positive.
+	if uint64(size) != headerSize+length {
+		return errors.Wrapf(
+			ErrChecksumMismatch, "length %d, size %d", length, size,
+		)
+	}
+	return nil
+}
+
+// decodeFile returns the value of a file, checking it against its header.
+func decodeFile(bz []byte) ([]byte, error) {
+	if !hasHeader(bz) {
+		return bz, nil
+	}
+	if err := checkLength(bz, int64(len(bz))); err != nil {
+		return nil, err
+	}
+	value := bz[headerSize:]
+	if crc32.Checksum(value, castagnoli) !=
+		binary.LittleEndian.Uint32(bz[len(magic):8]) {
+		return nil, ErrChecksumMismatch
+	}
+	return value, nil
+}
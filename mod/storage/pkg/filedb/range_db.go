@@ -94,10 +94,14 @@ func (db *RangeDB) DeleteRange(from, to uint64) error {
 		return errors.New("rangedb: delete range not supported for this db")
 	}
 	for ; from < to; from++ {
-		path := strconv.FormatUint(from, 10) + "/"
-		if err := f.fs.RemoveAll(path); err != nil {
+		if err := f.fs.RemoveAll(f.pathForIndex(from)); err != nil {
 			return err
 		}
+		// Remove the shard once its last index is removed, which fails
+		// harmlessly if it still holds indexes.
+		if f.shardSize != 0 && (from+1)%f.shardSize == 0 {
+			_ = f.fs.Remove(f.shardForIndex(from))
+		}
 	}
 	return nil
 }
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// quarantineDir is the directory the corrupt and partial files are moved
+// to.
+const quarantineDir = "quarantine"
+
+// Recover prepares the database after a restart. It quarantines the files
+// left partially written by a crash and the files not matching their
+// header, and moves the directories of the indexes written before sharding
+// was enabled into their shards. It returns the number of files
+// quarantined.
+func (db *DB) Recover() (int, error) {
+	// Nothing to recover before the first write.
+	if exists, err := afero.DirExists(db.fs, "."); err != nil || !exists {
+		return 0, err
+	}
+	if err := db.shardLegacyIndexes(); err != nil {
+		return 0, err
+	}
+
+	var corrupt []string
+	if err := afero.Walk(db.fs, ".", func(
+		path string, info os.FileInfo, err error,
+	) error {
+		switch {
+		case err != nil:
+			return err
+		case info.IsDir() && path == quarantineDir:
+			return filepath.SkipDir
+		case info.IsDir():
+			return nil
+		case strings.HasSuffix(path, tmpSuffix):
+			corrupt = append(corrupt, path)
+		case filepath.Ext(path) == "."+db.extension:
+			if db.checkFile(path, info.Size()) != nil {
+				corrupt = append(corrupt, path)
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	for _, path := range corrupt {
+		db.quarantine(path, ErrChecksumMismatch)
+	}
+	return len(corrupt), nil
+}
+
+// checkFile checks the header of a file against its size, without reading
+// its value.
+func (db *DB) checkFile(path string, size int64) error {
+	f, err := db.fs.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	header := make([]byte, headerSize)
+	n, err := io.ReadFull(f, header)
+	if err != nil && n < len(magic) {
+		// Files shorter than the magic are legacy files.
+		return nil
+	}
+	if !hasHeader(header) {
+		return nil
+	}
+	return checkLength(header[:n], size)
+}
+
+// shardLegacyIndexes moves the directories of the indexes at the root of
+// the database into their shards.
+func (db *DB) shardLegacyIndexes() error {
+	if db.shardSize == 0 {
+		return nil
+	}
+	entries, err := afero.ReadDir(db.fs, ".")
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		index, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		shard := db.shardForIndex(index)
+		if err = db.fs.MkdirAll(shard, db.dirPerms); err != nil {
+			return err
+		}
+		if err = db.fs.Rename(
+			entry.Name(), filepath.Join(shard, entry.Name()),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Indexes returns the indexes the RangeDB holds keys of, in ascending
+// order.
+func (db *DB) Indexes() ([]uint64, error) {
+	if exists, err := afero.DirExists(db.fs, "."); err != nil || !exists {
+		return nil, err
+	}
+	entries, err := afero.ReadDir(db.fs, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var indexes []uint64
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == quarantineDir {
+			continue
+		}
+		if index, pErr := strconv.ParseUint(
+			entry.Name(), 10, 64,
+		); pErr == nil {
+			indexes = append(indexes, index)
+			continue
+		}
+
+		// Every other directory is a shard of indexes.
+		shard, rErr := afero.ReadDir(db.fs, entry.Name())
+		if rErr != nil {
+			return nil, rErr
+		}
+		for _, dir := range shard {
+			if index, pErr := strconv.ParseUint(
+				dir.Name(), 10, 64,
+			); dir.IsDir() && pErr == nil {
+				indexes = append(indexes, index)
+			}
+		}
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+// quarantine moves a corrupt or partial file out of the database, keeping
+// it for inspection.
+func (db *DB) quarantine(path string, reason error) {
+	target := filepath.Join(quarantineDir, path)
+	if err := db.fs.MkdirAll(filepath.Dir(target), db.dirPerms); err != nil {
+		db.logger.Error("Failed to quarantine file", "path", path, "err", err)
+		return
+	}
+	if err := db.fs.Rename(path, target); err != nil {
+		db.logger.Error("Failed to quarantine file", "path", path, "err", err)
+		return
+	}
+	db.logger.Warn("Quarantined corrupt file",
+		"path", path, "target", target, "reason", reason,
+	)
+}
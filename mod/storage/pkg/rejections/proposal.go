@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rejections
+
+import (
+	"errors"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// Proposal is a proposal rejected by the node.
+type Proposal struct {
+	// Height is the height of the proposal.
+	Height int64 `json:"height"`
+	// Time is the consensus time of the proposal.
+	Time time.Time `json:"time"`
+	// ProposerAddress is the consensus address of the proposer.
+	ProposerAddress bytes.Bytes `json:"proposer_address"`
+	// Block is the SSZ encoded beacon block of the proposal.
+	Block bytes.Bytes `json:"block"`
+	// Sidecars is the SSZ encoded blob sidecars of the proposal.
+	Sidecars bytes.Bytes `json:"sidecars"`
+	// PreStateRoot is the root of the beacon state the proposal was
+	// processed on top of.
+	PreStateRoot common.Root `json:"pre_state_root"`
+	// Errors is the chain of errors the proposal was rejected with, from
+	// the outermost to the innermost.
+	Errors []string `json:"errors"`
+	// RejectedAt is the time the proposal was rejected at.
+	RejectedAt time.Time `json:"rejected_at"`
+}
+
+// ErrorChain returns the messages of the chain of errors wrapped by the
+// given error, from the outermost to the innermost. Joined errors are
+// walked depth first, and wrappers that do not add to the message of the
+// error they wrap, such as stack traces, are skipped.
+func ErrorChain(err error) []string {
+	var chain []string
+	for err != nil {
+		if msg := err.Error(); len(chain) == 0 ||
+			chain[len(chain)-1] != msg {
+			chain = append(chain, msg)
+		}
+		//nolint:errorlint // walking the chain.
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				chain = append(chain, ErrorChain(e)...)
+			}
+			return chain
+		}
+		err = errors.Unwrap(err)
+	}
+	return chain
+}
+
+// GetHeight returns the height of the proposal.
+func (p *Proposal) GetHeight() int64 {
+	return p.Height
+}
+
+// GetBlock returns the SSZ encoded beacon block of the proposal.
+func (p *Proposal) GetBlock() []byte {
+	return p.Block
+}
+
+// GetSidecars returns the SSZ encoded blob sidecars of the proposal.
+func (p *Proposal) GetSidecars() []byte {
+	return p.Sidecars
+}
+
+// GetPreStateRoot returns the root of the beacon state the proposal was
+// processed on top of.
+func (p *Proposal) GetPreStateRoot() common.Root {
+	return p.PreStateRoot
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rejections
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// BeaconState is the beacon state the rejected proposals are processed on
+// top of.
+type BeaconState interface {
+	// HashTreeRoot returns the hash tree root of the beacon state.
+	HashTreeRoot() common.Root
+}
+
+// StorageBackend provides the beacon state of a context.
+type StorageBackend[BeaconStateT BeaconState] interface {
+	// StateFromContext returns the beacon state of the context.
+	StateFromContext(context.Context) BeaconStateT
+}
+
+// Recorder records the proposals rejected by the node in a ring.
+type Recorder[BeaconStateT BeaconState] struct {
+	// ring keeps the rejected proposals.
+	ring *Ring
+	// sb provides the beacon state the proposals are processed on top of.
+	sb StorageBackend[BeaconStateT]
+	// logger is the logger of the recorder.
+	logger log.Logger
+}
+
+// NewRecorder creates a new recorder of rejected proposals.
+func NewRecorder[BeaconStateT BeaconState](
+	ring *Ring,
+	sb StorageBackend[BeaconStateT],
+	logger log.Logger,
+) *Recorder[BeaconStateT] {
+	return &Recorder[BeaconStateT]{
+		ring:   ring,
+		sb:     sb,
+		logger: logger,
+	}
+}
+
+// RecordRejection records the proposal of the given height, whose
+// transactions hold the beacon block and the blob sidecars, as rejected with
+// the given error. The context must hold the state the proposal was
+// processed on top of.
+func (r *Recorder[_]) RecordRejection(
+	ctx context.Context,
+	height int64,
+	consensusTime time.Time,
+	proposerAddress []byte,
+	txs [][]byte,
+	rejection error,
+) {
+	if !r.ring.Enabled() {
+		return
+	}
+
+	p := &Proposal{
+		Height:          height,
+		Time:            consensusTime,
+		ProposerAddress: proposerAddress,
+		PreStateRoot:    r.sb.StateFromContext(ctx).HashTreeRoot(),
+		Errors:          ErrorChain(rejection),
+		RejectedAt:      time.Now(),
+	}
+	if len(txs) > 0 {
+		p.Block = txs[0]
+	}
+	if len(txs) > 1 {
+		p.Sidecars = txs[1]
+	}
+
+	if err := r.ring.Add(p); err != nil {
+		r.logger.Error(
+			"Failed to record rejected proposal",
+			"height", height,
+			"error", err,
+		)
+		return
+	}
+	r.logger.Info("Recorded rejected proposal", "height", height)
+}
+
+// Ring returns the ring the rejected proposals are recorded in.
+func (r *Recorder[_]) Ring() *Ring {
+	return r.ring
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rejections
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// fileExtension is the extension of the files holding rejected proposals.
+const fileExtension = ".json"
+
+// ErrProposalNotFound is returned when no rejected proposal is kept for the
+// requested height.
+var ErrProposalNotFound = errors.New("no rejected proposal stored")
+
+// Ring keeps the latest rejected proposals on disk, one file per proposal,
+// dropping the proposals of the lowest heights once its capacity is
+// reached.
+type Ring struct {
+	// dir is the directory holding the rejected proposals.
+	dir string
+	// capacity is the number of rejected proposals kept.
+	capacity int
+	// mu serializes the writes to the ring.
+	mu sync.Mutex
+}
+
+// NewRing creates a new ring keeping up to capacity rejected proposals in
+// the given directory.
+func NewRing(dir string, capacity int) *Ring {
+	return &Ring{
+		dir:      dir,
+		capacity: capacity,
+	}
+}
+
+// Enabled returns true if the ring keeps rejected proposals.
+func (r *Ring) Enabled() bool {
+	return r.capacity > 0
+}
+
+// Add persists the rejected proposal, dropping the proposals of the lowest
+// heights beyond the capacity of the ring.
+func (r *Ring) Add(p *Proposal) error {
+	if !r.Enabled() {
+		return nil
+	}
+
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err = os.MkdirAll(r.dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a crash never leaves a
+	// truncated proposal behind.
+	name := filepath.Join(r.dir, fmt.Sprintf(
+		"%020d-%020d%s", p.Height, p.RejectedAt.UnixNano(), fileExtension,
+	))
+	//#nosec:G306 // rejected proposals are not secret.
+	if err = os.WriteFile(name+".tmp", bz, 0o644); err != nil {
+		return err
+	}
+	if err = os.Rename(name+".tmp", name); err != nil {
+		return err
+	}
+
+	names, err := r.names()
+	if err != nil {
+		return err
+	}
+	for len(names) > r.capacity {
+		if err = os.Remove(filepath.Join(r.dir, names[0])); err != nil {
+			return err
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+// List returns the rejected proposals kept by the ring, from the lowest
+// height to the highest.
+func (r *Ring) List() ([]*Proposal, error) {
+	names, err := r.names()
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*Proposal, 0, len(names))
+	for _, name := range names {
+		p, err := r.read(name)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, p)
+	}
+	return proposals, nil
+}
+
+// Get returns the latest rejected proposal of the given height, or the
+// latest rejected proposal of any height if height is 0.
+func (r *Ring) Get(height int64) (*Proposal, error) {
+	names, err := r.names()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("%020d-", height)
+	for _, name := range slices.Backward(names) {
+		if height == 0 || strings.HasPrefix(name, prefix) {
+			return r.read(name)
+		}
+	}
+	if height == 0 {
+		return nil, ErrProposalNotFound
+	}
+	return nil, errors.Wrapf(ErrProposalNotFound, "height %d", height)
+}
+
+// names returns the names of the files holding the rejected proposals,
+// from the lowest height to the highest.
+func (r *Ring) names() ([]string, error) {
+	entries, err := os.ReadDir(r.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() &&
+			filepath.Ext(entry.Name()) == fileExtension {
+			names = append(names, entry.Name())
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// read reads the rejected proposal held by the file of the given name.
+func (r *Ring) read(name string) (*Proposal, error) {
+	bz, err := os.ReadFile(filepath.Join(r.dir, name))
+	if err != nil {
+		return nil, err
+	}
+	p := new(Proposal)
+	if err = json.Unmarshal(bz, p); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", name)
+	}
+	return p, nil
+}
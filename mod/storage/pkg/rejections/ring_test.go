@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rejections_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/rejections"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRing(t *testing.T) {
+	ring := rejections.NewRing(t.TempDir(), 2)
+	start := time.Unix(0, 0)
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, ring.Add(&rejections.Proposal{
+			Height:     height,
+			Block:      []byte{byte(height)},
+			RejectedAt: start.Add(time.Duration(height)),
+		}))
+	}
+
+	// The proposal of the lowest height is dropped.
+	proposals, err := ring.List()
+	require.NoError(t, err)
+	require.Len(t, proposals, 2)
+	require.Equal(t, int64(2), proposals[0].Height)
+	require.Equal(t, int64(3), proposals[1].Height)
+
+	_, err = ring.Get(1)
+	require.ErrorIs(t, err, rejections.ErrProposalNotFound)
+
+	p, err := ring.Get(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, p.GetBlock())
+
+	p, err = ring.Get(0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), p.Height)
+}
+
+func TestRingDisabled(t *testing.T) {
+	ring := rejections.NewRing(t.TempDir(), 0)
+	require.NoError(t, ring.Add(&rejections.Proposal{Height: 1}))
+
+	proposals, err := ring.List()
+	require.NoError(t, err)
+	require.Empty(t, proposals)
+}
+
+func TestErrorChain(t *testing.T) {
+	inner := errors.New("inner")
+	err := errors.Wrap(inner, "outer")
+	require.Equal(t, []string{"outer: inner", "inner"},
+		rejections.ErrorChain(err))
+	require.Empty(t, rejections.ErrorChain(nil))
+}